@@ -0,0 +1,201 @@
+//******************************************************************************************************
+//  Connection.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+)
+
+// connectionOptions defines the connection flags shared by all commands.
+type connectionOptions struct {
+	listen  bool
+	verbose bool
+	metrics string
+	config  *sttp.Config
+}
+
+// addConnectionFlags defines the connection flags on the specified flag set.
+func addConnectionFlags(flags *flag.FlagSet) *connectionOptions {
+	options := &connectionOptions{config: sttp.NewConfig()}
+	config := options.config
+
+	flags.BoolVar(&options.listen, "listen", false, "listen on [INTERFACE]:PORT for a reverse connection from a publisher")
+	flags.BoolVar(&options.verbose, "verbose", false, "write status messages to stderr")
+	flags.StringVar(&options.metrics, "metrics", "", "serve Prometheus subscriber metrics at http://[INTERFACE]:PORT/metrics")
+
+	flags.Func("max-retries", "maximum number of connection retries, -1 to retry infinitely (default -1)", func(value string) error {
+		return parseInt32(value, &config.MaxRetries)
+	})
+
+	flags.Func("retry-interval", "base connection retry interval (default 1s)", func(value string) error {
+		return parseMilliseconds(value, &config.RetryInterval)
+	})
+
+	flags.Func("max-retry-interval", "maximum connection retry interval (default 30s)", func(value string) error {
+		return parseMilliseconds(value, &config.MaxRetryInterval)
+	})
+
+	flags.BoolVar(&config.AutoReconnect, "reconnect", config.AutoReconnect, "automatically reconnect when connection drops")
+	flags.BoolVar(&config.CompressPayloadData, "compress", config.CompressPayloadData, "request compressed payload data")
+	flags.StringVar(&config.MetadataFilters, "metadata-filters", config.MetadataFilters, "semi-colon separated filters applied to requested metadata")
+	flags.StringVar(&config.MetadataCachePath, "metadata-cache", config.MetadataCachePath, "path of local file used to cache received metadata")
+
+	flags.Func("version", "target STTP protocol version (default 2)", func(value string) error {
+		version, err := parseUint8(value)
+		config.Version = version
+		return err
+	})
+
+	return options
+}
+
+// parseArgs parses command arguments and returns the single HOSTNAME:PORT, or [INTERFACE]:PORT
+// when listening, argument. Usage is shown and false is returned when the arguments are invalid.
+func parseArgs(flags *flag.FlagSet, args []string) (string, bool) {
+	if err := flags.Parse(args); err != nil {
+		return "", false
+	}
+
+	if flags.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "Expected one HOSTNAME:PORT argument, received %d\n\n", flags.NArg())
+		flags.Usage()
+		return "", false
+	}
+
+	return flags.Arg(0), true
+}
+
+// start defines the subscriber message loggers and connects to, or listens for, a publisher.
+func (options *connectionOptions) start(subscriber *sttp.Subscriber, address string) error {
+	subscriber.SetStatusMessageLogger(func(message string) {
+		if options.verbose {
+			fmt.Fprintln(os.Stderr, message)
+		}
+	})
+
+	subscriber.SetErrorMessageLogger(func(message string) {
+		fmt.Fprintln(os.Stderr, message)
+	})
+
+	if len(options.metrics) > 0 {
+		if err := serveMetrics(options.metrics, subscriber.EnableMetrics()); err != nil {
+			return err
+		}
+	}
+
+	if options.listen {
+		if err := subscriber.Listen(address, options.config); err != nil {
+			return fmt.Errorf("failed to listen for STTP publisher connection on \"%s\": %s", address, err.Error())
+		}
+
+		subscriber.StatusMessage("Listening for STTP publisher connection on " + address)
+		return nil
+	}
+
+	if err := subscriber.Dial(address, options.config); err != nil {
+		return fmt.Errorf("failed to connect to STTP publisher at \"%s\": %s", address, err.Error())
+	}
+
+	return nil
+}
+
+// serveMetrics serves the specified Prometheus metrics handler at /metrics on the specified address
+// for the lifetime of the process.
+func serveMetrics(address string, metrics http.Handler) error {
+	listener, err := net.Listen("tcp", address)
+
+	if err != nil {
+		return fmt.Errorf("failed to listen for metrics requests on \"%s\": %s", address, err.Error())
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+
+	go http.Serve(listener, mux)
+	return nil
+}
+
+// waitForExit blocks until an interrupt is received, done is closed or, when greater than zero,
+// the specified duration elapses.
+func waitForExit(done <-chan struct{}, duration time.Duration) {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	var timeout <-chan time.Time
+
+	if duration > 0 {
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-interrupt:
+	case <-done:
+	case <-timeout:
+	}
+}
+
+func parseInt32(value string, target *int32) error {
+	parsed, err := strconv.ParseInt(value, 10, 32)
+
+	if err != nil {
+		return err
+	}
+
+	*target = int32(parsed)
+	return nil
+}
+
+func parseUint8(value string) (byte, error) {
+	parsed, err := strconv.ParseUint(value, 10, 8)
+	return byte(parsed), err
+}
+
+func parseMilliseconds(value string, target *int32) error {
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		return err
+	}
+
+	if duration < 0 || duration.Milliseconds() > math.MaxInt32 {
+		return fmt.Errorf("duration \"%s\" is out of range", value)
+	}
+
+	*target = int32(duration.Milliseconds())
+	return nil
+}
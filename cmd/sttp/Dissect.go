@@ -0,0 +1,424 @@
+//******************************************************************************************************
+//  Dissect.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp/format"
+	"github.com/sttp/goapi/sttp/pcap"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// captureSession defines an STTP connection found in a packet capture.
+type captureSession struct {
+	id           int
+	subscriber   netip.AddrPort
+	publisher    netip.AddrPort
+	dissector    *transport.Dissector
+	frames       int
+	measurements int
+	anomalies    int
+}
+
+// connectionKey identifies a TCP connection independent of direction.
+type connectionKey struct {
+	low, high netip.AddrPort
+}
+
+func newConnectionKey(flow pcap.Flow) connectionKey {
+	if flow.Source.Compare(flow.Destination) < 0 {
+		return connectionKey{flow.Source, flow.Destination}
+	}
+
+	return connectionKey{flow.Destination, flow.Source}
+}
+
+// captureDissector routes reassembled TCP stream data and UDP datagrams of a capture to per-connection
+// STTP dissectors and writes the resulting events.
+type captureDissector struct {
+	writer          io.Writer
+	port            uint16
+	maxMeasurements int
+	anomaliesOnly   bool
+	sessions        map[connectionKey]*captureSession
+	closed          []*captureSession
+	midstream       map[pcap.Flow]bool
+	ignored         map[connectionKey]bool
+	nextID          int
+}
+
+func newCaptureDissector(writer io.Writer, port uint16, maxMeasurements int, anomaliesOnly bool) *captureDissector {
+	return &captureDissector{
+		writer:          writer,
+		port:            port,
+		maxMeasurements: maxMeasurements,
+		anomaliesOnly:   anomaliesOnly,
+		sessions:        make(map[connectionKey]*captureSession),
+		midstream:       make(map[pcap.Flow]bool),
+		ignored:         make(map[connectionKey]bool),
+	}
+}
+
+// looksLikeFrame determines if data starts with a plausible STTP command or response frame and
+// whether the frame was sent by the publisher, i.e., is a response.
+func looksLikeFrame(data []byte) (isFrame bool, fromPublisher bool) {
+	if len(data) < 5 {
+		return false, false
+	}
+
+	size := binary.BigEndian.Uint32(data)
+	code := data[4]
+
+	switch {
+	case code <= byte(transport.ServerCommand.GetSignalSelectionSchema) || code >= byte(transport.ServerCommand.UserCommand00) && code <= byte(transport.ServerCommand.UserCommand15):
+		return size >= 1 && size <= 1<<20, false
+	case code >= byte(transport.ServerResponse.Succeeded) && code <= byte(transport.ServerResponse.ConfigurationChanged) ||
+		code >= byte(transport.ServerResponse.UserResponse00) && code <= byte(transport.ServerResponse.UserResponse15) ||
+		code == byte(transport.ServerResponse.NoOP):
+		return size >= 6, true
+	default:
+		return false, false
+	}
+}
+
+// session gets the STTP session for the connection of flow, creating it when the flow carries STTP
+// frames. Returns nil for connections that are not STTP.
+func (cd *captureDissector) session(flow pcap.Flow, data []byte) *captureSession {
+	key := newConnectionKey(flow)
+
+	if session, ok := cd.sessions[key]; ok {
+		return session
+	}
+
+	if cd.ignored[key] {
+		return nil
+	}
+
+	var fromPublisher bool
+
+	if cd.port > 0 {
+		switch {
+		case flow.Source.Port() == cd.port:
+			fromPublisher = true
+		case flow.Destination.Port() == cd.port:
+			fromPublisher = false
+		default:
+			cd.ignored[key] = true
+			return nil
+		}
+	} else {
+		var isFrame bool
+
+		if isFrame, fromPublisher = looksLikeFrame(data); !isFrame {
+			cd.ignored[key] = true
+			return nil
+		}
+	}
+
+	cd.nextID++
+	session := &captureSession{id: cd.nextID, dissector: transport.NewDissector()}
+
+	if fromPublisher {
+		session.publisher, session.subscriber = flow.Source, flow.Destination
+	} else {
+		session.publisher, session.subscriber = flow.Destination, flow.Source
+	}
+
+	cd.sessions[key] = session
+	fmt.Fprintf(cd.writer, "Session #%d: subscriber %s, publisher %s\n", session.id, session.subscriber, session.publisher)
+
+	return session
+}
+
+func (cd *captureDissector) direction(session *captureSession, flow pcap.Flow) transport.CaptureDirectionEnum {
+	if flow.Source == session.publisher {
+		return transport.CaptureDirection.Received
+	}
+
+	return transport.CaptureDirection.Sent
+}
+
+func (cd *captureDissector) streamData(flow pcap.Flow, timestamp ticks.Ticks, data []byte) {
+	session := cd.session(flow, data)
+
+	if session == nil {
+		return
+	}
+
+	direction := cd.direction(session, flow)
+
+	if cd.midstream[flow] {
+		delete(cd.midstream, flow)
+		cd.writeEvent(session, session.dissector.Gap(direction, timestamp, 0))
+	}
+
+	for _, event := range session.dissector.DissectStream(direction, timestamp, data) {
+		cd.writeEvent(session, event)
+	}
+}
+
+func (cd *captureDissector) streamGap(flow pcap.Flow, timestamp ticks.Ticks, size int) {
+	if size == 0 {
+		cd.midstream[flow] = true
+		return
+	}
+
+	if session, ok := cd.sessions[newConnectionKey(flow)]; ok {
+		cd.writeEvent(session, session.dissector.Gap(cd.direction(session, flow), timestamp, size))
+	}
+}
+
+// streamReset closes the session of a connection that was reset or restarted.
+func (cd *captureDissector) streamReset(flow pcap.Flow) {
+	key := newConnectionKey(flow)
+	delete(cd.ignored, key)
+
+	if session, ok := cd.sessions[key]; ok {
+		cd.closeSession(session)
+		delete(cd.sessions, key)
+	}
+}
+
+// datagram dissects a UDP datagram sent to the data channel port of a session subscriber.
+func (cd *captureDissector) datagram(segment *pcap.Segment, timestamp ticks.Ticks) {
+	for _, session := range cd.sessions {
+		if port := session.dissector.DataChannelPort(); port > 0 && port == segment.Destination.Port() &&
+			session.subscriber.Addr() == segment.Destination.Addr() {
+			cd.writeEvent(session, session.dissector.DissectDatagram(timestamp, segment.Payload))
+			return
+		}
+	}
+}
+
+func (cd *captureDissector) closeSession(session *captureSession) {
+	for _, event := range session.dissector.Flush() {
+		cd.writeEvent(session, event)
+	}
+
+	cd.closed = append(cd.closed, session)
+}
+
+func (cd *captureDissector) writeEvent(session *captureSession, event *transport.DissectorEvent) {
+	if event.Frame != nil {
+		session.frames++
+		session.measurements += len(event.Measurements)
+	}
+
+	session.anomalies += len(event.Anomalies)
+
+	if cd.anomaliesOnly && len(event.Anomalies) == 0 {
+		return
+	}
+
+	direction := "sub->pub"
+
+	if event.Direction == transport.CaptureDirection.Received {
+		direction = "pub->sub"
+	}
+
+	if event.Channel == transport.CaptureChannel.DataChannel {
+		direction += " udp"
+	}
+
+	line := fmt.Sprintf("%s #%d %-12s", event.Timestamp.ToTime().Format(timestampFormat), session.id, direction)
+
+	if event.Frame != nil {
+		var code string
+
+		if event.Direction == transport.CaptureDirection.Sent {
+			code = event.Command.String()
+		} else if event.Response == transport.ServerResponse.Succeeded || event.Response == transport.ServerResponse.Failed {
+			code = event.Response.String() + "(" + event.Command.String() + ")"
+		} else {
+			code = event.Response.String()
+		}
+
+		line += " " + code + " [" + format.Int(len(event.Frame)) + " bytes]"
+
+		if len(event.Description) > 0 {
+			line += ":"
+		}
+	}
+
+	if len(event.Description) > 0 {
+		line += " " + strings.ReplaceAll(event.Description, "\n", " ")
+	}
+
+	fmt.Fprintln(cd.writer, strings.TrimRight(line, " "))
+
+	for _, anomaly := range event.Anomalies {
+		fmt.Fprintln(cd.writer, "    ANOMALY: "+anomaly)
+	}
+
+	count := len(event.Measurements)
+
+	if cd.maxMeasurements >= 0 && count > cd.maxMeasurements {
+		count = cd.maxMeasurements
+	}
+
+	for i := 0; i < count; i++ {
+		measurement := &event.Measurements[i]
+		fmt.Fprintf(cd.writer, "    %s %s %s %s\n", measurement.SignalID, measurement.Timestamp.ToTime().Format(timestampFormat), formatValue(float32(measurement.Value)), measurement.Flags)
+	}
+
+	if count < len(event.Measurements) {
+		fmt.Fprintf(cd.writer, "    ... %s more\n", format.Int(len(event.Measurements)-count))
+	}
+}
+
+// finish closes remaining sessions and writes the capture summary.
+func (cd *captureDissector) finish(packets, undecodable int) {
+	remaining := make([]*captureSession, 0, len(cd.sessions))
+
+	for _, session := range cd.sessions {
+		remaining = append(remaining, session)
+	}
+
+	for len(remaining) > 0 {
+		earliest := 0
+
+		for i, session := range remaining {
+			if session.id < remaining[earliest].id {
+				earliest = i
+			}
+		}
+
+		cd.closeSession(remaining[earliest])
+		remaining = append(remaining[:earliest], remaining[earliest+1:]...)
+	}
+
+	fmt.Fprintln(cd.writer)
+	fmt.Fprintf(cd.writer, "%s packets read, %s not decodable, %s STTP sessions\n", format.Int(packets), format.Int(undecodable), format.Int(len(cd.closed)))
+
+	for _, session := range cd.closed {
+		fmt.Fprintf(cd.writer, "    #%d %s -> %s: %s frames, %s measurements, %s anomalies\n", session.id, session.subscriber, session.publisher,
+			format.Int(session.frames), format.Int(session.measurements), format.Int(session.anomalies))
+	}
+}
+
+func runDissect(args []string) int {
+	flags := flag.NewFlagSet("dissect", flag.ContinueOnError)
+
+	port := flags.Uint("port", 0, "publisher TCP port, STTP connections are detected from their first frame when 0")
+	measurements := flags.Int("measurements", 5, "maximum measurements shown per data packet, -1 for all")
+	anomalies := flags.Bool("anomalies", false, "only show frames with protocol anomalies")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp dissect [flags] FILENAME")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Decodes STTP sessions from a pcap or pcapng network capture. Use -port for")
+		fmt.Fprintln(flags.Output(), "captures that started after the STTP connection was established.")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 || *port > 65535 {
+		flags.Usage()
+		return 2
+	}
+
+	file, err := os.Open(flags.Arg(0))
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	defer file.Close()
+
+	reader, err := pcap.NewReader(file)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	dissector := newCaptureDissector(os.Stdout, uint16(*port), *measurements, *anomalies)
+	assembler := pcap.NewAssembler()
+
+	assembler.DataCallback = func(flow pcap.Flow, timestamp time.Time, data []byte) {
+		dissector.streamData(flow, ticks.FromTime(timestamp), data)
+	}
+
+	assembler.GapCallback = func(flow pcap.Flow, timestamp time.Time, size int) {
+		dissector.streamGap(flow, ticks.FromTime(timestamp), size)
+	}
+
+	assembler.ResetCallback = func(flow pcap.Flow, _ time.Time) {
+		dissector.streamReset(flow)
+	}
+
+	var packets, undecodable int
+
+	for {
+		packet, err := reader.ReadPacket()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to read capture: "+err.Error())
+			return 1
+		}
+
+		packets++
+		segment, err := packet.Decode()
+
+		if err != nil {
+			undecodable++
+			continue
+		}
+
+		if segment == nil {
+			continue
+		}
+
+		if segment.Protocol == pcap.Protocol.TCP {
+			assembler.AddSegment(segment)
+		} else {
+			dissector.datagram(segment, ticks.FromTime(segment.Timestamp))
+		}
+	}
+
+	assembler.Flush()
+	dissector.finish(packets, undecodable)
+
+	return 0
+}
@@ -0,0 +1,108 @@
+//******************************************************************************************************
+//  Dissect_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/pcap"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func commandFrame(command transport.ServerCommandEnum, data []byte) []byte {
+	frame := binary.BigEndian.AppendUint32(nil, uint32(1+len(data)))
+	frame = append(frame, byte(command))
+	return append(frame, data...)
+}
+
+func responseFrame(response transport.ServerResponseEnum, command transport.ServerCommandEnum, data []byte) []byte {
+	frame := binary.BigEndian.AppendUint32(nil, uint32(6+len(data)))
+	frame = append(frame, byte(response), byte(command))
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(data)))
+	return append(frame, data...)
+}
+
+func TestCaptureDissector(t *testing.T) {
+	var output strings.Builder
+
+	subscriber := netip.MustParseAddrPort("10.0.0.1:50000")
+	publisher := netip.MustParseAddrPort("10.0.0.2:7165")
+	other := pcap.Flow{Source: netip.MustParseAddrPort("10.0.0.1:50001"), Destination: netip.MustParseAddrPort("10.0.0.3:80")}
+	sent := pcap.Flow{Source: subscriber, Destination: publisher}
+	timestamp := ticks.UtcNow()
+
+	dissector := newCaptureDissector(&output, 0, 5, false)
+
+	// Non-STTP connections are ignored
+	dissector.streamData(other, timestamp, []byte("GET / HTTP/1.1\r\n\r\n"))
+
+	connectionString := "includeTime=true;dataChannel={localport=9500}"
+	subscribe := binary.BigEndian.AppendUint32([]byte{byte(transport.DataPacketFlags.Compact)}, uint32(len(connectionString)))
+	subscribe = append(subscribe, connectionString...)
+
+	dissector.streamData(sent, timestamp, append(commandFrame(transport.ServerCommand.DefineOperationalModes, []byte{0, 0, 2, 2}),
+		commandFrame(transport.ServerCommand.Subscribe, subscribe)...))
+
+	// Session direction is determined from session endpoints rather than frame contents
+	dissector.streamData(sent.Reverse(), timestamp, responseFrame(transport.ServerResponse.Succeeded, transport.ServerCommand.DefineOperationalModes, []byte("modes defined")))
+
+	// UDP data channel datagrams are routed to the subscribing session
+	dissector.datagram(&pcap.Segment{
+		Protocol:    pcap.Protocol.UDP,
+		Source:      netip.MustParseAddrPort("10.0.0.2:6165"),
+		Destination: netip.MustParseAddrPort("10.0.0.1:9500"),
+		Payload:     responseFrame(transport.ServerResponse.DataPacket, transport.ServerCommand.Subscribe, []byte{byte(transport.DataPacketFlags.Compact), 0, 0, 0, 1})[4:],
+	}, timestamp)
+
+	// Incomplete frame at end of capture
+	dissector.streamData(sent.Reverse(), timestamp, []byte{0, 0})
+	dissector.finish(10, 1)
+
+	result := output.String()
+
+	for _, expected := range []string{
+		"Session #1: subscriber 10.0.0.1:50000, publisher 10.0.0.2:7165",
+		"#1 sub->pub     DefineOperationalModes [5 bytes]: version 2, UTF8",
+		"#1 sub->pub     Subscribe [",
+		"dataChannel=9500",
+		"#1 pub->sub     Succeeded(DefineOperationalModes) [19 bytes]: modes defined",
+		"#1 pub->sub udp DataPacket [11 bytes]: 1 measurements",
+		"    ANOMALY: no signal index cache received for cache index 0",
+		"    ANOMALY: capture ended with 2 bytes of incomplete frame data",
+		"10 packets read, 1 not decodable, 1 STTP sessions",
+		"#1 10.0.0.1:50000 -> 10.0.0.2:7165: 4 frames, 0 measurements, 2 anomalies",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatal("TestCaptureDissector: expected output to contain \"" + expected + "\", output:\n" + result)
+		}
+	}
+
+	if strings.Contains(result, "10.0.0.3") {
+		t.Fatal("TestCaptureDissector: unexpected non-STTP session in output:\n" + result)
+	}
+}
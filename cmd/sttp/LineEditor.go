@@ -0,0 +1,346 @@
+//******************************************************************************************************
+//  LineEditor.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// lineEditor reads lines from an interactive terminal with cursor movement, history and tab completion.
+// When input is not a terminal, or the terminal mode cannot be changed with stty, lines are read without
+// editing support.
+type lineEditor struct {
+	input        *bufio.Reader
+	output       io.Writer
+	complete     func(line string) (int, []string)
+	history      []string
+	editing      bool
+	terminalMode string
+}
+
+// newLineEditor creates a lineEditor for stdin and stdout using the specified completion function.
+func newLineEditor(complete func(line string) (int, []string)) *lineEditor {
+	editor := &lineEditor{
+		input:    bufio.NewReader(os.Stdin),
+		output:   os.Stdout,
+		complete: complete,
+	}
+
+	if fileInfo, err := os.Stdin.Stat(); err != nil || fileInfo.Mode()&os.ModeCharDevice == 0 {
+		return editor
+	}
+
+	// Save terminal mode, then disable line buffering, echo and signals so keys are handled here
+	if mode, err := stty("-g"); err == nil {
+		if _, err := stty("-icanon", "-echo", "-isig", "min", "1", "time", "0"); err == nil {
+			editor.terminalMode = strings.TrimSpace(mode)
+			editor.editing = true
+		}
+	}
+
+	return editor
+}
+
+func stty(args ...string) (string, error) {
+	command := exec.Command("stty", args...)
+	command.Stdin = os.Stdin
+	output, err := command.Output()
+	return string(output), err
+}
+
+// Close restores the terminal mode.
+func (le *lineEditor) Close() {
+	if len(le.terminalMode) > 0 {
+		stty(le.terminalMode)
+		le.terminalMode = ""
+	}
+}
+
+// ReadLine writes the prompt and reads a line. Returns io.EOF at end of input or when Ctrl-D is
+// pressed on an empty line.
+func (le *lineEditor) ReadLine(prompt string) (string, error) {
+	io.WriteString(le.output, prompt)
+
+	if !le.editing {
+		line, err := le.input.ReadString('\n')
+
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		}
+
+		return strings.TrimRight(line, "\r\n"), err
+	}
+
+	var buffer []rune
+	var cursor int
+	var lastKeyTab bool
+	historyIndex := len(le.history)
+	draft := ""
+
+	redraw := func() {
+		var image strings.Builder
+
+		image.WriteString("\r" + prompt + string(buffer) + "\x1b[K")
+
+		if cursor < len(buffer) {
+			image.WriteString("\x1b[" + strconv.Itoa(len(buffer)-cursor) + "D")
+		}
+
+		io.WriteString(le.output, image.String())
+	}
+
+	setLine := func(line string) {
+		buffer = []rune(line)
+		cursor = len(buffer)
+		redraw()
+	}
+
+	for {
+		key, _, err := le.input.ReadRune()
+
+		if err != nil {
+			return "", err
+		}
+
+		tab := key == '\t'
+
+		switch key {
+		case '\r', '\n':
+			io.WriteString(le.output, "\r\n")
+			line := string(buffer)
+
+			if len(strings.TrimSpace(line)) > 0 && (len(le.history) == 0 || le.history[len(le.history)-1] != line) {
+				le.history = append(le.history, line)
+			}
+
+			return line, nil
+		case 0x03: // Ctrl-C, discard line
+			io.WriteString(le.output, "^C\r\n")
+			buffer, cursor = nil, 0
+			historyIndex = len(le.history)
+			redraw()
+		case 0x04: // Ctrl-D, end input on empty line or delete at cursor
+			if len(buffer) == 0 {
+				io.WriteString(le.output, "\r\n")
+				return "", io.EOF
+			}
+
+			if cursor < len(buffer) {
+				buffer = append(buffer[:cursor], buffer[cursor+1:]...)
+				redraw()
+			}
+		case 0x7F, 0x08: // Backspace
+			if cursor > 0 {
+				buffer = append(buffer[:cursor-1], buffer[cursor:]...)
+				cursor--
+				redraw()
+			}
+		case 0x01: // Ctrl-A
+			cursor = 0
+			redraw()
+		case 0x05: // Ctrl-E
+			cursor = len(buffer)
+			redraw()
+		case 0x0B: // Ctrl-K
+			buffer = buffer[:cursor]
+			redraw()
+		case 0x15: // Ctrl-U
+			buffer = append([]rune{}, buffer[cursor:]...)
+			cursor = 0
+			redraw()
+		case '\t':
+			le.completeLine(&buffer, &cursor, lastKeyTab)
+			redraw()
+		case 0x1B:
+			switch le.readEscapeSequence() {
+			case 'A': // Up
+				if historyIndex > 0 {
+					if historyIndex == len(le.history) {
+						draft = string(buffer)
+					}
+
+					historyIndex--
+					setLine(le.history[historyIndex])
+				}
+			case 'B': // Down
+				if historyIndex < len(le.history) {
+					historyIndex++
+
+					if historyIndex == len(le.history) {
+						setLine(draft)
+					} else {
+						setLine(le.history[historyIndex])
+					}
+				}
+			case 'C': // Right
+				if cursor < len(buffer) {
+					cursor++
+					redraw()
+				}
+			case 'D': // Left
+				if cursor > 0 {
+					cursor--
+					redraw()
+				}
+			case 'H': // Home
+				cursor = 0
+				redraw()
+			case 'F': // End
+				cursor = len(buffer)
+				redraw()
+			case '3': // Delete
+				if cursor < len(buffer) {
+					buffer = append(buffer[:cursor], buffer[cursor+1:]...)
+					redraw()
+				}
+			}
+		default:
+			if unicode.IsPrint(key) {
+				buffer = append(buffer[:cursor], append([]rune{key}, buffer[cursor:]...)...)
+				cursor++
+				redraw()
+			}
+		}
+
+		lastKeyTab = tab
+	}
+}
+
+// readEscapeSequence reads a CSI or SS3 escape sequence and returns its final character, or the
+// parameter digit for sequences such as Delete, i.e., "ESC [ 3 ~".
+func (le *lineEditor) readEscapeSequence() rune {
+	if introducer, _, err := le.input.ReadRune(); err != nil || introducer != '[' && introducer != 'O' {
+		return 0
+	}
+
+	key, _, err := le.input.ReadRune()
+
+	if err != nil {
+		return 0
+	}
+
+	if key >= '0' && key <= '9' {
+		// Skip any remaining parameters through the final character
+		for {
+			next, _, err := le.input.ReadRune()
+
+			if err != nil || next >= 0x40 && next <= 0x7E {
+				break
+			}
+		}
+	}
+
+	return key
+}
+
+// completeLine replaces the partial name before the cursor with its only candidate or with the longest
+// common prefix of its candidates. Candidates are listed when a repeated tab cannot extend the name.
+func (le *lineEditor) completeLine(buffer *[]rune, cursor *int, listCandidates bool) {
+	if le.complete == nil {
+		return
+	}
+
+	line := string((*buffer)[:*cursor])
+	start, candidates := le.complete(line)
+
+	if len(candidates) == 0 {
+		io.WriteString(le.output, "\a")
+		return
+	}
+
+	partial := line[start:]
+	replacement := candidates[0]
+
+	for _, candidate := range candidates[1:] {
+		replacement = commonPrefix(replacement, candidate)
+	}
+
+	if len(candidates) > 1 && utf8.RuneCountInString(replacement) <= utf8.RuneCountInString(partial) {
+		if listCandidates {
+			io.WriteString(le.output, "\r\n"+formatCandidates(candidates)+"\r\n")
+		} else {
+			io.WriteString(le.output, "\a")
+		}
+
+		return
+	}
+
+	startIndex := utf8.RuneCountInString(line[:start])
+	completed := append(append([]rune{}, (*buffer)[:startIndex]...), []rune(replacement)...)
+	*buffer = append(completed, (*buffer)[*cursor:]...)
+	*cursor = len(completed)
+}
+
+// commonPrefix gets the longest common prefix of two names, ignoring case, using the case of left.
+func commonPrefix(left, right string) string {
+	leftRunes, rightRunes := []rune(left), []rune(right)
+	length := 0
+
+	for length < len(leftRunes) && length < len(rightRunes) && unicode.ToUpper(leftRunes[length]) == unicode.ToUpper(rightRunes[length]) {
+		length++
+	}
+
+	return string(leftRunes[:length])
+}
+
+// formatCandidates formats completion candidates in columns that fit an 80 character line.
+func formatCandidates(candidates []string) string {
+	width := 0
+
+	for _, candidate := range candidates {
+		if length := utf8.RuneCountInString(candidate); length > width {
+			width = length
+		}
+	}
+
+	width += 2
+	columns := 80 / width
+
+	if columns < 1 {
+		columns = 1
+	}
+
+	var image strings.Builder
+
+	for i, candidate := range candidates {
+		if i > 0 && i%columns == 0 {
+			image.WriteString("\r\n")
+		}
+
+		image.WriteString(candidate)
+
+		if i%columns < columns-1 && i < len(candidates)-1 {
+			image.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(candidate)))
+		}
+	}
+
+	return image.String()
+}
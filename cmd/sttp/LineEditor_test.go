@@ -0,0 +1,92 @@
+//******************************************************************************************************
+//  LineEditor_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+)
+
+func TestLineEditor(t *testing.T) {
+	dataSet, err := loadMetadataFile("../../test/MetadataSample2.xml")
+
+	if err != nil {
+		t.Fatal("TestLineEditor: failed to load metadata: " + err.Error())
+	}
+
+	shell := data.NewQueryShell(dataSet)
+
+	// Completes table and column names, edits with cursor keys, recalls history then ends with Ctrl-D
+	input := "filter Pha\t WHERE Lab\t = 'x'\r" +
+		".tables\r" +
+		"\x1b[A\x1b[A\x1b[D\x1b[D\x1b[3~y\r" +
+		"FILTER M\t WHERE Signal\t\t\x15\x03" +
+		"\x04"
+
+	var output strings.Builder
+
+	editor := &lineEditor{
+		input:    bufio.NewReader(strings.NewReader(input)),
+		output:   &output,
+		complete: shell.Complete,
+		editing:  true,
+	}
+
+	for _, expected := range []string{"filter PhasorDetail WHERE Label = 'x'", ".tables", "filter PhasorDetail WHERE Label = 'y'"} {
+		line, err := editor.ReadLine("> ")
+
+		if err != nil || line != expected {
+			t.Fatal("TestLineEditor: expected line \"" + expected + "\", received \"" + line + "\"")
+		}
+	}
+
+	if _, err := editor.ReadLine("> "); err != io.EOF {
+		t.Fatal("TestLineEditor: expected end of input")
+	}
+
+	if !strings.Contains(output.String(), "\r\nSignalAcronym    SignalID         SignalReference\r\n") {
+		t.Fatal("TestLineEditor: expected candidates to be listed on repeated tab")
+	}
+
+	if len(editor.history) != 3 {
+		t.Fatal("TestLineEditor: expected 3 history entries")
+	}
+
+	// Lines are read without editing when input is not a terminal
+	editor = &lineEditor{input: bufio.NewReader(strings.NewReader(".tables\r\n.exit")), output: &output}
+
+	for _, expected := range []string{".tables", ".exit"} {
+		if line, err := editor.ReadLine("> "); err != nil || line != expected {
+			t.Fatal("TestLineEditor: expected line \"" + expected + "\", received \"" + line + "\"")
+		}
+	}
+
+	if _, err := editor.ReadLine("> "); err != io.EOF {
+		t.Fatal("TestLineEditor: expected end of input")
+	}
+}
@@ -0,0 +1,319 @@
+//******************************************************************************************************
+//  LoadGen.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// loadGenColumns defines the record columns written for each load generator encoding phase.
+var loadGenColumns = []string{"Encoding", "Signals", "FramesPerSecond", "Measurements", "MeasurementsPerSecond", "Bytes", "BytesPerSecond",
+	"Discarded", "MinLatencyMs", "AvgLatencyMs", "P99LatencyMs", "MaxLatencyMs"}
+
+// loadGenPhase defines a payload encoding benchmarked by the loadgen command.
+type loadGenPhase struct {
+	name       string
+	compressed bool
+}
+
+var loadGenPhases = []loadGenPhase{
+	{"compact", false},
+	{"tssc", true},
+}
+
+// latencyRecorder collects the latency of each received data packet, i.e., the time between
+// publication of a frame and its reception by the subscriber.
+type latencyRecorder struct {
+	mutex        sync.Mutex
+	skew         ticks.Ticks
+	latencies    []ticks.Ticks
+	measurements uint64
+}
+
+func (lr *latencyRecorder) record(measurements []transport.Measurement) {
+	if len(measurements) == 0 {
+		return
+	}
+
+	// All measurements in a data packet are from the same frame
+	latency := ticks.UtcNow() - (measurements[0].Timestamp - lr.skew)
+
+	lr.mutex.Lock()
+	lr.latencies = append(lr.latencies, latency)
+	lr.measurements += uint64(len(measurements))
+	lr.mutex.Unlock()
+}
+
+// reset clears recorded latencies and returns the sorted latencies and measurement count recorded before the reset.
+func (lr *latencyRecorder) reset() ([]ticks.Ticks, uint64) {
+	lr.mutex.Lock()
+	latencies, measurements := lr.latencies, lr.measurements
+	lr.latencies, lr.measurements = nil, 0
+	lr.mutex.Unlock()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	return latencies, measurements
+}
+
+// latencySummary gets the minimum, average, 99th percentile and maximum of sorted latencies in milliseconds.
+func latencySummary(latencies []ticks.Ticks) []interface{} {
+	if len(latencies) == 0 {
+		return []interface{}{nil, nil, nil, nil}
+	}
+
+	milliseconds := func(value ticks.Ticks) float64 {
+		return math.Round(float64(value)/float64(ticks.PerMillisecond)*1000) / 1000
+	}
+
+	var total ticks.Ticks
+
+	for _, latency := range latencies {
+		total += latency
+	}
+
+	p99 := latencies[int(math.Ceil(0.99*float64(len(latencies))))-1]
+
+	return []interface{}{
+		milliseconds(latencies[0]),
+		milliseconds(total / ticks.Ticks(len(latencies))),
+		milliseconds(p99),
+		milliseconds(latencies[len(latencies)-1]),
+	}
+}
+
+func parseWaveform(value string) (transport.WaveformEnum, error) {
+	for _, waveform := range []transport.WaveformEnum{transport.Waveform.Sine, transport.Waveform.Ramp, transport.Waveform.Noise} {
+		if strings.EqualFold(value, waveform.String()) {
+			return waveform, nil
+		}
+	}
+
+	return 0, errors.New("unsupported waveform \"" + value + "\": expected sine, ramp or noise")
+}
+
+func runLoadGen(args []string) int {
+	flags := flag.NewFlagSet("loadgen", flag.ContinueOnError)
+	generator := transport.NewLoadGenerator()
+	settings := sttp.NewSettings()
+
+	flags.IntVar(&generator.SignalCount, "signals", generator.SignalCount, "number of signals published in each frame")
+	flags.IntVar(&generator.FramesPerSecond, "fps", generator.FramesPerSecond, "number of frames published per second")
+	waveform := flags.String("waveform", "sine", "signal waveform: sine (phasors), ramp or noise")
+	flags.Float64Var(&generator.QualityFlagRate, "quality-rate", 0, "fraction, from 0 to 1, of measurements published with bad data quality flags")
+	flags.DurationVar(&generator.ClockSkew, "skew", 0, "clock skew applied to published timestamps, e.g., -2s")
+	flags.Int64Var(&generator.Seed, "seed", generator.Seed, "seed for noise values and quality flag injection")
+
+	flags.BoolVar(&settings.EnableTimeReasonabilityCheck, "time-check", false, "request publisher time reasonability checks")
+	flags.Float64Var(&settings.LagTime, "lag-time", settings.LagTime, "allowed past time deviation, in seconds, for time reasonability checks")
+	flags.Float64Var(&settings.LeadTime, "lead-time", settings.LeadTime, "allowed future time deviation, in seconds, for time reasonability checks")
+	flags.BoolVar(&settings.UseLocalClockAsRealTime, "local-clock", true, "use local clock as real time for time reasonability checks")
+	flags.BoolVar(&settings.UseMillisecondResolution, "ms-resolution", false, "request millisecond resolution timestamps for compact encoding")
+
+	encoding := flags.String("encoding", "both", "payload encoding to benchmark: compact, tssc or both")
+	duration := flags.Duration("duration", 10*time.Second, "benchmark duration for each encoding")
+	format := flags.String("format", "table", "output format: table, json (JSON lines) or csv")
+	verbose := flags.Bool("verbose", false, "write status messages to stderr")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp loadgen [flags]")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Publishes synthetic signals on loopback and reports the throughput and latency of a connected subscriber.")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 0 {
+		fmt.Fprintf(os.Stderr, "Expected no arguments, received %d\n\n", flags.NArg())
+		flags.Usage()
+		return 2
+	}
+
+	var err error
+
+	if generator.Waveform, err = parseWaveform(*waveform); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	var phases []loadGenPhase
+
+	for _, phase := range loadGenPhases {
+		if strings.EqualFold(*encoding, "both") || strings.EqualFold(*encoding, phase.name) {
+			phases = append(phases, phase)
+		}
+	}
+
+	if len(phases) == 0 {
+		fmt.Fprintln(os.Stderr, "Unsupported encoding \""+*encoding+"\": expected compact, tssc or both")
+		return 2
+	}
+
+	if *duration <= 0 {
+		fmt.Fprintln(os.Stderr, "Benchmark duration must be greater than zero")
+		return 2
+	}
+
+	writer, err := newRecordWriter(*format, os.Stdout, loadGenColumns)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	if err := generator.Start("127.0.0.1:0"); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to start load generator: "+err.Error())
+		return 1
+	}
+
+	defer generator.Stop()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	for _, phase := range phases {
+		record, interrupted, err := runLoadGenPhase(generator, phase, settings, *duration, *verbose, interrupt)
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return 1
+		}
+
+		if err = writer.WriteRecord(record); err == nil {
+			err = writer.Flush()
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to write load generator results: "+err.Error())
+			return 1
+		}
+
+		if interrupted {
+			break
+		}
+	}
+
+	return 0
+}
+
+// runLoadGenPhase subscribes to the load generator with the specified payload encoding and gets the
+// results recorded over the benchmark duration, see loadGenColumns.
+func runLoadGenPhase(generator *transport.LoadGenerator, phase loadGenPhase, settings *sttp.Settings, duration time.Duration, verbose bool, interrupt <-chan os.Signal) ([]interface{}, bool, error) {
+	recorder := &latencyRecorder{skew: ticks.Ticks(generator.ClockSkew.Nanoseconds() / 100)}
+	subscriber := sttp.NewSubscriber()
+	defer subscriber.Close()
+
+	subscriber.SetStatusMessageLogger(func(message string) {
+		if verbose {
+			fmt.Fprintln(os.Stderr, message)
+		}
+	})
+
+	subscriber.SetErrorMessageLogger(func(message string) {
+		fmt.Fprintln(os.Stderr, message)
+	})
+
+	// Connection is closed at the end of each phase, only report termination as a status message
+	subscriber.SetConnectionTerminatedReceiver(func() {
+		subscriber.StatusMessage("Connection for " + subscriber.ConnectionID() + " terminated.")
+	})
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		recorder.record(*measurements)
+		subscriber.PutMeasurementSlice(measurements)
+	})
+
+	config := sttp.NewConfig()
+	config.AutoReconnect = false
+	config.CompressPayloadData = phase.compressed
+
+	subscriber.Subscribe(defaultFilterExpression, settings)
+
+	if err := subscriber.Dial(generator.Address(), config); err != nil {
+		return nil, false, errors.New("failed to connect to load generator: " + err.Error())
+	}
+
+	// Results are recorded once subscribed, excluding connection and metadata exchange
+	for deadline := time.Now().Add(10 * time.Second); !subscriber.IsSubscribed(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			return nil, false, errors.New("timeout waiting for load generator subscription")
+		}
+	}
+
+	recorder.reset()
+	started := time.Now()
+	startBytes := subscriber.TotalCommandChannelBytesReceived() + subscriber.TotalDataChannelBytesReceived()
+	startDiscarded := generator.MeasurementsDiscarded()
+	interrupted := false
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-interrupt:
+		interrupted = true
+	case <-timer.C:
+	}
+
+	latencies, measurements := recorder.reset()
+	seconds := time.Since(started).Seconds()
+	bytes := subscriber.TotalCommandChannelBytesReceived() + subscriber.TotalDataChannelBytesReceived() - startBytes
+
+	rate := func(total uint64) float64 {
+		return math.Round(float64(total)/seconds*10) / 10
+	}
+
+	record := []interface{}{
+		phase.name,
+		generator.SignalCount,
+		generator.FramesPerSecond,
+		measurements,
+		rate(measurements),
+		bytes,
+		rate(bytes),
+		generator.MeasurementsDiscarded() - startDiscarded,
+	}
+
+	return append(record, latencySummary(latencies)...), interrupted, nil
+}
@@ -0,0 +1,279 @@
+//******************************************************************************************************
+//  Metadata.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/data"
+)
+
+func runMetadata(args []string) int {
+	flags := flag.NewFlagSet("metadata", flag.ContinueOnError)
+	connection := addConnectionFlags(flags)
+
+	format := flags.String("format", "table", "output format: table, json (JSON lines) or csv")
+	fileName := flags.String("file", "", "read metadata from a cached XML metadata file instead of a publisher")
+	timeout := flags.Duration("timeout", 30*time.Second, "maximum time to wait for metadata from publisher")
+	tableName := flags.String("table", "", "dump rows of the specified table, lists tables when no table or query is specified")
+	where := flags.String("where", "", "filter expression, without WHERE keyword, for rows of selected table")
+	sortOrder := flags.String("sort", "", "comma separated sort order for rows of selected table, e.g., \"PointTag DESC\"")
+	top := flags.Int("top", -1, "maximum number of rows of selected table, -1 for all rows")
+	query := flags.String("query", "", "SELECT statement to evaluate, e.g., \"SELECT SignalType, COUNT(*) AS Total FROM ActiveMeasurements GROUP BY SignalType\"")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp metadata [flags] HOSTNAME:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp metadata [flags] -listen [INTERFACE]:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp metadata [flags] -file FILENAME")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	dataSet, code := loadMetadata(flags, connection, *fileName, *timeout)
+
+	if dataSet == nil {
+		return code
+	}
+
+	var err error
+
+	switch {
+	case len(*query) > 0:
+		err = writeQuery(os.Stdout, *format, dataSet, *query)
+	case len(*tableName) > 0:
+		err = writeTableRows(os.Stdout, *format, dataSet, *tableName, *where, *sortOrder, *top)
+	default:
+		err = writeTableList(os.Stdout, *format, dataSet)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+// loadMetadata loads metadata from the specified file or, when no file is specified, from the publisher
+// at the HOSTNAME:PORT argument of the parsed flags. When metadata cannot be loaded, an error is shown and
+// nil is returned with the exit code.
+func loadMetadata(flags *flag.FlagSet, connection *connectionOptions, fileName string, timeout time.Duration) (*data.DataSet, int) {
+	var dataSet *data.DataSet
+	var err error
+
+	if len(fileName) > 0 {
+		if flags.NArg() > 0 {
+			fmt.Fprintln(os.Stderr, "Unexpected HOSTNAME:PORT argument when reading metadata from a file")
+			return nil, 2
+		}
+
+		dataSet, err = loadMetadataFile(fileName)
+	} else {
+		if flags.NArg() != 1 {
+			fmt.Fprintf(os.Stderr, "Expected one HOSTNAME:PORT argument, received %d\n\n", flags.NArg())
+			flags.Usage()
+			return nil, 2
+		}
+
+		dataSet, err = receiveMetadata(connection, flags.Arg(0), timeout)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return nil, 1
+	}
+
+	return dataSet, 0
+}
+
+// loadMetadataFile loads metadata from an XML file, e.g., a file saved with Config.MetadataCachePath.
+func loadMetadataFile(fileName string) (*data.DataSet, error) {
+	metadata, err := os.ReadFile(fileName)
+
+	if err != nil {
+		return nil, errors.New("failed to read metadata file: " + err.Error())
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err := dataSet.ParseXml(metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse XML metadata \"%s\": %s", fileName, err.Error())
+	}
+
+	return dataSet, nil
+}
+
+// receiveMetadata connects to, or listens for, a publisher and waits for it to send metadata.
+func receiveMetadata(connection *connectionOptions, address string, timeout time.Duration) (*data.DataSet, error) {
+	subscriber := sttp.NewSubscriber()
+	defer subscriber.Close()
+
+	received := make(chan *data.DataSet, 1)
+
+	subscriber.SetMetadataReceiver(func(dataSet *data.DataSet) {
+		// Cached metadata is loaded before connection, wait for metadata from publisher
+		if !subscriber.IsConnected() {
+			return
+		}
+
+		select {
+		case received <- dataSet:
+		default:
+		}
+	})
+
+	connection.config.AutoRequestMetadata = true
+	connection.config.AutoSubscribe = false
+
+	if err := connection.start(subscriber, address); err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	var dataSet *data.DataSet
+
+	go func() {
+		dataSet = <-received
+		close(done)
+	}()
+
+	waitForExit(done, timeout)
+
+	select {
+	case <-done:
+		return dataSet, nil
+	default:
+		return nil, errors.New("no metadata received from STTP publisher")
+	}
+}
+
+func writeTableList(writer io.Writer, format string, dataSet *data.DataSet) error {
+	output, err := newRecordWriter(format, writer, []string{"Table", "Columns", "Rows"})
+
+	if err != nil {
+		return err
+	}
+
+	tables := dataSet.Tables()
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name() < tables[j].Name()
+	})
+
+	for _, table := range tables {
+		if err := output.WriteRecord([]interface{}{table.Name(), table.ColumnCount(), table.RowCount()}); err != nil {
+			return err
+		}
+	}
+
+	return output.Flush()
+}
+
+func writeTableRows(writer io.Writer, format string, dataSet *data.DataSet, tableName string, where string, sortOrder string, top int) error {
+	table := dataSet.Table(tableName)
+
+	if table == nil {
+		return errors.New("failed to find table \"" + tableName + "\" in metadata")
+	}
+
+	rows, err := table.Select(where, sortOrder, top)
+
+	if err != nil {
+		return err
+	}
+
+	return writeRows(writer, format, table, rows)
+}
+
+func writeQuery(writer io.Writer, format string, dataSet *data.DataSet, query string) error {
+	table, err := data.SelectDataTable(dataSet, query, true)
+
+	if err != nil {
+		return err
+	}
+
+	return writeRows(writer, format, table, table.Rows())
+}
+
+func writeRows(writer io.Writer, format string, table *data.DataTable, rows []*data.DataRow) error {
+	columns := make([]string, table.ColumnCount())
+
+	for i := range columns {
+		columns[i] = table.Column(i).Name()
+	}
+
+	output, err := newRecordWriter(format, writer, columns)
+
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(columns))
+
+	for _, row := range rows {
+		if row == nil {
+			continue
+		}
+
+		for i := range values {
+			values[i] = rowValue(row, i)
+		}
+
+		if err := output.WriteRecord(values); err != nil {
+			return err
+		}
+	}
+
+	return output.Flush()
+}
+
+// rowValue gets a row value with a JSON friendly type: numeric and boolean values keep their type,
+// other values use their string form and nulls are nil.
+func rowValue(row *data.DataRow, columnIndex int) interface{} {
+	value, err := row.Value(columnIndex)
+
+	if err != nil || value == nil {
+		return nil
+	}
+
+	switch value.(type) {
+	case bool, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float32, float64:
+		return value
+	default:
+		return row.ValueAsString(columnIndex)
+	}
+}
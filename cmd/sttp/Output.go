@@ -0,0 +1,277 @@
+//******************************************************************************************************
+//  Output.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// timestampFormat defines the fixed width, full tick resolution, format used for timestamps.
+const timestampFormat = "2006-01-02 15:04:05.0000000"
+
+// measurementColumns defines the record columns written for each measurement.
+var measurementColumns = []string{"SignalID", "PointTag", "Timestamp", "Value", "Flags"}
+
+// recordWriter writes records of values with a fixed set of columns in a selected output format.
+type recordWriter interface {
+	// WriteRecord writes a record with one value for each column.
+	WriteRecord(values []interface{}) error
+
+	// Flush writes any buffered records to the underlying writer.
+	Flush() error
+}
+
+// newRecordWriter creates a recordWriter for the specified output format: table, json or csv.
+func newRecordWriter(format string, writer io.Writer, columns []string) (recordWriter, error) {
+	switch strings.ToLower(format) {
+	case "table":
+		return &tableWriter{writer: bufio.NewWriter(writer), columns: columns, widths: make([]int, len(columns))}, nil
+	case "json", "jsonl":
+		return &jsonWriter{writer: bufio.NewWriter(writer), columns: columns}, nil
+	case "csv":
+		return &csvWriter{writer: csv.NewWriter(writer), columns: columns}, nil
+	default:
+		return nil, errors.New("unsupported output format \"" + format + "\": expected table, json or csv")
+	}
+}
+
+// measurementRecord gets the column values for a measurement, see measurementColumns.
+func measurementRecord(measurement *transport.Measurement, metadata *transport.MeasurementMetadata, value float64) []interface{} {
+	var pointTag string
+
+	if metadata != nil {
+		pointTag = metadata.Tag
+	}
+
+	return []interface{}{
+		measurement.SignalID.String(),
+		pointTag,
+		measurement.Timestamp.ToTime().Format(timestampFormat),
+		value,
+		measurement.Flags.String(),
+	}
+}
+
+func formatValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', -1, 32)
+	case time.Time:
+		return value.Format(timestampFormat)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// tableWriter writes records as aligned columns. Records are buffered until Flush so that column
+// widths fit the buffered values; widths never shrink so that columns stay aligned across flushes.
+type tableWriter struct {
+	writer        *bufio.Writer
+	columns       []string
+	widths        []int
+	rows          [][]string
+	headerWritten bool
+}
+
+func (tw *tableWriter) WriteRecord(values []interface{}) error {
+	row := make([]string, len(tw.columns))
+
+	for i := range row {
+		if i < len(values) {
+			row[i] = formatValue(values[i])
+		}
+	}
+
+	tw.rows = append(tw.rows, row)
+	return nil
+}
+
+func (tw *tableWriter) Flush() error {
+	if !tw.headerWritten {
+		tw.updateWidths(tw.columns)
+	}
+
+	for _, row := range tw.rows {
+		tw.updateWidths(row)
+	}
+
+	if !tw.headerWritten {
+		tw.writeRow(tw.columns)
+
+		separators := make([]string, len(tw.columns))
+
+		for i, width := range tw.widths {
+			separators[i] = strings.Repeat("-", width)
+		}
+
+		tw.writeRow(separators)
+		tw.headerWritten = true
+	}
+
+	for _, row := range tw.rows {
+		tw.writeRow(row)
+	}
+
+	tw.rows = tw.rows[:0]
+	return tw.writer.Flush()
+}
+
+func (tw *tableWriter) updateWidths(row []string) {
+	for i, value := range row {
+		if width := utf8.RuneCountInString(value); width > tw.widths[i] {
+			tw.widths[i] = width
+		}
+	}
+}
+
+func (tw *tableWriter) writeRow(row []string) {
+	for i, value := range row {
+		if i > 0 {
+			tw.writer.WriteString("  ")
+		}
+
+		tw.writer.WriteString(value)
+
+		// Last column is not padded to avoid trailing spaces
+		if i < len(row)-1 {
+			tw.writer.WriteString(strings.Repeat(" ", tw.widths[i]-utf8.RuneCountInString(value)))
+		}
+	}
+
+	tw.writer.WriteByte('\n')
+}
+
+// jsonWriter writes each record as a JSON object on its own line, i.e., JSON lines, with keys in
+// column order. Non-finite numbers are written as null.
+type jsonWriter struct {
+	writer  *bufio.Writer
+	columns []string
+}
+
+func (jw *jsonWriter) WriteRecord(values []interface{}) error {
+	jw.writer.WriteByte('{')
+
+	for i, column := range jw.columns {
+		if i > 0 {
+			jw.writer.WriteByte(',')
+		}
+
+		key, _ := json.Marshal(column)
+		jw.writer.Write(key)
+		jw.writer.WriteByte(':')
+
+		var value interface{}
+
+		if i < len(values) {
+			value = values[i]
+		}
+
+		switch typedValue := value.(type) {
+		case float64:
+			if math.IsNaN(typedValue) || math.IsInf(typedValue, 0) {
+				value = nil
+			}
+		case float32:
+			if math.IsNaN(float64(typedValue)) || math.IsInf(float64(typedValue), 0) {
+				value = nil
+			}
+		case time.Time:
+			value = typedValue.Format(timestampFormat)
+		}
+
+		encoded, err := json.Marshal(value)
+
+		if err != nil {
+			return err
+		}
+
+		jw.writer.Write(encoded)
+	}
+
+	jw.writer.WriteString("}\n")
+	return nil
+}
+
+func (jw *jsonWriter) Flush() error {
+	return jw.writer.Flush()
+}
+
+// csvWriter writes records as comma-separated values preceded by a header record of column names.
+type csvWriter struct {
+	writer        *csv.Writer
+	columns       []string
+	headerWritten bool
+}
+
+func (cw *csvWriter) WriteRecord(values []interface{}) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	record := make([]string, len(cw.columns))
+
+	for i := range record {
+		if i < len(values) {
+			record[i] = formatValue(values[i])
+		}
+	}
+
+	return cw.writer.Write(record)
+}
+
+func (cw *csvWriter) writeHeader() error {
+	if cw.headerWritten {
+		return nil
+	}
+
+	cw.headerWritten = true
+	return cw.writer.Write(cw.columns)
+}
+
+func (cw *csvWriter) Flush() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
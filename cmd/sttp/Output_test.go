@@ -0,0 +1,128 @@
+//******************************************************************************************************
+//  Output_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func TestRecordWriters(t *testing.T) {
+	signalID, _ := guid.Parse("93673c68-d59d-4926-b7e9-e7678f9f66b4")
+	timestamp := ticks.FromTime(time.Date(2026, 10, 18, 12, 30, 0, 1234500, time.UTC))
+
+	measurements := []transport.Measurement{
+		{SignalID: signalID, Value: 59.98, Timestamp: timestamp},
+		{SignalID: signalID, Value: math.NaN(), Timestamp: timestamp + ticks.PerSecond},
+	}
+
+	metadata := &transport.MeasurementMetadata{SignalID: signalID, Tag: "SHELBY:FREQ"}
+
+	write := func(format string) string {
+		var output strings.Builder
+		writer, err := newRecordWriter(format, &output, measurementColumns)
+
+		if err != nil {
+			t.Fatal("TestRecordWriters: failed to create " + format + " writer: " + err.Error())
+		}
+
+		for i := range measurements {
+			if err := writer.WriteRecord(measurementRecord(&measurements[i], metadata, measurements[i].Value)); err != nil {
+				t.Fatal("TestRecordWriters: failed to write " + format + " record: " + err.Error())
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			t.Fatal("TestRecordWriters: failed to flush " + format + " writer: " + err.Error())
+		}
+
+		return output.String()
+	}
+
+	expected := "{\"SignalID\":\"{93673c68-d59d-4926-b7e9-e7678f9f66b4}\",\"PointTag\":\"SHELBY:FREQ\",\"Timestamp\":\"2026-10-18 12:30:00.0012345\",\"Value\":59.98,\"Flags\":\"Normal\"}\n" +
+		"{\"SignalID\":\"{93673c68-d59d-4926-b7e9-e7678f9f66b4}\",\"PointTag\":\"SHELBY:FREQ\",\"Timestamp\":\"2026-10-18 12:30:01.0012345\",\"Value\":null,\"Flags\":\"Normal\"}\n"
+
+	if result := write("json"); result != expected {
+		t.Fatal("TestRecordWriters: unexpected JSON lines output:\n" + result)
+	}
+
+	expected = "SignalID,PointTag,Timestamp,Value,Flags\n" +
+		"{93673c68-d59d-4926-b7e9-e7678f9f66b4},SHELBY:FREQ,2026-10-18 12:30:00.0012345,59.98,Normal\n" +
+		"{93673c68-d59d-4926-b7e9-e7678f9f66b4},SHELBY:FREQ,2026-10-18 12:30:01.0012345,NaN,Normal\n"
+
+	if result := write("CSV"); result != expected {
+		t.Fatal("TestRecordWriters: unexpected CSV output:\n" + result)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(write("table"), "\n"), "\n")
+
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "SignalID                                PointTag     Timestamp") ||
+		!strings.HasPrefix(lines[1], strings.Repeat("-", 38)+"  ") || !strings.HasSuffix(lines[2], "59.98  Normal") || !strings.HasSuffix(lines[3], "NaN    Normal") {
+		t.Fatal("TestRecordWriters: unexpected table output:\n" + strings.Join(lines, "\n"))
+	}
+
+	if _, err := newRecordWriter("xml", &strings.Builder{}, measurementColumns); err == nil {
+		t.Fatal("TestRecordWriters: expected error for unsupported format")
+	}
+}
+
+func TestMetadataQueries(t *testing.T) {
+	dataSet, err := loadMetadataFile("../../test/MetadataSample2.xml")
+
+	if err != nil {
+		t.Fatal("TestMetadataQueries: failed to load metadata: " + err.Error())
+	}
+
+	var output strings.Builder
+
+	if err := writeTableRows(&output, "csv", dataSet, "MeasurementDetail", "SignalAcronym = 'FREQ'", "", -1); err != nil {
+		t.Fatal("TestMetadataQueries: failed to write table rows: " + err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "DeviceAcronym,ID,SignalID,PointTag") || !strings.Contains(lines[1], "TVA_SHELBY:ABBF") {
+		t.Fatal("TestMetadataQueries: unexpected table rows output:\n" + output.String())
+	}
+
+	output.Reset()
+
+	if err := writeQuery(&output, "json", dataSet, "SELECT SignalAcronym, COUNT(*) AS Total FROM MeasurementDetail WHERE SignalAcronym = 'STAT' GROUP BY SignalAcronym"); err != nil {
+		t.Fatal("TestMetadataQueries: failed to write query: " + err.Error())
+	}
+
+	if output.String() != "{\"SignalAcronym\":\"STAT\",\"Total\":116}\n" {
+		t.Fatal("TestMetadataQueries: unexpected query output: " + output.String())
+	}
+
+	if err := writeTableRows(&output, "table", dataSet, "Missing", "", "", -1); err == nil {
+		t.Fatal("TestMetadataQueries: expected error for missing table")
+	}
+}
@@ -0,0 +1,106 @@
+//******************************************************************************************************
+//  Relay.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+)
+
+func runRelay(args []string) int {
+	flags := flag.NewFlagSet("relay", flag.ContinueOnError)
+	connection := addConnectionFlags(flags)
+	subscription := addSubscriptionFlags(flags)
+
+	publish := flags.String("publish", ":7165", "[INTERFACE]:PORT to listen on for downstream subscriber connections")
+	duration := flags.Duration("duration", 0, "exit after the specified duration, 0 for no limit")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp relay [flags] HOSTNAME:PORT")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Subscribes once to the upstream publisher at HOSTNAME:PORT and republishes to downstream subscribers.")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	address, ok := parseArgs(flags, args)
+
+	if !ok {
+		return 2
+	}
+
+	if connection.listen {
+		fmt.Fprintln(os.Stderr, "The -listen flag is not supported by the relay command, upstream publisher must accept connections")
+		return 2
+	}
+
+	relay := sttp.NewRelay()
+	defer relay.Close()
+
+	relay.SetStatusMessageLogger(func(message string) {
+		if connection.verbose {
+			fmt.Fprintln(os.Stderr, message)
+		}
+	})
+
+	relay.SetErrorMessageLogger(func(message string) {
+		fmt.Fprintln(os.Stderr, message)
+	})
+
+	if len(connection.metrics) > 0 {
+		if err := serveMetrics(connection.metrics, relay.DataSubscriber().EnableMetrics()); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return 1
+		}
+	}
+
+	relay.Subscribe(subscription.filterExpression, subscription.settings)
+
+	if err := relay.Listen(*publish); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to start relay publisher: "+err.Error())
+		return 1
+	}
+
+	if err := relay.Dial(address, connection.config); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to STTP publisher at \"%s\": %s\n", address, err.Error())
+		return 1
+	}
+
+	fmt.Fprintf(os.Stderr, "Relaying %s to downstream subscribers on %s\n", address, relay.DataPublisher().Address())
+
+	start := time.Now()
+	waitForExit(nil, *duration)
+
+	publisher := relay.DataPublisher()
+	fmt.Fprintf(os.Stderr, "Relayed %d measurements, %d bytes, to %d connected subscribers in %s\n", publisher.TotalMeasurementsSent(),
+		publisher.TotalBytesSent(), len(publisher.Connections()), time.Since(start).Round(time.Second))
+
+	return 0
+}
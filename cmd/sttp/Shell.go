@@ -0,0 +1,107 @@
+//******************************************************************************************************
+//  Shell.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/format"
+)
+
+func runShell(args []string) int {
+	flags := flag.NewFlagSet("shell", flag.ContinueOnError)
+	connection := addConnectionFlags(flags)
+
+	fileName := flags.String("file", "", "read metadata from a cached XML metadata file instead of a publisher")
+	timeout := flags.Duration("timeout", 30*time.Second, "maximum time to wait for metadata from publisher")
+	table := flags.String("table", "", "initial current table for WHERE only expressions")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp shell [flags] HOSTNAME:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp shell [flags] -listen [INTERFACE]:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp shell [flags] -file FILENAME")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	dataSet, code := loadMetadata(flags, connection, *fileName, *timeout)
+
+	if dataSet == nil {
+		return code
+	}
+
+	shell := data.NewQueryShell(dataSet)
+
+	if len(*table) > 0 {
+		if err := shell.Execute(".use "+*table, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return 2
+		}
+	}
+
+	editor := newLineEditor(shell.Complete)
+	defer editor.Close()
+
+	fmt.Printf("Loaded metadata with %s tables. Enter .help for commands, .exit to quit; tab completes names.\n", format.Int(dataSet.TableCount()))
+
+	for {
+		prompt := "sttp> "
+
+		if len(shell.Table()) > 0 {
+			prompt = "sttp:" + shell.Table() + "> "
+		}
+
+		line, err := editor.ReadLine(prompt)
+
+		if err == io.EOF {
+			return 0
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to read input: "+err.Error())
+			return 1
+		}
+
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case ".exit", ".quit":
+			return 0
+		}
+
+		if err := shell.Execute(line, os.Stdout); err != nil {
+			fmt.Println("Error: " + err.Error())
+		}
+	}
+}
@@ -0,0 +1,177 @@
+//******************************************************************************************************
+//  Stats.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// statsColumns defines the record columns written for each statistics interval.
+var statsColumns = []string{"Time", "Measurements", "MeasurementsPerSecond", "CommandBytes", "CommandBytesPerSecond", "DataBytes", "DataBytesPerSecond"}
+
+// statsCounters defines a snapshot of the subscriber received totals.
+type statsCounters struct {
+	time         time.Time
+	measurements uint64
+	commandBytes uint64
+	dataBytes    uint64
+}
+
+func readStatsCounters(subscriber *sttp.Subscriber) statsCounters {
+	return statsCounters{
+		time:         time.Now(),
+		measurements: subscriber.TotalMeasurementsReceived(),
+		commandBytes: subscriber.TotalCommandChannelBytesReceived(),
+		dataBytes:    subscriber.TotalDataChannelBytesReceived(),
+	}
+}
+
+// statsRecord gets the column values for the interval between two counter snapshots, see statsColumns.
+func statsRecord(last, current statsCounters) []interface{} {
+	seconds := current.time.Sub(last.time).Seconds()
+
+	rate := func(lastTotal, currentTotal uint64) float64 {
+		// Totals restart from zero when subscriber reconnects
+		if seconds <= 0 || currentTotal < lastTotal {
+			return 0
+		}
+
+		return math.Round(float64(currentTotal-lastTotal)/seconds*10) / 10
+	}
+
+	return []interface{}{
+		current.time,
+		current.measurements,
+		rate(last.measurements, current.measurements),
+		current.commandBytes,
+		rate(last.commandBytes, current.commandBytes),
+		current.dataBytes,
+		rate(last.dataBytes, current.dataBytes),
+	}
+}
+
+func runStats(args []string) int {
+	flags := flag.NewFlagSet("stats", flag.ContinueOnError)
+	connection := addConnectionFlags(flags)
+	subscription := addSubscriptionFlags(flags)
+
+	format := flags.String("format", "table", "output format: table, json (JSON lines) or csv")
+	interval := flags.Duration("interval", time.Second, "statistics reporting interval")
+	duration := flags.Duration("duration", 0, "exit after the specified duration, 0 for no limit")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp stats [flags] HOSTNAME:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp stats [flags] -listen [INTERFACE]:PORT")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	address, ok := parseArgs(flags, args)
+
+	if !ok {
+		return 2
+	}
+
+	if *interval <= 0 {
+		fmt.Fprintln(os.Stderr, "Statistics interval must be greater than zero")
+		return 2
+	}
+
+	writer, err := newRecordWriter(*format, os.Stdout, statsColumns)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	subscriber := sttp.NewSubscriber()
+	defer subscriber.Close()
+
+	// Measurements are only counted, return received slices to the pool
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		subscriber.PutMeasurementSlice(measurements)
+	})
+
+	subscriber.Subscribe(subscription.filterExpression, subscription.settings)
+
+	if err := connection.start(subscriber, address); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	done := make(chan struct{})
+	failed := make(chan struct{})
+	finished := make(chan struct{})
+	var writeErr error
+
+	go func() {
+		defer close(finished)
+
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+
+		last := readStatsCounters(subscriber)
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			current := readStatsCounters(subscriber)
+
+			if writeErr = writer.WriteRecord(statsRecord(last, current)); writeErr == nil {
+				writeErr = writer.Flush()
+			}
+
+			if writeErr != nil {
+				close(failed)
+				return
+			}
+
+			last = current
+		}
+	}()
+
+	waitForExit(failed, *duration)
+	close(done)
+	<-finished
+
+	if writeErr != nil {
+		fmt.Fprintln(os.Stderr, "Failed to write statistics: "+writeErr.Error())
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,118 @@
+//******************************************************************************************************
+//  SttpCli.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// Command sttp is a command-line STTP subscriber tool for checking publishers without writing code.
+//
+// Usage:
+//
+//	sttp subscribe [flags] HOSTNAME:PORT    Subscribe and write received measurements
+//	sttp metadata [flags] HOSTNAME:PORT     Dump metadata tables or query metadata
+//	sttp stats [flags] HOSTNAME:PORT        Show live measurement and byte rates
+//	sttp shell [flags] HOSTNAME:PORT        Interactive metadata query shell
+//	sttp dissect [flags] FILENAME           Decode STTP sessions from a pcap or pcapng capture
+//	sttp loadgen [flags]                    Benchmark subscriber throughput and latency on loopback
+//	sttp relay [flags] HOSTNAME:PORT        Republish one upstream subscription to many subscribers
+//
+// Each command accepts the -listen flag to listen on the specified [INTERFACE]:PORT for a reverse
+// connection from a publisher instead of connecting to one. The metadata and shell commands can also
+// read metadata from a file saved with the -metadata-cache flag using the -file flag. Commands that
+// connect to a publisher accept the -metrics flag to serve subscriber health metrics, e.g., reconnect
+// attempts, TSSC resets and measurement latency, for Prometheus at http://[INTERFACE]:PORT/metrics.
+// Use "sttp COMMAND -h" for command flags.
+//
+// The dissect command reads network captures, e.g., from tcpdump or Wireshark, reassembles TCP streams
+// and writes the STTP commands and responses of each session, including decoded measurements, along
+// with any detected protocol anomalies.
+//
+// The loadgen command publishes synthetic sine phasor, ramp or noise signals at a fixed frame rate on
+// loopback, optionally with injected quality flags and clock skew, and reports the throughput and
+// latency seen by a connected subscriber for both compact and TSSC payload encodings.
+//
+// The relay command subscribes once to an upstream publisher and republishes its metadata and
+// measurements to any number of downstream subscribers, listening on the -publish address, e.g., to
+// stay within publisher connection limits. Each downstream subscriber receives the measurements that
+// match its own filter expression.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name        string
+	description string
+	run         func(args []string) int
+}
+
+var commands = []command{
+	{"subscribe", "Subscribe and write received measurements as a table, JSON lines or CSV", runSubscribe},
+	{"metadata", "Dump metadata tables or run metadata table queries", runMetadata},
+	{"stats", "Show live measurement and byte rates", runStats},
+	{"shell", "Interactive metadata query shell with tab completion", runShell},
+	{"dissect", "Decode STTP sessions and protocol anomalies from a pcap or pcapng capture", runDissect},
+	{"loadgen", "Benchmark subscriber throughput and latency with a synthetic loopback publisher", runLoadGen},
+	{"relay", "Republish one upstream subscription to many downstream subscribers", runRelay},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	name := os.Args[1]
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			os.Exit(cmd.run(os.Args[2:]))
+		}
+	}
+
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		usage()
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Unknown command \"%s\"\n\n", name)
+	usage()
+	os.Exit(1)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage:")
+	fmt.Fprintln(os.Stderr, "    sttp COMMAND [flags] HOSTNAME:PORT")
+	fmt.Fprintln(os.Stderr, "    sttp COMMAND [flags] -listen [INTERFACE]:PORT")
+	fmt.Fprintln(os.Stderr, "    sttp dissect [flags] FILENAME")
+	fmt.Fprintln(os.Stderr, "    sttp loadgen [flags]")
+	fmt.Fprintln(os.Stderr, "    sttp relay [flags] HOSTNAME:PORT")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "    %-10s %s\n", cmd.name, cmd.description)
+	}
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Use \"sttp COMMAND -h\" for the flags of a command.")
+}
@@ -0,0 +1,178 @@
+//******************************************************************************************************
+//  Subscribe.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// defaultFilterExpression defines the subscription filter expression used when none is specified.
+const defaultFilterExpression = "FILTER ActiveMeasurements WHERE True"
+
+// subscriptionOptions defines the subscription flags shared by commands that subscribe.
+type subscriptionOptions struct {
+	filterExpression string
+	settings         *sttp.Settings
+}
+
+// addSubscriptionFlags defines the subscription filter and settings flags on the specified flag set.
+func addSubscriptionFlags(flags *flag.FlagSet) *subscriptionOptions {
+	options := &subscriptionOptions{settings: sttp.NewSettings()}
+	settings := options.settings
+
+	flags.StringVar(&options.filterExpression, "filter", defaultFilterExpression, "subscription filter expression")
+	flags.BoolVar(&settings.Throttled, "throttled", settings.Throttled, "request down-sampled data")
+	flags.Float64Var(&settings.PublishInterval, "publish-interval", settings.PublishInterval, "down-sampling publish interval, in seconds, when throttled")
+
+	flags.Func("udp-port", "receive data on the specified UDP port instead of TCP", func(value string) error {
+		var port int32
+
+		if err := parseInt32(value, &port); err != nil {
+			return err
+		}
+
+		if port < 0 || port > 65535 {
+			return fmt.Errorf("port number \"%s\" is out of range: must be 0 to 65535", value)
+		}
+
+		settings.UdpPort = uint16(port)
+		return nil
+	})
+
+	flags.BoolVar(&settings.IncludeTime, "include-time", settings.IncludeTime, "include time in non-compressed, compact measurements")
+	flags.BoolVar(&settings.UseMillisecondResolution, "millisecond-resolution", settings.UseMillisecondResolution, "restrict time to milliseconds in non-compressed, compact measurements")
+	flags.BoolVar(&settings.RequestNaNValueFilter, "nan-filter", settings.RequestNaNValueFilter, "request that publisher does not send NaN values")
+	flags.StringVar(&settings.StartTime, "start", settings.StartTime, "start time of a historical subscription")
+	flags.StringVar(&settings.StopTime, "stop", settings.StopTime, "stop time of a historical subscription")
+	flags.Func("processing-interval", "historical playback processing interval, in milliseconds, -1 for default, 0 for as fast as possible (default -1)", func(value string) error {
+		return parseInt32(value, &settings.ProcessingInterval)
+	})
+	flags.StringVar(&settings.ExtraConnectionStringParameters, "extra-parameters", settings.ExtraConnectionStringParameters, "extra connection string parameters for subscription")
+
+	return options
+}
+
+func runSubscribe(args []string) int {
+	flags := flag.NewFlagSet("subscribe", flag.ContinueOnError)
+	connection := addConnectionFlags(flags)
+	subscription := addSubscriptionFlags(flags)
+
+	format := flags.String("format", "table", "output format: table, json (JSON lines) or csv")
+	count := flags.Uint64("count", 0, "exit after writing the specified number of measurements, 0 for no limit")
+	duration := flags.Duration("duration", 0, "exit after the specified duration, 0 for no limit")
+	raw := flags.Bool("raw", false, "write values without applying metadata Adder and Multiplier adjustments")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage:")
+		fmt.Fprintln(flags.Output(), "    sttp subscribe [flags] HOSTNAME:PORT")
+		fmt.Fprintln(flags.Output(), "    sttp subscribe [flags] -listen [INTERFACE]:PORT")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Flags:")
+		flags.PrintDefaults()
+	}
+
+	address, ok := parseArgs(flags, args)
+
+	if !ok {
+		return 2
+	}
+
+	writer, err := newRecordWriter(*format, os.Stdout, measurementColumns)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	subscriber := sttp.NewSubscriber()
+	defer subscriber.Close()
+
+	var writeLock sync.Mutex
+	var written uint64
+	var writeErr error
+	done := make(chan struct{})
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		defer subscriber.PutMeasurementSlice(measurements)
+
+		writeLock.Lock()
+		defer writeLock.Unlock()
+
+		if writeErr != nil || *count > 0 && written >= *count {
+			return
+		}
+
+		for i := range *measurements {
+			measurement := &(*measurements)[i]
+			value := measurement.Value
+
+			if !*raw {
+				value = subscriber.AdjustedValue(measurement)
+			}
+
+			if writeErr = writer.WriteRecord(measurementRecord(measurement, subscriber.Metadata(measurement), value)); writeErr != nil {
+				break
+			}
+
+			written++
+
+			if *count > 0 && written >= *count {
+				break
+			}
+		}
+
+		if writeErr == nil {
+			writeErr = writer.Flush()
+		}
+
+		if writeErr != nil || *count > 0 && written >= *count {
+			close(done)
+		}
+	})
+
+	subscriber.Subscribe(subscription.filterExpression, subscription.settings)
+
+	if err := connection.start(subscriber, address); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	waitForExit(done, *duration)
+
+	writeLock.Lock()
+	defer writeLock.Unlock()
+
+	if writeErr != nil {
+		fmt.Fprintln(os.Stderr, "Failed to write measurements: "+writeErr.Error())
+		return 1
+	}
+
+	return 0
+}
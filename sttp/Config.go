@@ -77,6 +77,14 @@ type Config struct {
 	// RfcGuidEncoding determines if Guid wire serialization should use RFC encoding.
 	// This defaults to true.
 	RfcGuidEncoding bool
+
+	// MetadataCachePath defines the path of a local file used to persist the last
+	// received metadata. When defined, any cached metadata is loaded when connection
+	// is initiated so that metadata lookups work before, or without, a live refresh.
+	// The cache is replaced when newer metadata is received and removed when the data
+	// publisher reports that its configuration has changed. Defaults to empty string,
+	// i.e., no metadata caching.
+	MetadataCachePath string
 }
 
 // configDefaults define the default values for an STTP connection Config.
@@ -0,0 +1,206 @@
+//******************************************************************************************************
+//  MeasurementFilter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// MeasurementFilterTableName defines the name of the virtual table used by a MeasurementFilter.
+const MeasurementFilterTableName = "ActiveMeasurements"
+
+// Column indexes of the virtual measurement filter table.
+const (
+	filterSignalIDColumn = iota
+	filterValueColumn
+	filterTimestampColumn
+	filterFlagsColumn
+	filterAdderColumn
+	filterMultiplierColumn
+	filterIDColumn
+	filterSourceColumn
+	filterSignalTypeColumn
+	filterSignalReferenceColumn
+	filterDescriptionColumn
+	filterUpdatedOnColumn
+	filterTagColumn
+	filterPointTagColumn
+	filterColumnCount
+)
+
+// MeasurementFilter represents a filter expression that is evaluated against streaming measurements
+// instead of metadata rows. Expressions are parsed against a virtual "ActiveMeasurements" table that
+// includes the Value, Timestamp and Flags of each measurement along with the fields of its associated
+// MeasurementMetadata, i.e., SignalID, Adder, Multiplier, ID, Source, SignalType, SignalReference,
+// Description, UpdatedOn and Tag; PointTag is available as an alias for Tag. Example:
+//
+//	FILTER ActiveMeasurements WHERE SignalType = 'FREQ' AND Value < 59.95
+//
+// The FILTER statement may be omitted, e.g., "SignalType = 'FREQ' AND Value < 59.95". Multiple
+// semi-colon separated statements match a measurement when any statement matches. Since filtering
+// is applied one measurement at a time, any "TOP" limit and "ORDER BY" clauses are ignored.
+type MeasurementFilter struct {
+	filterExpression string
+	expressionTrees  []*data.ExpressionTree
+	row              *data.DataRow
+	mutex            sync.Mutex
+}
+
+// NewMeasurementFilter creates a new MeasurementFilter for the specified filterExpression. An error
+// will be returned if the filterExpression is empty, fails to parse, references a table other than
+// "ActiveMeasurements" or references an undefined column.
+func NewMeasurementFilter(filterExpression string) (*MeasurementFilter, error) {
+	filterExpression = strings.TrimSpace(filterExpression)
+
+	if len(filterExpression) == 0 {
+		return nil, errors.New("measurement filter expression is empty")
+	}
+
+	dataSet := data.NewDataSet()
+	dataTable := dataSet.CreateTable(MeasurementFilterTableName)
+	dataTable.InitColumns(filterColumnCount)
+
+	addColumn := func(name string, dataType data.DataTypeEnum) {
+		dataTable.AddColumn(dataTable.CreateColumn(name, dataType, ""))
+	}
+
+	// Column order must match filter column index constants
+	addColumn("SignalID", data.DataType.Guid)
+	addColumn("Value", data.DataType.Double)
+	addColumn("Timestamp", data.DataType.DateTime)
+	addColumn("Flags", data.DataType.UInt32)
+	addColumn("Adder", data.DataType.Double)
+	addColumn("Multiplier", data.DataType.Double)
+	addColumn("ID", data.DataType.UInt64)
+	addColumn("Source", data.DataType.String)
+	addColumn("SignalType", data.DataType.String)
+	addColumn("SignalReference", data.DataType.String)
+	addColumn("Description", data.DataType.String)
+	addColumn("UpdatedOn", data.DataType.DateTime)
+	addColumn("Tag", data.DataType.String)
+	addColumn("PointTag", data.DataType.String)
+
+	dataSet.AddTable(dataTable)
+
+	expressionTrees, err := data.GenerateExpressionTrees(dataSet, MeasurementFilterTableName, filterExpression, true)
+
+	if err != nil {
+		return nil, errors.New("failed to parse measurement filter expression \"" + filterExpression + "\": " + err.Error())
+	}
+
+	if len(expressionTrees) == 0 {
+		return nil, errors.New("no expression trees generated with measurement filter expression \"" + filterExpression + "\"")
+	}
+
+	for _, expressionTree := range expressionTrees {
+		if expressionTree.Root == nil {
+			return nil, errors.New("failed to parse measurement filter expression \"" + filterExpression + "\": each statement must define a boolean predicate")
+		}
+	}
+
+	return &MeasurementFilter{
+		filterExpression: filterExpression,
+		expressionTrees:  expressionTrees,
+		row:              dataTable.CreateRow(),
+	}, nil
+}
+
+// FilterExpression gets the filter expression used to create the MeasurementFilter.
+func (mf *MeasurementFilter) FilterExpression() string {
+	return mf.filterExpression
+}
+
+// Matches determines if the specified measurement, joined with its associated metadata, satisfies
+// the filter expression. The metadata parameter can be nil, in which case metadata columns are
+// evaluated as Null. An error will be returned if the expression does not evaluate to a boolean
+// value or expression evaluation fails.
+func (mf *MeasurementFilter) Matches(measurement *transport.Measurement, metadata *transport.MeasurementMetadata) (bool, error) {
+	if measurement == nil {
+		return false, errors.New("measurement parameter is nil")
+	}
+
+	// Virtual row and expression tree evaluation state are reused between calls
+	mf.mutex.Lock()
+	defer mf.mutex.Unlock()
+
+	mf.loadRow(measurement, metadata)
+
+	for _, expressionTree := range mf.expressionTrees {
+		result, err := expressionTree.Evaluate(mf.row)
+
+		if err != nil {
+			return false, err
+		}
+
+		if result.ValueType() != data.ExpressionValueType.Boolean {
+			return false, errors.New("measurement filter expression evaluation did not result in a boolean value, result data type is \"" + result.ValueType().String() + "\"")
+		}
+
+		// If result is Null, i.e., has no value due to Null propagation, treat result as False
+		if result.IsNull() {
+			continue
+		}
+
+		if matched, _ := result.BooleanValue(); matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (mf *MeasurementFilter) loadRow(measurement *transport.Measurement, metadata *transport.MeasurementMetadata) {
+	row := mf.row
+
+	row.SetValue(filterSignalIDColumn, measurement.SignalID)
+	row.SetValue(filterValueColumn, measurement.Value)
+	row.SetValue(filterTimestampColumn, measurement.DateTime())
+	row.SetValue(filterFlagsColumn, uint32(measurement.Flags))
+
+	// Metadata columns are Null when no metadata is available, comparisons with Null evaluate to
+	// Null which Matches treats as False, e.g., "Source = ''" will not match the measurement
+	if metadata == nil {
+		for columnIndex := filterAdderColumn; columnIndex <= filterPointTagColumn; columnIndex++ {
+			row.SetValue(columnIndex, nil)
+		}
+
+		return
+	}
+
+	row.SetValue(filterAdderColumn, metadata.Adder)
+	row.SetValue(filterMultiplierColumn, metadata.Multiplier)
+	row.SetValue(filterIDColumn, metadata.ID)
+	row.SetValue(filterSourceColumn, metadata.Source)
+	row.SetValue(filterSignalTypeColumn, metadata.SignalType)
+	row.SetValue(filterSignalReferenceColumn, metadata.SignalReference)
+	row.SetValue(filterDescriptionColumn, metadata.Description)
+	row.SetValue(filterUpdatedOnColumn, metadata.UpdatedOn)
+	row.SetValue(filterTagColumn, metadata.Tag)
+	row.SetValue(filterPointTagColumn, metadata.Tag)
+}
@@ -0,0 +1,187 @@
+//******************************************************************************************************
+//  MeasurementFilter_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func TestNewMeasurementFilter(t *testing.T) {
+	invalidExpressions := []string{
+		"",
+		"FILTER ActiveMeasurements WHERE",
+		"FILTER DeviceDetail WHERE True",
+		"Undefined = 1",
+	}
+
+	for _, filterExpression := range invalidExpressions {
+		if _, err := NewMeasurementFilter(filterExpression); err == nil {
+			t.Fatal("TestNewMeasurementFilter: expected error for filter expression \"" + filterExpression + "\"")
+		}
+	}
+
+	measurementFilter, err := NewMeasurementFilter(" SignalType = 'FREQ' ")
+
+	if err != nil {
+		t.Fatal("TestNewMeasurementFilter: failed to create filter: " + err.Error())
+	}
+
+	if measurementFilter.FilterExpression() != "SignalType = 'FREQ'" {
+		t.Fatal("TestNewMeasurementFilter: unexpected filter expression: " + measurementFilter.FilterExpression())
+	}
+}
+
+func TestMeasurementFilterMatches(t *testing.T) {
+	measurementFilter, err := NewMeasurementFilter("FILTER ActiveMeasurements WHERE SignalType = 'FREQ' AND Value < 59.95; PointTag LIKE 'SHELBY:%' AND Flags <> 0")
+
+	if err != nil {
+		t.Fatal("TestMeasurementFilterMatches: failed to create filter: " + err.Error())
+	}
+
+	frequency := &transport.MeasurementMetadata{SignalID: guid.New(), Multiplier: 1, SignalType: "FREQ", Tag: "GPA:FREQ"}
+	shelby := &transport.MeasurementMetadata{SignalID: guid.New(), Multiplier: 1, SignalType: "VPHM", Tag: "SHELBY:VPHM"}
+
+	testCases := []struct {
+		measurement transport.Measurement
+		metadata    *transport.MeasurementMetadata
+		expected    bool
+	}{
+		{transport.Measurement{SignalID: frequency.SignalID, Value: 59.9}, frequency, true},
+		{transport.Measurement{SignalID: frequency.SignalID, Value: 60.0}, frequency, false},
+		{transport.Measurement{SignalID: shelby.SignalID, Value: 59.9}, shelby, false},
+		{transport.Measurement{SignalID: shelby.SignalID, Flags: transport.StateFlags.BadData}, shelby, true},
+		{transport.Measurement{SignalID: guid.New(), Value: 59.9, Flags: transport.StateFlags.BadData}, nil, false},
+	}
+
+	for i, testCase := range testCases {
+		matched, err := measurementFilter.Matches(&testCase.measurement, testCase.metadata)
+
+		if err != nil {
+			t.Fatalf("TestMeasurementFilterMatches: case %d, unexpected error: %s", i, err.Error())
+		}
+
+		if matched != testCase.expected {
+			t.Fatalf("TestMeasurementFilterMatches: case %d, expected match of %v", i, testCase.expected)
+		}
+	}
+
+	if _, err := measurementFilter.Matches(nil, nil); err == nil {
+		t.Fatal("TestMeasurementFilterMatches: expected error for nil measurement")
+	}
+
+	// Metadata columns are Null for measurements without metadata
+	for _, testCase := range []struct {
+		filterExpression string
+		metadata         *transport.MeasurementMetadata
+		expected         bool
+	}{
+		{"Source = ''", nil, false},
+		{"Source = ''", &transport.MeasurementMetadata{}, true},
+		{"Source IS NULL AND Multiplier IS NULL", nil, true},
+		{"Value * Multiplier + Adder > 0", nil, false},
+		{"Value * Multiplier + Adder > 0", frequency, true},
+	} {
+		measurementFilter, err := NewMeasurementFilter(testCase.filterExpression)
+
+		if err != nil {
+			t.Fatal("TestMeasurementFilterMatches: failed to create filter: " + err.Error())
+		}
+
+		// Row is reused, so first load a row with metadata
+		if _, err := measurementFilter.Matches(&transport.Measurement{Value: 1}, shelby); err != nil {
+			t.Fatal("TestMeasurementFilterMatches: unexpected error: " + err.Error())
+		}
+
+		matched, err := measurementFilter.Matches(&transport.Measurement{Value: 1}, testCase.metadata)
+
+		if err != nil {
+			t.Fatalf("TestMeasurementFilterMatches: \"%s\", unexpected error: %s", testCase.filterExpression, err.Error())
+		}
+
+		if matched != testCase.expected {
+			t.Fatalf("TestMeasurementFilterMatches: \"%s\", expected match of %v", testCase.filterExpression, testCase.expected)
+		}
+	}
+}
+
+func TestFilteredMeasurementsReceiver(t *testing.T) {
+	subscriber := NewSubscriber()
+	defer subscriber.Close()
+
+	matchedID, unmatchedID := guid.New(), guid.New()
+	subscriber.LookupMetadata(matchedID).SignalType = "FREQ"
+	subscriber.LookupMetadata(unmatchedID).SignalType = "VPHM"
+
+	if err := subscriber.SetFilteredMeasurementsReceiver("Undefined = 1", func([]transport.Measurement) {}); err == nil {
+		t.Fatal("TestFilteredMeasurementsReceiver: expected error for invalid filter expression")
+	}
+
+	var filtered []transport.Measurement
+	var received int
+
+	if err := subscriber.SetFilteredMeasurementsReceiver("SignalType = 'FREQ'", func(measurements []transport.Measurement) {
+		filtered = append(filtered, measurements...)
+	}); err != nil {
+		t.Fatal("TestFilteredMeasurementsReceiver: failed to set filtered receiver: " + err.Error())
+	}
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		received += len(*measurements)
+	})
+
+	measurements := []transport.Measurement{{SignalID: unmatchedID, Value: 1}, {SignalID: matchedID, Value: 2}, {SignalID: unmatchedID, Value: 3}}
+	subscriber.handleNewMeasurements(&measurements)
+
+	if len(filtered) != 1 || filtered[0].SignalID != matchedID || filtered[0].Value != 2 {
+		t.Fatalf("TestFilteredMeasurementsReceiver: expected only matching measurement to be routed, received: %d", len(filtered))
+	}
+
+	if received != len(measurements) {
+		t.Fatalf("TestFilteredMeasurementsReceiver: expected all measurements to be received, received: %d", received)
+	}
+
+	// Non-matching measurements do not invoke the filtered receiver
+	filtered = nil
+	measurements = []transport.Measurement{{SignalID: unmatchedID, Value: 4}}
+	subscriber.handleNewMeasurements(&measurements)
+
+	if filtered != nil {
+		t.Fatal("TestFilteredMeasurementsReceiver: expected no filtered measurements")
+	}
+
+	// Removing the filtered receiver stops routing
+	if err := subscriber.SetFilteredMeasurementsReceiver("", nil); err != nil {
+		t.Fatal("TestFilteredMeasurementsReceiver: failed to remove filtered receiver: " + err.Error())
+	}
+
+	measurements = []transport.Measurement{{SignalID: matchedID, Value: 5}}
+	subscriber.handleNewMeasurements(&measurements)
+
+	if filtered != nil || received != 5 {
+		t.Fatal("TestFilteredMeasurementsReceiver: expected filtered receiver to be removed")
+	}
+}
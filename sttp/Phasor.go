@@ -0,0 +1,259 @@
+//******************************************************************************************************
+//  Phasor.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"math/cmplx"
+	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Phasor defines a complex phasor value reconstructed from the time-aligned magnitude and angle
+// measurements of a voltage or current phasor.
+type Phasor struct {
+	// Source defines the source, i.e., device acronym, of the phasor.
+	Source string
+
+	// SourceIndex defines the one-based index of the phasor within its source.
+	SourceIndex int32
+
+	// Detail defines the associated PhasorDetail metadata record, e.g., for the phasor Label, Type
+	// and Phase; nil when the PhasorDetail table is not available or does not define the phasor.
+	Detail *metadata.PhasorDetail
+
+	// MagnitudeID defines the signal ID of the magnitude measurement.
+	MagnitudeID guid.Guid
+
+	// AngleID defines the signal ID of the angle measurement.
+	AngleID guid.Guid
+
+	// Timestamp defines the common timestamp of the magnitude and angle measurements.
+	Timestamp ticks.Ticks
+
+	// Flags defines the combined quality flags of the magnitude and angle measurements.
+	Flags transport.StateFlagsEnum
+
+	// Magnitude defines the adjusted value of the magnitude measurement.
+	Magnitude float64
+
+	// Angle defines the adjusted value of the angle measurement, in degrees.
+	Angle float64
+
+	// AngleRadians defines the Angle in radians.
+	AngleRadians float64
+
+	// Real defines the real, i.e., rectangular X, component of the phasor.
+	Real float64
+
+	// Imaginary defines the imaginary, i.e., rectangular Y, component of the phasor.
+	Imaginary float64
+}
+
+// Value gets the phasor as a complex number.
+func (p *Phasor) Value() complex128 {
+	return complex(p.Real, p.Imaginary)
+}
+
+// DateTime gets the phasor Timestamp as a time.Time.
+func (p *Phasor) DateTime() time.Time {
+	return p.Timestamp.ToTime()
+}
+
+// phasorSourceKey identifies a phasor by its upper-case source and one-based source index.
+type phasorSourceKey struct {
+	source      string
+	sourceIndex int32
+}
+
+// phasorComponent holds the last received, not yet aligned, magnitude or angle value of a phasor.
+type phasorComponent struct {
+	timestamp ticks.Ticks
+	value     float64
+	flags     transport.StateFlagsEnum
+	pending   bool
+}
+
+// phasorState holds the identity and the pending component values of a phasor.
+type phasorState struct {
+	phasor    Phasor
+	magnitude phasorComponent
+	angle     phasorComponent
+}
+
+// phasorSignal associates a magnitude or angle measurement with its phasor.
+type phasorSignal struct {
+	state   *phasorState
+	isAngle bool
+}
+
+// phasorAligner pairs received magnitude and angle measurements into phasors. Signal pairings are
+// cached until the metadata model changes. Measurements must be aligned from a single goroutine.
+type phasorAligner struct {
+	model   *metadata.Model
+	signals map[guid.Guid]*phasorSignal
+	phasors map[phasorSourceKey]*phasorState
+}
+
+func newPhasorAligner() *phasorAligner {
+	return &phasorAligner{
+		signals: make(map[guid.Guid]*phasorSignal),
+		phasors: make(map[phasorSourceKey]*phasorState),
+	}
+}
+
+// align adds the specified measurements and returns any phasors with both a magnitude and an angle
+// for the same timestamp. A pending component is discarded once a newer value for the other arrives.
+func (pa *phasorAligner) align(sb *Subscriber, measurements []transport.Measurement) []Phasor {
+	if model := sb.MetadataModel(); model != pa.model {
+		pa.model = model
+		pa.signals = make(map[guid.Guid]*phasorSignal)
+		pa.phasors = make(map[phasorSourceKey]*phasorState)
+	}
+
+	var phasors []Phasor
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		signal, ok := pa.signals[measurement.SignalID]
+
+		if !ok {
+			signal = pa.resolve(sb, measurement.SignalID)
+			pa.signals[measurement.SignalID] = signal
+		}
+
+		if signal == nil {
+			continue
+		}
+
+		state := signal.state
+		component, other := &state.magnitude, &state.angle
+
+		if signal.isAngle {
+			component, other = other, component
+		}
+
+		*component = phasorComponent{
+			timestamp: measurement.Timestamp,
+			value:     sb.AdjustedValue(measurement),
+			flags:     measurement.Flags,
+			pending:   true,
+		}
+
+		if !other.pending {
+			continue
+		}
+
+		if other.timestamp != component.timestamp {
+			if other.timestamp < component.timestamp {
+				other.pending = false
+			} else {
+				component.pending = false
+			}
+
+			continue
+		}
+
+		phasors = append(phasors, state.complete())
+	}
+
+	return phasors
+}
+
+// complete creates a phasor from the aligned magnitude and angle and clears the pending components.
+func (state *phasorState) complete() Phasor {
+	phasor := state.phasor
+	phasor.Timestamp = state.magnitude.timestamp
+	phasor.Flags = state.magnitude.flags | state.angle.flags
+	phasor.Magnitude = state.magnitude.value
+	phasor.Angle = state.angle.value
+	phasor.AngleRadians = phasor.Angle * math.Pi / 180
+
+	value := cmplx.Rect(phasor.Magnitude, phasor.AngleRadians)
+	phasor.Real = real(value)
+	phasor.Imaginary = imag(value)
+
+	state.magnitude.pending = false
+	state.angle.pending = false
+
+	return phasor
+}
+
+// resolve determines the phasor of a magnitude or angle measurement from the PhasorDetail pairing of the
+// metadata model, when available; otherwise, from the parsed measurement signal reference. Returns nil
+// for measurements that are not a phasor magnitude or angle.
+func (pa *phasorAligner) resolve(sb *Subscriber, signalID guid.Guid) *phasorSignal {
+	var detail *metadata.PhasorDetail
+	var key phasorSourceKey
+	var signalKind transport.SignalKindEnum
+
+	if pa.model != nil {
+		if measurement := pa.model.Measurement(signalID); measurement != nil && measurement.Phasor != nil {
+			detail = measurement.Phasor
+			key = phasorSourceKey{strings.ToUpper(detail.DeviceAcronym), detail.SourceIndex}
+
+			if detail.Angle == measurement {
+				signalKind = transport.SignalKind.Angle
+			} else {
+				signalKind = transport.SignalKind.Magnitude
+			}
+		}
+	}
+
+	if detail == nil {
+		source, kind, position := sb.LookupMetadata(signalID).ParseSignalReference()
+
+		if kind != transport.SignalKind.Angle && kind != transport.SignalKind.Magnitude || position < 1 {
+			return nil
+		}
+
+		key = phasorSourceKey{strings.ToUpper(source), int32(position)}
+		signalKind = kind
+	}
+
+	state, ok := pa.phasors[key]
+
+	if !ok {
+		state = &phasorState{phasor: Phasor{Source: key.source, SourceIndex: key.sourceIndex, Detail: detail}}
+
+		if detail != nil {
+			state.phasor.Source = detail.DeviceAcronym
+		}
+
+		pa.phasors[key] = state
+	}
+
+	if signalKind == transport.SignalKind.Angle {
+		state.phasor.AngleID = signalID
+	} else {
+		state.phasor.MagnitudeID = signalID
+	}
+
+	return &phasorSignal{state: state, isAngle: signalKind == transport.SignalKind.Angle}
+}
@@ -0,0 +1,186 @@
+//******************************************************************************************************
+//  Phasor_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func TestPhasorAlignment(t *testing.T) {
+	subscriber := NewSubscriber()
+	defer subscriber.Close()
+
+	magnitudeID, angleID, frequencyID := guid.New(), guid.New(), guid.New()
+	subscriber.LookupMetadata(magnitudeID).SignalReference = "SHELBY-PM2"
+	subscriber.LookupMetadata(angleID).SignalReference = "SHELBY-PA2"
+	subscriber.LookupMetadata(frequencyID).SignalReference = "SHELBY-FQ"
+
+	// Magnitude is scaled by metadata adjustments
+	subscriber.LookupMetadata(magnitudeID).Multiplier = 2
+
+	aligner := newPhasorAligner()
+	timestamp := ticks.UtcNow()
+
+	phasors := aligner.align(subscriber, []transport.Measurement{
+		{SignalID: frequencyID, Value: 60, Timestamp: timestamp},
+		{SignalID: magnitudeID, Value: 50, Timestamp: timestamp},
+		{SignalID: angleID, Value: 10, Timestamp: timestamp - 1},
+	})
+
+	if len(phasors) != 0 {
+		t.Fatal("TestPhasorAlignment: expected no phasor for misaligned timestamps")
+	}
+
+	phasors = aligner.align(subscriber, []transport.Measurement{
+		{SignalID: angleID, Value: 90, Timestamp: timestamp, Flags: transport.StateFlags.SuspectTime},
+		{SignalID: angleID, Value: 30, Timestamp: timestamp + 1},
+	})
+
+	if len(phasors) != 1 {
+		t.Fatalf("TestPhasorAlignment: expected one aligned phasor, received: %d", len(phasors))
+	}
+
+	phasor := phasors[0]
+
+	if phasor.Source != "SHELBY" || phasor.SourceIndex != 2 || phasor.Detail != nil || phasor.MagnitudeID != magnitudeID || phasor.AngleID != angleID {
+		t.Fatal("TestPhasorAlignment: unexpected phasor identity")
+	}
+
+	if phasor.Timestamp != timestamp || phasor.Flags != transport.StateFlags.SuspectTime {
+		t.Fatal("TestPhasorAlignment: unexpected phasor timestamp or flags")
+	}
+
+	if phasor.Magnitude != 100 || phasor.Angle != 90 || math.Abs(phasor.AngleRadians-math.Pi/2) > 1e-12 {
+		t.Fatalf("TestPhasorAlignment: unexpected polar components: %f, %f", phasor.Magnitude, phasor.Angle)
+	}
+
+	if math.Abs(phasor.Real) > 1e-9 || math.Abs(phasor.Imaginary-100) > 1e-9 || cmplx.Abs(phasor.Value()-complex(0, 100)) > 1e-9 {
+		t.Fatalf("TestPhasorAlignment: unexpected rectangular components: %f, %f", phasor.Real, phasor.Imaginary)
+	}
+
+	// Newer angle remains pending until its magnitude arrives
+	phasors = aligner.align(subscriber, []transport.Measurement{{SignalID: magnitudeID, Value: 10, Timestamp: timestamp + 1}})
+
+	if len(phasors) != 1 || phasors[0].Angle != 30 || phasors[0].Magnitude != 20 {
+		t.Fatal("TestPhasorAlignment: expected pending angle to align with later magnitude")
+	}
+
+	// Malformed signal references are not paired
+	malformedIDs := []guid.Guid{guid.New(), guid.New(), guid.New()}
+
+	for i, signalReference := range []string{"SHELBY-A", "SHELBY-", "-P"} {
+		subscriber.LookupMetadata(malformedIDs[i]).SignalReference = signalReference
+	}
+
+	phasors = aligner.align(subscriber, []transport.Measurement{
+		{SignalID: malformedIDs[0], Value: 1, Timestamp: timestamp + 2},
+		{SignalID: malformedIDs[1], Value: 2, Timestamp: timestamp + 2},
+		{SignalID: malformedIDs[2], Value: 3, Timestamp: timestamp + 2},
+	})
+
+	if len(phasors) != 0 {
+		t.Fatal("TestPhasorAlignment: expected no phasors for malformed signal references")
+	}
+}
+
+func TestPhasorsReceiver(t *testing.T) {
+	generator := transport.NewLoadGenerator()
+	generator.SignalCount = 5
+	generator.FramesPerSecond = 50
+
+	if err := generator.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("TestPhasorsReceiver: failed to start load generator: " + err.Error())
+	}
+
+	defer generator.Stop()
+
+	var mutex sync.Mutex
+	received := make(map[int32][]Phasor)
+
+	subscriber := NewSubscriber()
+	subscriber.SetStatusMessageLogger(nil)
+	subscriber.SetErrorMessageLogger(func(message string) { t.Log(message) })
+	subscriber.SetConnectionEstablishedReceiver(nil)
+	defer subscriber.Close()
+
+	subscriber.SetPhasorsReceiver(func(phasors []Phasor) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		for _, phasor := range phasors {
+			received[phasor.SourceIndex] = append(received[phasor.SourceIndex], phasor)
+		}
+	})
+
+	config := NewConfig()
+	config.AutoReconnect = false
+	subscriber.Subscribe("FILTER ActiveMeasurements WHERE True", nil)
+
+	if err := subscriber.Dial(generator.Address(), config); err != nil {
+		t.Fatal("TestPhasorsReceiver: failed to connect to load generator: " + err.Error())
+	}
+
+	// Phasor metadata pairing applies once metadata has been received
+	if !waitFor(func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(received[1]) > 0 && received[1][len(received[1])-1].Detail != nil && len(received[2]) > 0 && received[2][len(received[2])-1].Detail != nil
+	}) {
+		t.Fatal("TestPhasorsReceiver: timeout waiting for phasors with phasor details")
+	}
+
+	subscriber.Disconnect()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(received) != 2 {
+		t.Fatalf("TestPhasorsReceiver: expected two phasors, received: %d", len(received))
+	}
+
+	for sourceIndex, phasors := range received {
+		phasor := phasors[len(phasors)-1]
+
+		if phasor.Source != "LOADGEN" || phasor.Detail.Label != "Phasor "+strconv.Itoa(int(sourceIndex)) || !phasor.Detail.IsVoltage() {
+			t.Fatal("TestPhasorsReceiver: unexpected phasor detail for " + phasor.Detail.Label)
+		}
+
+		if phasor.MagnitudeID != generator.SignalIDs()[2*(sourceIndex-1)] || phasor.AngleID != generator.SignalIDs()[2*(sourceIndex-1)+1] {
+			t.Fatal("TestPhasorsReceiver: unexpected phasor signal IDs for " + phasor.Detail.Label)
+		}
+
+		if phasor.Magnitude <= 0 || math.Abs(cmplx.Abs(phasor.Value())-phasor.Magnitude) > 1e-6 || math.Abs(cmplx.Phase(phasor.Value())-phasor.AngleRadians) > 1e-6 && math.Abs(phasor.Angle) < 179.9 {
+			t.Fatalf("TestPhasorsReceiver: inconsistent phasor components for %s: %f, %f", phasor.Detail.Label, phasor.Magnitude, phasor.Angle)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+//******************************************************************************************************
+//  Relay.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Relay represents an STTP relay that subscribes once to an upstream data publisher and republishes
+// the received measurements to any number of downstream subscribers, e.g., to stay within publisher
+// connection limits. Metadata received from the upstream publisher is forwarded to downstream
+// subscribers unchanged and each downstream subscription gets its own signal index cache resolved
+// from its filter expression against the upstream metadata. Filter expressions can target the
+// "ActiveMeasurements" table which, when not included in the upstream metadata, is derived from the
+// MeasurementDetail table with its SignalAcronym and DeviceAcronym fields available as SignalType
+// and Device. Measurements are passed through with their values, timestamps and flags unchanged and
+// re-encoded for each downstream subscriber with the compact or TSSC encoding that it requests.
+// Upstream configuration changes and notifications are propagated to downstream subscribers.
+type Relay struct {
+	config           *Config
+	filterExpression string
+	settings         *Settings
+
+	// Upstream DataSubscriber and downstream DataPublisher references
+	ds        *transport.DataSubscriber
+	publisher *transport.DataPublisher
+
+	// Last metadata received from upstream publisher
+	metadata     []byte
+	metadataLock sync.Mutex
+	dataSet      atomic.Pointer[data.DataSet]
+
+	// Set when upstream publisher reports a configuration change until updated metadata is received
+	configurationChanged atomic.Bool
+
+	statusMessageLogger func(message string)
+	errorMessageLogger  func(message string)
+	consoleLock         sync.Mutex
+}
+
+// NewRelay creates a new Relay that subscribes to all upstream measurements with default settings.
+func NewRelay() *Relay {
+	r := &Relay{
+		config:           NewConfig(),
+		filterExpression: "FILTER ActiveMeasurements WHERE True",
+		ds:               transport.NewDataSubscriber(),
+		publisher:        transport.NewDataPublisher(),
+	}
+
+	r.statusMessageLogger = r.DefaultStatusMessageLogger
+	r.errorMessageLogger = r.DefaultErrorMessageLogger
+	return r
+}
+
+// Close stops the downstream publisher and disconnects from the upstream publisher.
+func (r *Relay) Close() {
+	r.publisher.Stop()
+	r.ds.Dispose()
+}
+
+// DataSubscriber gets the upstream DataSubscriber used by the Relay.
+func (r *Relay) DataSubscriber() *transport.DataSubscriber {
+	return r.ds
+}
+
+// DataPublisher gets the downstream DataPublisher used by the Relay.
+func (r *Relay) DataPublisher() *transport.DataPublisher {
+	return r.publisher
+}
+
+// Metadata gets the last metadata received from the upstream publisher, including any derived
+// ActiveMeasurements table, or nil when no metadata has been received.
+func (r *Relay) Metadata() *data.DataSet {
+	return r.dataSet.Load()
+}
+
+// SetStatusMessageLogger defines the callback that handles informational message logging.
+// Define callback before calling Dial or Listen.
+func (r *Relay) SetStatusMessageLogger(callback func(message string)) {
+	r.statusMessageLogger = callback
+}
+
+// SetErrorMessageLogger defines the callback that handles error message logging.
+// Define callback before calling Dial or Listen.
+func (r *Relay) SetErrorMessageLogger(callback func(message string)) {
+	r.errorMessageLogger = callback
+}
+
+// Subscribe defines the filter expression and settings of the upstream subscription, see Subscriber.Subscribe.
+// Downstream subscribers only receive measurements included in the upstream subscription. Call before Dial.
+func (r *Relay) Subscribe(filterExpression string, settings *Settings) {
+	r.filterExpression = filterExpression
+	r.settings = settings
+}
+
+// Listen starts the downstream publisher on the specified TCP address, e.g., ":7165". Downstream
+// subscriptions fail until metadata has been received from the upstream publisher.
+func (r *Relay) Listen(address string) error {
+	r.publisher.StatusMessageCallback = r.StatusMessage
+	r.publisher.ErrorMessageCallback = r.ErrorMessage
+	r.publisher.SubscriptionRequestedCallback = r.handleSubscriptionRequested
+
+	return r.publisher.Start(address)
+}
+
+// Dial connects to the upstream publisher at the specified HOSTNAME:PORT address. Config parameter
+// controls connection related settings, set value to nil for default values. Upon connection, metadata
+// is requested and, once received, the upstream subscription is established. The AutoRequestMetadata,
+// AutoSubscribe and MetadataCachePath settings of config are not used.
+func (r *Relay) Dial(address string, config *Config) error {
+	hostname, portname, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return err
+	}
+
+	port, err := strconv.Atoi(portname)
+
+	if err != nil {
+		return fmt.Errorf("invalid port number \"%s\": %s", portname, err.Error())
+	}
+
+	if port < 1 || port > math.MaxUint16 {
+		return fmt.Errorf("port number \"%s\" is out of range: must be 1 to %d", portname, math.MaxUint16)
+	}
+
+	if config != nil {
+		r.config = config
+	}
+
+	ds := r.ds
+	con := ds.Connector()
+
+	con.Hostname = hostname
+	con.Port = uint16(port)
+	con.MaxRetries = r.config.MaxRetries
+	con.RetryInterval = r.config.RetryInterval
+	con.MaxRetryInterval = r.config.MaxRetryInterval
+	con.AutoReconnect = r.config.AutoReconnect
+
+	ds.CompressPayloadData = r.config.CompressPayloadData
+	ds.CompressMetadata = r.config.CompressMetadata
+	ds.CompressSignalIndexCache = r.config.CompressSignalIndexCache
+	ds.Version = r.config.Version
+	ds.SwapGuidEndianness = !r.config.RfcGuidEncoding
+
+	con.BeginCallbackAssignment()
+	ds.BeginCallbackAssignment()
+
+	con.ErrorMessageCallback = r.ErrorMessage
+	con.ReconnectCallback = r.handleReconnect
+	ds.StatusMessageCallback = r.StatusMessage
+	ds.ErrorMessageCallback = r.ErrorMessage
+	ds.ConnectionTerminatedCallback = r.handleConnectionTerminated
+	ds.MetadataReceivedCallback = r.handleMetadataReceived
+	ds.ConfigurationChangedCallback = r.handleConfigurationChanged
+	ds.NotificationReceivedCallback = r.handleNotification
+	ds.NewMeasurementsCallback = r.handleNewMeasurements
+
+	con.EndCallbackAssignment()
+	ds.EndCallbackAssignment()
+
+	switch con.Connect(ds) {
+	case transport.ConnectStatus.Success:
+		r.handleConnect()
+	case transport.ConnectStatus.Failed:
+		return errors.New("all connection attempts failed")
+	case transport.ConnectStatus.Canceled:
+		return errors.New("connection canceled")
+	}
+
+	return nil
+}
+
+// StatusMessage executes the defined status message logger callback.
+func (r *Relay) StatusMessage(message string) {
+	if r.statusMessageLogger != nil {
+		r.statusMessageLogger(message)
+	}
+}
+
+// ErrorMessage executes the defined error message logger callback.
+func (r *Relay) ErrorMessage(message string) {
+	if r.errorMessageLogger != nil {
+		r.errorMessageLogger(message)
+	}
+}
+
+// DefaultStatusMessageLogger implements the default handler for the statusMessage callback.
+// Default implementation synchronously writes output to stdio.
+func (r *Relay) DefaultStatusMessageLogger(message string) {
+	r.consoleLock.Lock()
+	defer r.consoleLock.Unlock()
+	fmt.Println(message)
+}
+
+// DefaultErrorMessageLogger implements the default handler for the errorMessage callback.
+// Default implementation synchronously writes output to stderr.
+func (r *Relay) DefaultErrorMessageLogger(message string) {
+	r.consoleLock.Lock()
+	defer r.consoleLock.Unlock()
+	fmt.Fprintln(os.Stderr, message)
+}
+
+// Upstream callback handlers:
+
+func (r *Relay) handleConnect() {
+	r.StatusMessage("Relay connected to upstream publisher " + r.ds.ConnectionID() + ", requesting metadata...")
+	requestMetadata(r.ds, r.config.MetadataFilters)
+}
+
+func (r *Relay) handleReconnect(ds *transport.DataSubscriber) {
+	if ds.IsConnected() {
+		r.handleConnect()
+	} else {
+		ds.Disconnect()
+		r.StatusMessage("Relay upstream connection retry attempts exceeded.")
+	}
+}
+
+func (r *Relay) handleConnectionTerminated() {
+	r.ErrorMessage("Relay upstream connection for " + r.ds.ConnectionID() + " terminated.")
+}
+
+func (r *Relay) handleMetadataReceived(metadata []byte) {
+	dataSet := data.NewDataSet()
+
+	if err := dataSet.ParseXml(metadata); err != nil {
+		r.ErrorMessage("Failed to parse received upstream XML metadata: " + err.Error())
+		return
+	}
+
+	if err := loadMeasurementMetadata(r.ds, dataSet); err != nil {
+		r.ErrorMessage(err.Error())
+		return
+	}
+
+	addActiveMeasurements(dataSet)
+
+	r.metadataLock.Lock()
+	changed := r.metadata != nil && !bytes.Equal(r.metadata, metadata)
+	r.metadata = metadata
+	r.metadataLock.Unlock()
+
+	r.dataSet.Store(dataSet)
+	r.publisher.SetMetadata(metadata)
+
+	// Downstream subscribers refresh metadata and resubscribe when configuration has changed
+	if r.configurationChanged.Swap(false) || changed {
+		r.StatusMessage("Relaying upstream configuration change to downstream subscribers")
+		r.publisher.SendConfigurationChanged()
+	}
+
+	applySettings(r.ds.Subscription(), r.filterExpression, r.settings)
+
+	if err := r.ds.Subscribe(); err != nil {
+		r.ErrorMessage("Failed to subscribe to upstream publisher: " + err.Error())
+	}
+}
+
+func (r *Relay) handleConfigurationChanged() {
+	r.configurationChanged.Store(true)
+	requestMetadata(r.ds, r.config.MetadataFilters)
+}
+
+func (r *Relay) handleNotification(notification string) {
+	r.publisher.SendNotification(notification)
+}
+
+func (r *Relay) handleNewMeasurements(measurements *[]transport.Measurement) {
+	r.publisher.PublishMeasurements(*measurements)
+
+	// Measurements are encoded before publish returns, return slice to the pool
+	*measurements = (*measurements)[:0]
+	r.ds.MeasurementPool.Put(measurements)
+}
+
+// Downstream callback handlers:
+
+func (r *Relay) handleSubscriptionRequested(connection *transport.SubscriberConnection, filterExpression string) ([]*transport.MeasurementMetadata, error) {
+	dataSet := r.dataSet.Load()
+
+	if dataSet == nil {
+		return nil, errors.New("metadata has not been received from upstream publisher")
+	}
+
+	parser, err := data.NewFilterExpressionParserForDataSet(dataSet, filterExpression, activeMeasurementsTableName, nil, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parser.TrackFilteredRows = false
+	parser.TrackFilteredSignalIDs = true
+
+	if err := parser.Evaluate(true, true); err != nil {
+		return nil, errors.New("failed to evaluate filter expression \"" + filterExpression + "\": " + err.Error())
+	}
+
+	signalIDs := parser.FilteredSignalIDs()
+	signals := make([]*transport.MeasurementMetadata, len(signalIDs))
+
+	for i, signalID := range signalIDs {
+		signals[i] = r.ds.LookupMetadata(signalID)
+	}
+
+	return signals, nil
+}
+
+const activeMeasurementsTableName = "ActiveMeasurements"
+
+// addActiveMeasurements adds an ActiveMeasurements table, derived from the enabled rows of the MeasurementDetail
+// table, to a dataSet that does not define one so that common subscription filter expressions can be evaluated.
+func addActiveMeasurements(dataSet *data.DataSet) {
+	measurementDetail := dataSet.Table("MeasurementDetail")
+
+	if measurementDetail == nil || dataSet.Table(activeMeasurementsTableName) != nil {
+		return
+	}
+
+	activeMeasurements := dataSet.CreateTable(activeMeasurementsTableName)
+	activeMeasurements.InitColumns(measurementDetail.ColumnCount() + 2)
+
+	for i := 0; i < measurementDetail.ColumnCount(); i++ {
+		activeMeasurements.AddColumn(activeMeasurements.CloneColumn(measurementDetail.Column(i)))
+	}
+
+	// Define common ActiveMeasurements fields as aliases of their MeasurementDetail fields
+	aliases := [][2]int{}
+
+	for _, alias := range [][2]string{{"SignalType", "SignalAcronym"}, {"Device", "DeviceAcronym"}} {
+		if source := measurementDetail.ColumnIndex(alias[1]); source > -1 && activeMeasurements.ColumnIndex(alias[0]) < 0 {
+			column := activeMeasurements.CreateColumn(alias[0], measurementDetail.Column(source).Type(), "")
+			activeMeasurements.AddColumn(column)
+			aliases = append(aliases, [2]int{column.Index(), source})
+		}
+	}
+
+	enabledIndex := measurementDetail.ColumnIndex("Enabled")
+
+	for i := 0; i < measurementDetail.RowCount(); i++ {
+		source := measurementDetail.Row(i)
+
+		if source == nil {
+			continue
+		}
+
+		if enabledIndex > -1 {
+			if enabled, null, err := source.BooleanValue(enabledIndex); err == nil && !null && !enabled {
+				continue
+			}
+		}
+
+		row := activeMeasurements.CloneRow(source)
+
+		for _, alias := range aliases {
+			value, _ := source.Value(alias[1])
+			row.SetValue(alias[0], value)
+		}
+
+		activeMeasurements.AddRow(row)
+	}
+
+	dataSet.AddTable(activeMeasurements)
+}
@@ -0,0 +1,183 @@
+//******************************************************************************************************
+//  Relay_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// relayTestSubscriber records the signals, notifications and configuration changes received by a downstream subscriber.
+type relayTestSubscriber struct {
+	*Subscriber
+	mutex                sync.Mutex
+	signalIDs            map[guid.Guid]bool
+	notifications        []string
+	configurationChanged int
+}
+
+func newRelayTestSubscriber(t *testing.T, address string, filterExpression string, compressed bool) *relayTestSubscriber {
+	subscriber := &relayTestSubscriber{Subscriber: NewSubscriber(), signalIDs: make(map[guid.Guid]bool)}
+	subscriber.SetStatusMessageLogger(nil)
+	subscriber.SetErrorMessageLogger(func(message string) { t.Log(message) })
+	subscriber.SetConnectionEstablishedReceiver(nil)
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		subscriber.mutex.Lock()
+
+		for _, measurement := range *measurements {
+			subscriber.signalIDs[measurement.SignalID] = true
+		}
+
+		subscriber.mutex.Unlock()
+		subscriber.PutMeasurementSlice(measurements)
+	})
+
+	subscriber.SetNotificationReceiver(func(notification string) {
+		subscriber.mutex.Lock()
+		subscriber.notifications = append(subscriber.notifications, notification)
+		subscriber.mutex.Unlock()
+	})
+
+	subscriber.SetConfigurationChangedReceiver(func() {
+		subscriber.mutex.Lock()
+		subscriber.configurationChanged++
+		subscriber.mutex.Unlock()
+	})
+
+	config := NewConfig()
+	config.AutoReconnect = false
+	config.CompressPayloadData = compressed
+
+	subscriber.Subscribe(filterExpression, nil)
+
+	if err := subscriber.Dial(address, config); err != nil {
+		t.Fatal("TestRelay: failed to connect downstream subscriber: " + err.Error())
+	}
+
+	return subscriber
+}
+
+// waitFor polls condition until it is true or a timeout expires.
+func waitFor(condition func() bool) bool {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestRelay(t *testing.T) {
+	generator := transport.NewLoadGenerator()
+	generator.SignalCount = 6
+	generator.FramesPerSecond = 50
+
+	if err := generator.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("TestRelay: failed to start load generator: " + err.Error())
+	}
+
+	defer generator.Stop()
+
+	relay := NewRelay()
+	relay.SetStatusMessageLogger(nil)
+	relay.SetErrorMessageLogger(func(message string) { t.Log(message) })
+	defer relay.Close()
+
+	if err := relay.Listen("127.0.0.1:0"); err != nil {
+		t.Fatal("TestRelay: failed to start relay publisher: " + err.Error())
+	}
+
+	config := NewConfig()
+	config.AutoReconnect = false
+
+	if err := relay.Dial(generator.Address(), config); err != nil {
+		t.Fatal("TestRelay: failed to connect relay to upstream publisher: " + err.Error())
+	}
+
+	if !waitFor(func() bool { return relay.Metadata() != nil }) {
+		t.Fatal("TestRelay: timeout waiting for upstream metadata")
+	}
+
+	// Sine waveform signals alternate between phasor magnitudes and angles
+	signalIDs := generator.SignalIDs()
+	magnitudes := newRelayTestSubscriber(t, relay.DataPublisher().Address(), "FILTER ActiveMeasurements WHERE SignalType = 'VPHM'", false)
+	defer magnitudes.Close()
+
+	angles := newRelayTestSubscriber(t, relay.DataPublisher().Address(), "LOADGEN:2; LOADGEN:4", true)
+	defer angles.Close()
+
+	expected := map[*relayTestSubscriber][]guid.Guid{
+		magnitudes: {signalIDs[0], signalIDs[2], signalIDs[4]},
+		angles:     {signalIDs[1], signalIDs[3]},
+	}
+
+	for subscriber, expectedIDs := range expected {
+		received := waitFor(func() bool {
+			subscriber.mutex.Lock()
+			defer subscriber.mutex.Unlock()
+			return len(subscriber.signalIDs) >= len(expectedIDs)
+		})
+
+		subscriber.mutex.Lock()
+
+		if !received || len(subscriber.signalIDs) != len(expectedIDs) {
+			t.Fatalf("TestRelay: expected %d signals for \"%s\", received: %d", len(expectedIDs), subscriber.dataSubscriber().Subscription().FilterExpression, len(subscriber.signalIDs))
+		}
+
+		for _, signalID := range expectedIDs {
+			if !subscriber.signalIDs[signalID] {
+				t.Fatal("TestRelay: expected signal " + signalID.String() + " was not received")
+			}
+		}
+
+		subscriber.mutex.Unlock()
+	}
+
+	generator.Publisher().SendNotification("relayed notification")
+	generator.Publisher().SendConfigurationChanged()
+
+	for subscriber := range expected {
+		if !waitFor(func() bool {
+			subscriber.mutex.Lock()
+			defer subscriber.mutex.Unlock()
+			return len(subscriber.notifications) > 0 && subscriber.configurationChanged > 0
+		}) {
+			t.Fatal("TestRelay: expected notification and configuration change to be relayed")
+		}
+
+		if subscriber.notifications[0] != "relayed notification" {
+			t.Fatal("TestRelay: unexpected relayed notification: " + subscriber.notifications[0])
+		}
+	}
+
+	if _, err := relay.handleSubscriptionRequested(nil, "FILTER ActiveMeasurements WHERE"); err == nil {
+		t.Fatal("TestRelay: expected error for invalid filter expression")
+	}
+}
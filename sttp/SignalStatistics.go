@@ -0,0 +1,487 @@
+//******************************************************************************************************
+//  SignalStatistics.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// SignalStatisticsTableName defines the name of the table created by SignalStatistics.Table.
+const SignalStatisticsTableName = "SignalStatistics"
+
+const (
+	// statisticsIntervalCount defines the number of intervals a statistics window is divided into,
+	// the window rolls forward one interval at a time.
+	statisticsIntervalCount = 12
+
+	// stateFlagCount defines the number of StateFlags bits tracked per signal.
+	stateFlagCount = 32
+
+	// rateSampleCount defines the number of recent timestamp deltas used to infer a signal's nominal rate.
+	rateSampleCount = 32
+
+	// gapThreshold defines the multiple of the nominal interval between timestamps considered a gap.
+	gapThreshold = 1.5
+
+	// latencyBucketCount defines the number of latency histogram buckets. Bucket upper bounds grow
+	// geometrically by a quarter power of two from one millisecond to about two minutes; the last
+	// bucket holds any greater latency.
+	latencyBucketCount = 70
+)
+
+// Column indexes of the signal statistics table.
+const (
+	statisticsSignalIDColumn = iota
+	statisticsIDColumn
+	statisticsSourceColumn
+	statisticsPointTagColumn
+	statisticsSignalTypeColumn
+	statisticsWindowStartColumn
+	statisticsWindowEndColumn
+	statisticsNominalRateColumn
+	statisticsReceivedColumn
+	statisticsExpectedColumn
+	statisticsMissingColumn
+	statisticsCompletenessColumn
+	statisticsGapsColumn
+	statisticsMaxGapColumn
+	statisticsOutOfOrderColumn
+	statisticsLateColumn
+	statisticsLatencyP50Column
+	statisticsLatencyP90Column
+	statisticsLatencyP99Column
+	statisticsLatencyMaxColumn
+	statisticsFlaggedColumn
+	statisticsFirstFlagColumn
+	statisticsColumnCount = statisticsFirstFlagColumn + stateFlagCount
+)
+
+// SignalStatistics computes per-signal data quality and completeness statistics over a rolling window
+// of received measurements. Attach SignalStatistics to a Subscriber with Subscriber.SetSignalStatistics
+// or call AddMeasurements directly.
+//
+// The nominal rate of each signal is inferred from the most common interval between its timestamps.
+// The window ends at the start of the interval that contains the latest received timestamp of any signal,
+// i.e., only completed intervals are included, and rolls forward one interval, a twelfth of the window
+// size, at a time. Since the first interval a signal is seen in is only partially covered, expected counts
+// start with the following interval. Latency is measured relative to the local clock at time of receipt.
+type SignalStatistics struct {
+	// WindowSize defines the duration of the rolling window used to compute statistics, defaults to one minute.
+	// Changes take effect after Reset.
+	WindowSize time.Duration
+
+	// LateTime defines the latency after which a measurement is considered to have arrived late, defaults to
+	// one second.
+	LateTime time.Duration
+
+	intervalTicks  ticks.Ticks
+	latestInterval int64
+	signals        map[guid.Guid]*signalStatistics
+	mutex          sync.Mutex
+}
+
+// signalInterval accumulates the statistics of one signal for one interval of the rolling window.
+type signalInterval struct {
+	index      int64
+	received   uint32
+	flagged    uint32
+	gaps       uint32
+	outOfOrder uint32
+	late       uint32
+	maxGap     ticks.Ticks
+	maxLatency float64
+	flags      [stateFlagCount]uint32
+	latency    [latencyBucketCount]uint32
+}
+
+// signalStatistics holds the rolling window intervals, including the current partial interval, of one signal.
+type signalStatistics struct {
+	firstInterval   int64
+	lastTimestamp   ticks.Ticks
+	nominalRate     float64
+	rateSamples     [rateSampleCount]float64
+	rateSampleIndex int
+	intervals       [statisticsIntervalCount + 1]signalInterval
+}
+
+// NewSignalStatistics creates a new SignalStatistics with a one minute window.
+func NewSignalStatistics() *SignalStatistics {
+	return &SignalStatistics{
+		WindowSize: time.Minute,
+		LateTime:   time.Second,
+	}
+}
+
+// Reset clears all accumulated statistics and applies any WindowSize change.
+func (ss *SignalStatistics) Reset() {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	ss.signals = nil
+}
+
+// AddMeasurements adds the specified measurements, received now, to the statistics.
+func (ss *SignalStatistics) AddMeasurements(measurements []transport.Measurement) {
+	ss.addMeasurements(measurements, ticks.UtcNow())
+}
+
+func (ss *SignalStatistics) addMeasurements(measurements []transport.Measurement, received ticks.Ticks) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	if ss.signals == nil {
+		ss.signals = make(map[guid.Guid]*signalStatistics)
+		ss.intervalTicks = ticks.Ticks(ss.WindowSize.Nanoseconds() / 100 / statisticsIntervalCount)
+		ss.latestInterval = 0
+
+		if ss.intervalTicks <= 0 {
+			ss.intervalTicks = ticks.Ticks(time.Minute.Nanoseconds() / 100 / statisticsIntervalCount)
+		}
+	}
+
+	lateTime := ss.LateTime.Seconds()
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		timestamp := measurement.Timestamp & ticks.ValueMask
+
+		if timestamp == 0 {
+			continue
+		}
+
+		intervalIndex := int64(timestamp / ss.intervalTicks)
+		signal, ok := ss.signals[measurement.SignalID]
+
+		if !ok {
+			signal = &signalStatistics{firstInterval: intervalIndex}
+
+			for j := range signal.intervals {
+				signal.intervals[j].index = -1
+			}
+
+			ss.signals[measurement.SignalID] = signal
+		}
+
+		if intervalIndex > ss.latestInterval {
+			ss.latestInterval = intervalIndex
+		}
+
+		interval := &signal.intervals[intervalIndex%int64(len(signal.intervals))]
+
+		if interval.index != intervalIndex {
+			// Measurements older than the retained intervals are not counted
+			if interval.index > intervalIndex {
+				continue
+			}
+
+			*interval = signalInterval{index: intervalIndex}
+		}
+
+		interval.received++
+		signal.addTimestamp(interval, timestamp)
+
+		if flags := uint32(measurement.Flags); flags != 0 {
+			interval.flagged++
+
+			for bit := 0; bit < stateFlagCount; bit++ {
+				if flags&(1<<bit) != 0 {
+					interval.flags[bit]++
+				}
+			}
+		}
+
+		latency := float64(received-timestamp) / float64(ticks.PerSecond)
+		interval.latency[latencyBucket(latency)]++
+
+		if latency > interval.maxLatency || interval.received == 1 {
+			interval.maxLatency = latency
+		}
+
+		if latency > lateTime {
+			interval.late++
+		}
+	}
+}
+
+// addTimestamp updates nominal rate inference, gap and out-of-order statistics with a new timestamp.
+func (signal *signalStatistics) addTimestamp(interval *signalInterval, timestamp ticks.Ticks) {
+	lastTimestamp := signal.lastTimestamp
+
+	if timestamp < lastTimestamp {
+		interval.outOfOrder++
+		return
+	}
+
+	signal.lastTimestamp = timestamp
+
+	if lastTimestamp == 0 || timestamp == lastTimestamp {
+		return
+	}
+
+	delta := timestamp - lastTimestamp
+
+	// Rates are rounded so that timestamp rounding, e.g., of 1/60 second intervals, yields a common rate
+	signal.rateSamples[signal.rateSampleIndex] = math.Round(float64(ticks.PerSecond)/float64(delta)*100) / 100
+	signal.rateSampleIndex = (signal.rateSampleIndex + 1) % rateSampleCount
+
+	if signal.nominalRate == 0 || signal.rateSampleIndex == 0 {
+		signal.nominalRate = signal.inferNominalRate()
+	}
+
+	if float64(delta)*signal.nominalRate > gapThreshold*float64(ticks.PerSecond) {
+		interval.gaps++
+
+		if delta > interval.maxGap {
+			interval.maxGap = delta
+		}
+	}
+}
+
+// inferNominalRate gets the most common recent rate, preferring the higher rate on ties.
+func (signal *signalStatistics) inferNominalRate() float64 {
+	counts := make(map[float64]int, rateSampleCount)
+	var nominalRate float64
+	var nominalCount int
+
+	for _, rate := range signal.rateSamples {
+		if rate == 0 {
+			continue
+		}
+
+		counts[rate]++
+
+		if count := counts[rate]; count > nominalCount || count == nominalCount && rate > nominalRate {
+			nominalRate = rate
+			nominalCount = count
+		}
+	}
+
+	return nominalRate
+}
+
+// latencyBucket gets the histogram bucket index of the specified latency in seconds.
+func latencyBucket(latency float64) int {
+	if latency <= 0.001 {
+		return 0
+	}
+
+	bucket := int(math.Ceil(4 * math.Log2(latency*1000)))
+
+	if bucket >= latencyBucketCount {
+		return latencyBucketCount - 1
+	}
+
+	return bucket
+}
+
+// latencyBucketBound gets the upper bound, in seconds, of the specified latency histogram bucket.
+func latencyBucketBound(bucket int) float64 {
+	return math.Exp2(float64(bucket)/4) / 1000
+}
+
+// latencyPercentile gets the upper bound, in seconds, of the latency histogram bucket that contains the
+// specified percentile, limited to the maximum latency.
+func latencyPercentile(histogram *[latencyBucketCount]uint32, count uint32, percentile float64, maxLatency float64) float64 {
+	target := uint32(math.Ceil(float64(count) * percentile / 100))
+	var cumulative uint32
+
+	for bucket, bucketCount := range histogram {
+		cumulative += bucketCount
+
+		if cumulative >= target {
+			return math.Min(latencyBucketBound(bucket), maxLatency)
+		}
+	}
+
+	return maxLatency
+}
+
+// Table gets the current statistics as a DataTable, named "SignalStatistics", with one row per signal. The
+// table can be filtered with DataTable.Select, e.g., "Completeness < 99.5 OR BadData > 0". The optional
+// metadata function, e.g., Subscriber.LookupMetadata, provides the ID, Source, PointTag and SignalType
+// columns. Columns include the inferred NominalRate, in frames per second, Received, Expected and Missing
+// measurement counts, Completeness as a percentage of expected, Gaps and the MaxGap in seconds, OutOfOrder
+// and Late measurement counts, LatencyP50, LatencyP90, LatencyP99 and LatencyMax in seconds, the Flagged
+// count of measurements with any StateFlags set and a count column for each StateFlags bit, e.g., BadData.
+func (ss *SignalStatistics) Table(metadata func(signalID guid.Guid) *transport.MeasurementMetadata) *data.DataTable {
+	dataSet := data.NewDataSet()
+	dataTable := dataSet.CreateTable(SignalStatisticsTableName)
+	dataTable.InitColumns(statisticsColumnCount)
+
+	addColumn := func(name string, dataType data.DataTypeEnum) {
+		dataTable.AddColumn(dataTable.CreateColumn(name, dataType, ""))
+	}
+
+	// Column order must match statistics column index constants
+	addColumn("SignalID", data.DataType.Guid)
+	addColumn("ID", data.DataType.UInt64)
+	addColumn("Source", data.DataType.String)
+	addColumn("PointTag", data.DataType.String)
+	addColumn("SignalType", data.DataType.String)
+	addColumn("WindowStart", data.DataType.DateTime)
+	addColumn("WindowEnd", data.DataType.DateTime)
+	addColumn("NominalRate", data.DataType.Double)
+	addColumn("Received", data.DataType.Int64)
+	addColumn("Expected", data.DataType.Int64)
+	addColumn("Missing", data.DataType.Int64)
+	addColumn("Completeness", data.DataType.Double)
+	addColumn("Gaps", data.DataType.Int64)
+	addColumn("MaxGap", data.DataType.Double)
+	addColumn("OutOfOrder", data.DataType.Int64)
+	addColumn("Late", data.DataType.Int64)
+	addColumn("LatencyP50", data.DataType.Double)
+	addColumn("LatencyP90", data.DataType.Double)
+	addColumn("LatencyP99", data.DataType.Double)
+	addColumn("LatencyMax", data.DataType.Double)
+	addColumn("Flagged", data.DataType.Int64)
+
+	for bit := 0; bit < stateFlagCount; bit++ {
+		addColumn(transport.StateFlagsEnum(1<<bit).String(), data.DataType.Int64)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	// Window includes completed intervals only
+	lastInterval := ss.latestInterval - 1
+	firstInterval := lastInterval - statisticsIntervalCount + 1
+	windowStart := (ticks.Ticks(firstInterval) * ss.intervalTicks).ToTime()
+	windowEnd := (ticks.Ticks(lastInterval+1) * ss.intervalTicks).ToTime()
+	intervalSeconds := float64(ss.intervalTicks) / float64(ticks.PerSecond)
+
+	signalIDs := make([]guid.Guid, 0, len(ss.signals))
+
+	for signalID := range ss.signals {
+		signalIDs = append(signalIDs, signalID)
+	}
+
+	sort.Slice(signalIDs, func(i, j int) bool {
+		return signalIDs[i].String() < signalIDs[j].String()
+	})
+
+	for _, signalID := range signalIDs {
+		signal := ss.signals[signalID]
+		var total signalInterval
+		var coveredIntervals int64
+
+		for index := firstInterval; index <= lastInterval; index++ {
+			if index <= signal.firstInterval {
+				continue
+			}
+
+			coveredIntervals++
+			interval := &signal.intervals[index%int64(len(signal.intervals))]
+
+			if interval.index != index {
+				continue
+			}
+
+			if total.received == 0 || interval.maxLatency > total.maxLatency {
+				total.maxLatency = interval.maxLatency
+			}
+
+			total.received += interval.received
+			total.flagged += interval.flagged
+			total.gaps += interval.gaps
+			total.outOfOrder += interval.outOfOrder
+			total.late += interval.late
+
+			if interval.maxGap > total.maxGap {
+				total.maxGap = interval.maxGap
+			}
+
+			for bit := range interval.flags {
+				total.flags[bit] += interval.flags[bit]
+			}
+
+			for bucket := range interval.latency {
+				total.latency[bucket] += interval.latency[bucket]
+			}
+		}
+
+		expected := int64(math.Round(signal.nominalRate * intervalSeconds * float64(coveredIntervals)))
+		missing := expected - int64(total.received)
+		var completeness float64
+
+		if missing < 0 {
+			missing = 0
+		}
+
+		if expected > 0 {
+			completeness = float64(total.received) / float64(expected) * 100
+		}
+
+		row := dataTable.CreateRow()
+
+		row.SetValue(statisticsSignalIDColumn, signalID)
+
+		if metadata != nil {
+			if record := metadata(signalID); record != nil {
+				row.SetValue(statisticsIDColumn, record.ID)
+				row.SetValue(statisticsSourceColumn, record.Source)
+				row.SetValue(statisticsPointTagColumn, record.Tag)
+				row.SetValue(statisticsSignalTypeColumn, record.SignalType)
+			}
+		}
+
+		row.SetValue(statisticsWindowStartColumn, windowStart)
+		row.SetValue(statisticsWindowEndColumn, windowEnd)
+		row.SetValue(statisticsNominalRateColumn, signal.nominalRate)
+		row.SetValue(statisticsReceivedColumn, int64(total.received))
+		row.SetValue(statisticsExpectedColumn, expected)
+		row.SetValue(statisticsMissingColumn, missing)
+		row.SetValue(statisticsCompletenessColumn, completeness)
+		row.SetValue(statisticsGapsColumn, int64(total.gaps))
+		row.SetValue(statisticsMaxGapColumn, float64(total.maxGap)/float64(ticks.PerSecond))
+		row.SetValue(statisticsOutOfOrderColumn, int64(total.outOfOrder))
+		row.SetValue(statisticsLateColumn, int64(total.late))
+
+		if total.received > 0 {
+			row.SetValue(statisticsLatencyP50Column, latencyPercentile(&total.latency, total.received, 50, total.maxLatency))
+			row.SetValue(statisticsLatencyP90Column, latencyPercentile(&total.latency, total.received, 90, total.maxLatency))
+			row.SetValue(statisticsLatencyP99Column, latencyPercentile(&total.latency, total.received, 99, total.maxLatency))
+			row.SetValue(statisticsLatencyMaxColumn, total.maxLatency)
+		}
+
+		row.SetValue(statisticsFlaggedColumn, int64(total.flagged))
+
+		for bit := range total.flags {
+			row.SetValue(statisticsFirstFlagColumn+bit, int64(total.flags[bit]))
+		}
+
+		dataTable.AddRow(row)
+	}
+
+	return dataTable
+}
@@ -0,0 +1,143 @@
+//******************************************************************************************************
+//  SignalStatistics_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func TestSignalStatistics(t *testing.T) {
+	statistics := NewSignalStatistics()
+	statistics.WindowSize = 12 * time.Second
+
+	normal, degraded := guid.New(), guid.New()
+	base := ticks.FromTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	// Thirteen seconds of 30 frames per second data, degraded signal is missing ten frames, has five
+	// flagged as bad data and arrives with two seconds of latency
+	for frame := int64(0); frame <= 13*30; frame++ {
+		timestamp := base + ticks.Ticks(frame*int64(ticks.PerSecond)/30)
+		statistics.addMeasurements([]transport.Measurement{{SignalID: normal, Timestamp: timestamp}}, timestamp+50*ticks.PerMillisecond)
+
+		if frame >= 100 && frame < 110 {
+			continue
+		}
+
+		measurement := transport.Measurement{SignalID: degraded, Timestamp: timestamp}
+
+		if frame >= 200 && frame < 205 {
+			measurement.Flags = transport.StateFlags.BadData
+		}
+
+		statistics.addMeasurements([]transport.Measurement{measurement}, timestamp+2*ticks.PerSecond)
+	}
+
+	table := statistics.Table(func(signalID guid.Guid) *transport.MeasurementMetadata {
+		return &transport.MeasurementMetadata{SignalID: signalID, Source: "TEST", ID: 1, Tag: "TAG"}
+	})
+
+	if table.Name() != SignalStatisticsTableName || table.RowCount() != 2 {
+		t.Fatalf("TestSignalStatistics: unexpected statistics table row count: %d", table.RowCount())
+	}
+
+	rows, err := table.Select("Completeness < 99.5 OR BadData > 0", "", -1)
+
+	if err != nil {
+		t.Fatal("TestSignalStatistics: failed to select statistics: " + err.Error())
+	}
+
+	if len(rows) != 1 {
+		t.Fatalf("TestSignalStatistics: expected one degraded signal, received: %d", len(rows))
+	}
+
+	if signalID, _, _ := rows[0].GuidValueByName("SignalID"); signalID != degraded {
+		t.Fatal("TestSignalStatistics: unexpected degraded signal ID")
+	}
+
+	expectInt64 := func(rowIndex int, columnName string, expected int64) {
+		if value, _, _ := table.Row(rowIndex).Int64ValueByName(columnName); value != expected {
+			t.Fatalf("TestSignalStatistics: row %d, expected %s of %d, received: %d", rowIndex, columnName, expected, value)
+		}
+	}
+
+	expectDouble := func(rowIndex int, columnName string, expected float64) {
+		if value, _, _ := table.Row(rowIndex).DoubleValueByName(columnName); math.Abs(value-expected) > 1e-6 {
+			t.Fatalf("TestSignalStatistics: row %d, expected %s of %f, received: %f", rowIndex, columnName, expected, value)
+		}
+	}
+
+	for rowIndex := 0; rowIndex < table.RowCount(); rowIndex++ {
+		signalID, _, _ := table.Row(rowIndex).GuidValueByName("SignalID")
+
+		// Window covers the twelve completed intervals after the first, partially covered, interval
+		expectDouble(rowIndex, "NominalRate", 30)
+		expectInt64(rowIndex, "Expected", 360)
+
+		if table.RowValueAsStringByName(rowIndex, "Source") != "TEST" || table.RowValueAsStringByName(rowIndex, "PointTag") != "TAG" {
+			t.Fatal("TestSignalStatistics: unexpected metadata columns")
+		}
+
+		if signalID == normal {
+			expectInt64(rowIndex, "Received", 360)
+			expectInt64(rowIndex, "Missing", 0)
+			expectDouble(rowIndex, "Completeness", 100)
+			expectInt64(rowIndex, "Gaps", 0)
+			expectInt64(rowIndex, "Late", 0)
+			expectInt64(rowIndex, "Flagged", 0)
+			expectDouble(rowIndex, "LatencyP50", 0.05)
+			expectDouble(rowIndex, "LatencyMax", 0.05)
+		} else {
+			expectInt64(rowIndex, "Received", 350)
+			expectInt64(rowIndex, "Missing", 10)
+			expectDouble(rowIndex, "Completeness", 350.0/360.0*100)
+			expectInt64(rowIndex, "Gaps", 1)
+			expectDouble(rowIndex, "MaxGap", float64(ticks.Ticks(110*int64(ticks.PerSecond)/30)-ticks.Ticks(99*int64(ticks.PerSecond)/30))/float64(ticks.PerSecond))
+			expectInt64(rowIndex, "Late", 350)
+			expectInt64(rowIndex, "Flagged", 5)
+			expectInt64(rowIndex, "BadData", 5)
+			expectInt64(rowIndex, "SuspectData", 0)
+			expectDouble(rowIndex, "LatencyP99", 2)
+		}
+	}
+
+	// Signals that stop reporting lose completeness as the window rolls forward
+	timestamp := base + 20*ticks.PerSecond
+	statistics.addMeasurements([]transport.Measurement{{SignalID: normal, Timestamp: timestamp}}, timestamp)
+
+	if rows, _ := statistics.Table(nil).Select("Completeness > 50", "", -1); len(rows) != 0 {
+		t.Fatal("TestSignalStatistics: expected completeness to decrease for stale window")
+	}
+
+	statistics.Reset()
+
+	if statistics.Table(nil).RowCount() != 0 {
+		t.Fatal("TestSignalStatistics: expected no statistics after reset")
+	}
+}
@@ -33,11 +33,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sttp/goapi/sttp/data"
 	"github.com/sttp/goapi/sttp/format"
 	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
 	"github.com/sttp/goapi/sttp/ticks"
 	"github.com/sttp/goapi/sttp/transport"
 )
@@ -67,6 +69,21 @@ type Subscriber struct {
 	// Lock used to synchronize console writes
 	consoleLock sync.Mutex
 
+	// Last metadata loaded from, or saved to, the metadata cache
+	cachedMetadata     *data.DataSet
+	cachedMetadataLock sync.Mutex
+
+	// Typed model of last received metadata
+	metadataModel atomic.Pointer[metadata.Model]
+
+	// New measurement receivers, dispatched by handleNewMeasurements
+	newMeasurementsReceiver      func(measurements *[]transport.Measurement)
+	filteredMeasurementsReceiver func(measurements []transport.Measurement)
+	measurementFilter            *MeasurementFilter
+	signalStatistics             *SignalStatistics
+	phasorsReceiver              func(phasors []Phasor)
+	phasorAligner                *phasorAligner
+
 	assigningHandlerMutex sync.RWMutex
 }
 
@@ -159,12 +176,31 @@ func (sb *Subscriber) TotalMeasurementsReceived() uint64 {
 	return sb.dataSubscriber().TotalMeasurementsReceived()
 }
 
+// EnableMetrics enables collection of health metrics that, unlike the Total statistics functions, accumulate
+// across reconnects. The returned metrics implement http.Handler to serve a Prometheus scrape endpoint.
+func (sb *Subscriber) EnableMetrics() *transport.SubscriberMetrics {
+	return sb.dataSubscriber().EnableMetrics()
+}
+
+// Metrics gets the health metrics of the Subscriber, or nil if metrics have not been enabled.
+func (sb *Subscriber) Metrics() *transport.SubscriberMetrics {
+	return sb.dataSubscriber().Metrics()
+}
+
 // LookupMetadata gets the MeasurementMetadata for the specified signalID from the local
 // registry. If the metadata does not exist, a new record is created and returned.
 func (sb *Subscriber) LookupMetadata(signalID guid.Guid) *transport.MeasurementMetadata {
 	return sb.dataSubscriber().LookupMetadata(signalID)
 }
 
+// MetadataModel gets the strongly typed model of the standard STTP metadata tables, e.g., DeviceDetail,
+// MeasurementDetail and PhasorDetail, with relationships resolved. The model is replaced after each
+// metadata refresh, or load of cached metadata; nil is returned if no metadata has been received or
+// the publisher reported a configuration change since metadata was last received.
+func (sb *Subscriber) MetadataModel() *metadata.Model {
+	return sb.metadataModel.Load()
+}
+
 // Metadata gets the measurement-level metadata associated with a measurement from the local
 // registry. If the metadata does not exist, a new record is created and returned.
 func (sb *Subscriber) Metadata(measurement *transport.Measurement) *transport.MeasurementMetadata {
@@ -213,6 +249,8 @@ func (sb *Subscriber) Dial(address string, config *Config) error {
 		sb.config = config
 	}
 
+	sb.loadConfiguredMetadataCache()
+
 	return sb.connect(hostname, uint16(port))
 }
 
@@ -310,6 +348,8 @@ func (sb *Subscriber) Listen(address string, config *Config) error {
 		sb.config = config
 	}
 
+	sb.loadConfiguredMetadataCache()
+
 	return sb.listen(uint16(port), networkInterface)
 }
 
@@ -355,14 +395,17 @@ func (sb *Subscriber) Disconnect() {
 // RequestMetadata sends a request to the data publisher indicating that the Subscriber would
 // like new metadata. Any defined MetadataFilters will be included in request.
 func (sb *Subscriber) RequestMetadata() {
-	ds := sb.dataSubscriber()
+	requestMetadata(sb.dataSubscriber(), sb.config.MetadataFilters)
+}
 
-	if len(sb.config.MetadataFilters) == 0 {
+// requestMetadata sends a metadata refresh request, including any metadataFilters, to the data publisher.
+func requestMetadata(ds *transport.DataSubscriber, metadataFilters string) {
+	if len(metadataFilters) == 0 {
 		ds.SendServerCommand(transport.ServerCommand.MetadataRefresh)
 		return
 	}
 
-	filters := ds.EncodeString(sb.config.MetadataFilters)
+	filters := ds.EncodeString(metadataFilters)
 	buffer := make([]byte, 4+len(filters))
 
 	binary.BigEndian.PutUint32(buffer, uint32(len(filters)))
@@ -393,8 +436,15 @@ func (sb *Subscriber) RequestMetadata() {
 // Settings parameter controls subscription related settings, set value to nil for default values.
 func (sb *Subscriber) Subscribe(filterExpression string, settings *Settings) {
 	ds := sb.dataSubscriber()
-	sub := ds.Subscription()
+	applySettings(ds.Subscription(), filterExpression, settings)
+
+	if ds.IsConnected() {
+		ds.Subscribe()
+	}
+}
 
+// applySettings maps the filterExpression and Settings, or default settings when nil, to the SubscriptionInfo.
+func applySettings(sub *transport.SubscriptionInfo, filterExpression string, settings *Settings) {
 	if settings == nil {
 		settings = &settingsDefaults
 	}
@@ -423,10 +473,6 @@ func (sb *Subscriber) Subscribe(filterExpression string, settings *Settings) {
 	sub.ConstraintParameters = settings.ConstraintParameters
 	sub.ProcessingInterval = settings.ProcessingInterval
 	sub.ExtraConnectionStringParameters = settings.ExtraConnectionStringParameters
-
-	if ds.IsConnected() {
-		ds.Subscribe()
-	}
 }
 
 // Unsubscribe sends a request to the data publisher indicating that the Subscriber would
@@ -484,9 +530,125 @@ func (sb *Subscriber) ErrorMessage(message string) {
 	sb.endCallbackSync()
 }
 
+// LoadMetadataCache loads metadata previously persisted to the specified fileName, see
+// Config.MetadataCachePath, and applies it to the local measurement metadata registry so that
+// LookupMetadata works without a live metadata refresh. Any defined metadata receiver will be
+// called with the loaded DataSet. Cached metadata remains in effect until newer metadata is
+// received or the data publisher reports that its configuration has changed.
+func (sb *Subscriber) LoadMetadataCache(fileName string) (*data.DataSet, error) {
+	loadStarted := time.Now()
+	metadata, err := os.ReadFile(fileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err := dataSet.ParseXml(metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse cached XML metadata \"%s\": %s", fileName, err.Error())
+	}
+
+	sb.cachedMetadataLock.Lock()
+	sb.cachedMetadata = dataSet
+	sb.cachedMetadataLock.Unlock()
+
+	sb.loadMeasurementMetadata(dataSet)
+	sb.loadMetadataModel(dataSet)
+	sb.StatusMessage("Loaded cached metadata from \"" + fileName + "\".")
+	sb.showMetadataSummary(dataSet, loadStarted)
+
+	sb.beginCallbackSync()
+
+	if sb.metadataReceiver != nil {
+		sb.metadataReceiver(dataSet)
+	}
+
+	sb.endCallbackSync()
+
+	return dataSet, nil
+}
+
+func (sb *Subscriber) loadConfiguredMetadataCache() {
+	if len(sb.config.MetadataCachePath) == 0 {
+		return
+	}
+
+	sb.cachedMetadataLock.Lock()
+	loaded := sb.cachedMetadata != nil
+	sb.cachedMetadataLock.Unlock()
+
+	if loaded {
+		return
+	}
+
+	if _, err := os.Stat(sb.config.MetadataCachePath); err != nil {
+		return
+	}
+
+	if _, err := sb.LoadMetadataCache(sb.config.MetadataCachePath); err != nil {
+		sb.ErrorMessage("Failed to load metadata cache: " + err.Error())
+	}
+}
+
+func (sb *Subscriber) saveMetadataCache(metadata []byte, dataSet *data.DataSet) {
+	sb.cachedMetadataLock.Lock()
+	sb.cachedMetadata = dataSet
+	sb.cachedMetadataLock.Unlock()
+
+	cachePath := sb.config.MetadataCachePath
+
+	if len(cachePath) == 0 {
+		return
+	}
+
+	// Write to temporary file first so an interrupted write cannot corrupt existing cache
+	tempPath := cachePath + ".tmp"
+
+	if err := os.WriteFile(tempPath, metadata, 0o644); err != nil {
+		sb.ErrorMessage("Failed to write metadata cache: " + err.Error())
+		return
+	}
+
+	if err := os.Rename(tempPath, cachePath); err != nil {
+		sb.ErrorMessage("Failed to write metadata cache: " + err.Error())
+	}
+}
+
+// invalidateMetadataCache removes cached metadata along with the metadata model derived from it.
+// The measurement metadata registry remains in place, since it is still needed for any measurements
+// received before the next metadata refresh, which will update its records.
+func (sb *Subscriber) invalidateMetadataCache() {
+	sb.cachedMetadataLock.Lock()
+	sb.cachedMetadata = nil
+	sb.cachedMetadataLock.Unlock()
+
+	sb.metadataModel.Store(nil)
+
+	cachePath := sb.config.MetadataCachePath
+
+	if len(cachePath) == 0 {
+		return
+	}
+
+	if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+		sb.ErrorMessage("Failed to remove metadata cache: " + err.Error())
+	}
+}
+
 // Intermediate callback handlers:
 
 func (sb *Subscriber) handleConnect() {
+	// Measurement metadata registry is reset for each new connection,
+	// restore any cached metadata until a metadata refresh arrives
+	sb.cachedMetadataLock.Lock()
+	cachedMetadata := sb.cachedMetadata
+	sb.cachedMetadataLock.Unlock()
+
+	if cachedMetadata != nil {
+		sb.loadMeasurementMetadata(cachedMetadata)
+	}
+
 	sb.beginCallbackSync()
 
 	if sb.connectionEstablishedReceiver != nil {
@@ -521,6 +683,8 @@ func (sb *Subscriber) handleMetadataReceived(metadata []byte) {
 
 	if err == nil {
 		sb.loadMeasurementMetadata(dataSet)
+		sb.loadMetadataModel(dataSet)
+		sb.saveMetadataCache(metadata, dataSet)
 	} else {
 		sb.ErrorMessage("Failed to parse received XML metadata: " + err.Error())
 	}
@@ -541,6 +705,14 @@ func (sb *Subscriber) handleMetadataReceived(metadata []byte) {
 }
 
 func (sb *Subscriber) loadMeasurementMetadata(dataSet *data.DataSet) {
+	if err := loadMeasurementMetadata(sb.dataSubscriber(), dataSet); err != nil {
+		sb.ErrorMessage(err.Error())
+	}
+}
+
+// loadMeasurementMetadata registers the measurement metadata defined in the MeasurementDetail table
+// of the dataSet with the DataSubscriber measurement metadata registry.
+func loadMeasurementMetadata(ds *transport.DataSubscriber, dataSet *data.DataSet) error {
 	measurements := dataSet.Table("MeasurementDetail")
 
 	if measurements != nil {
@@ -553,7 +725,6 @@ func (sb *Subscriber) loadMeasurementMetadata(dataSet *data.DataSet) {
 			signalTypeIndex := measurements.ColumnIndex("SignalAcronym")
 			descriptionIndex := measurements.ColumnIndex("Description")
 			updatedOnIndex := measurements.ColumnIndex("UpdatedOn")
-			ds := sb.dataSubscriber()
 
 			for i := 0; i < measurements.RowCount(); i++ {
 				measurement := measurements.Row(i)
@@ -601,11 +772,24 @@ func (sb *Subscriber) loadMeasurementMetadata(dataSet *data.DataSet) {
 				}
 			}
 		} else {
-			sb.ErrorMessage("Received metadata does not contain the required MeasurementDetail.SignalID field")
+			return errors.New("received metadata does not contain the required MeasurementDetail.SignalID field")
 		}
 	} else {
-		sb.ErrorMessage("Received metadata does not contain the required MeasurementDetail table")
+		return errors.New("received metadata does not contain the required MeasurementDetail table")
 	}
+
+	return nil
+}
+
+func (sb *Subscriber) loadMetadataModel(dataSet *data.DataSet) {
+	model, err := metadata.NewModel(dataSet)
+
+	if err != nil {
+		sb.ErrorMessage("Failed to load metadata model: " + err.Error())
+		return
+	}
+
+	sb.metadataModel.Store(model)
 }
 
 func (sb *Subscriber) showMetadataSummary(dataSet *data.DataSet, parseStarted time.Time) {
@@ -662,6 +846,8 @@ func (sb *Subscriber) handleDataStartTime(startTime ticks.Ticks) {
 }
 
 func (sb *Subscriber) handleConfigurationChanged() {
+	sb.invalidateMetadataCache()
+
 	sb.beginCallbackSync()
 
 	if sb.configurationChangedReceiver != nil {
@@ -687,6 +873,50 @@ func (sb *Subscriber) handleProcessingComplete(message string) {
 	sb.endCallbackSync()
 }
 
+// handleNewMeasurements is called from the DataSubscriber callback synchronization context.
+func (sb *Subscriber) handleNewMeasurements(measurements *[]transport.Measurement) {
+	// Gather statistics and filter before calling new measurements receiver, which may return slice to the pool
+	if sb.signalStatistics != nil {
+		sb.signalStatistics.AddMeasurements(*measurements)
+	}
+
+	if sb.filteredMeasurementsReceiver != nil && sb.measurementFilter != nil {
+		sb.routeFilteredMeasurements(*measurements)
+	}
+
+	if sb.phasorsReceiver != nil && sb.phasorAligner != nil {
+		if phasors := sb.phasorAligner.align(sb, *measurements); len(phasors) > 0 {
+			sb.phasorsReceiver(phasors)
+		}
+	}
+
+	if sb.newMeasurementsReceiver != nil {
+		sb.newMeasurementsReceiver(measurements)
+	}
+}
+
+func (sb *Subscriber) routeFilteredMeasurements(measurements []transport.Measurement) {
+	var matched []transport.Measurement
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		result, err := sb.measurementFilter.Matches(measurement, sb.Metadata(measurement))
+
+		if err != nil {
+			sb.ErrorMessage("Failed to evaluate measurement filter expression \"" + sb.measurementFilter.FilterExpression() + "\": " + err.Error())
+			break
+		}
+
+		if result {
+			matched = append(matched, *measurement)
+		}
+	}
+
+	if len(matched) > 0 {
+		sb.filteredMeasurementsReceiver(matched)
+	}
+}
+
 // DefaultStatusMessageLogger implements the default handler for the statusMessage callback.
 // Default implementation synchronously writes output to stdio. Logging is recommended.
 func (sb *Subscriber) DefaultStatusMessageLogger(message string) {
@@ -786,7 +1016,83 @@ func (sb *Subscriber) SetNewMeasurementsReceiver(callback func(measurements *[]t
 	ds.BeginCallbackAssignment()
 	defer ds.EndCallbackAssignment()
 
-	ds.NewMeasurementsCallback = callback
+	sb.newMeasurementsReceiver = callback
+	sb.updateNewMeasurementsCallback(ds)
+}
+
+// SetFilteredMeasurementsReceiver defines the callback that handles reception of new measurements that
+// match the specified filterExpression. The expression is evaluated against each received measurement
+// joined with its metadata, see MeasurementFilter for available columns, e.g.:
+//
+//	FILTER ActiveMeasurements WHERE SignalType = 'FREQ' AND Value < 59.95
+//
+// Matching measurements are delivered in addition to any defined new measurements receiver. Set
+// callback to nil to remove the filtered receiver. An error will be returned if the filterExpression
+// fails to parse. Assignment will take effect immediately, even while subscription is active.
+func (sb *Subscriber) SetFilteredMeasurementsReceiver(filterExpression string, callback func(measurements []transport.Measurement)) error {
+	var measurementFilter *MeasurementFilter
+
+	if callback != nil {
+		var err error
+
+		if measurementFilter, err = NewMeasurementFilter(filterExpression); err != nil {
+			return err
+		}
+	}
+
+	ds := sb.dataSubscriber()
+	ds.BeginCallbackAssignment()
+	defer ds.EndCallbackAssignment()
+
+	sb.measurementFilter = measurementFilter
+	sb.filteredMeasurementsReceiver = callback
+	sb.updateNewMeasurementsCallback(ds)
+
+	return nil
+}
+
+// SetPhasorsReceiver defines the callback that handles reception of new phasors. Phasor magnitude and angle
+// measurements are paired using the PhasorDetail metadata table, when available; otherwise, using their parsed
+// signal references, e.g., SHELBY-PM1 and SHELBY-PA1. A phasor is delivered once both its magnitude and angle
+// have been received for the same timestamp. Delivered phasors are in addition to any defined new measurements
+// receiver. Set callback to nil to remove the phasors receiver. Assignment will take effect immediately, even
+// while subscription is active.
+func (sb *Subscriber) SetPhasorsReceiver(callback func(phasors []Phasor)) {
+	ds := sb.dataSubscriber()
+	ds.BeginCallbackAssignment()
+	defer ds.EndCallbackAssignment()
+
+	sb.phasorsReceiver = callback
+
+	if callback == nil {
+		sb.phasorAligner = nil
+	} else {
+		sb.phasorAligner = newPhasorAligner()
+	}
+
+	sb.updateNewMeasurementsCallback(ds)
+}
+
+// SetSignalStatistics attaches the SignalStatistics that will accumulate per-signal data quality and completeness
+// statistics for all received measurements. Set statistics to nil to detach. Assignment will take effect immediately,
+// even while subscription is active. Use SignalStatistics.Table with LookupMetadata to get statistics with metadata.
+func (sb *Subscriber) SetSignalStatistics(statistics *SignalStatistics) {
+	ds := sb.dataSubscriber()
+	ds.BeginCallbackAssignment()
+	defer ds.EndCallbackAssignment()
+
+	sb.signalStatistics = statistics
+	sb.updateNewMeasurementsCallback(ds)
+}
+
+// updateNewMeasurementsCallback only assigns the DataSubscriber callback when a receiver is defined,
+// callers are expected to hold the DataSubscriber callback assignment lock.
+func (sb *Subscriber) updateNewMeasurementsCallback(ds *transport.DataSubscriber) {
+	if sb.newMeasurementsReceiver == nil && sb.filteredMeasurementsReceiver == nil && sb.signalStatistics == nil && sb.phasorsReceiver == nil {
+		ds.NewMeasurementsCallback = nil
+	} else {
+		ds.NewMeasurementsCallback = sb.handleNewMeasurements
+	}
 }
 
 // SetNewBufferBlocksReceiver defines the callback that handles reception of new buffer blocks.
@@ -0,0 +1,164 @@
+//******************************************************************************************************
+//  Subscriber_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/metadata"
+)
+
+func newMetadataCacheTestSubscriber(cachePath string, errors *[]string) *Subscriber {
+	subscriber := NewSubscriber()
+	subscriber.SetStatusMessageLogger(nil)
+	subscriber.SetErrorMessageLogger(func(message string) { *errors = append(*errors, message) })
+	subscriber.config.MetadataCachePath = cachePath
+	return subscriber
+}
+
+func TestMetadataCacheRoundTrip(t *testing.T) {
+	buffer, err := os.ReadFile("../test/MetadataSample1.xml")
+
+	if err != nil {
+		t.Fatal("TestMetadataCacheRoundTrip: failed to read metadata sample: " + err.Error())
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err := dataSet.ParseXml(buffer); err != nil {
+		t.Fatal("TestMetadataCacheRoundTrip: failed to parse metadata sample: " + err.Error())
+	}
+
+	model, err := metadata.NewModel(dataSet)
+
+	if err != nil || len(model.Measurements) == 0 {
+		t.Fatal("TestMetadataCacheRoundTrip: expected metadata sample measurements")
+	}
+
+	measurement := model.Measurements[0]
+	cachePath := filepath.Join(t.TempDir(), "metadata.xml")
+	var errors []string
+
+	// Received metadata is saved to the cache
+	source := newMetadataCacheTestSubscriber(cachePath, &errors)
+	defer source.Close()
+
+	source.handleMetadataReceived(buffer)
+
+	if cached, err := os.ReadFile(cachePath); err != nil || string(cached) != string(buffer) {
+		t.Fatal("TestMetadataCacheRoundTrip: expected received metadata to be written to cache")
+	}
+
+	// Cached metadata is loaded into the measurement registry of a new subscriber
+	target := newMetadataCacheTestSubscriber(cachePath, &errors)
+	defer target.Close()
+
+	var received *data.DataSet
+	target.SetMetadataReceiver(func(dataSet *data.DataSet) { received = dataSet })
+	target.loadConfiguredMetadataCache()
+
+	if received == nil || received.Table("MeasurementDetail").RowCount() != dataSet.Table("MeasurementDetail").RowCount() {
+		t.Fatal("TestMetadataCacheRoundTrip: expected cached metadata to be delivered to metadata receiver")
+	}
+
+	if metadata := target.LookupMetadata(measurement.SignalID); metadata.Tag != measurement.PointTag {
+		t.Fatal("TestMetadataCacheRoundTrip: expected cached metadata for " + measurement.PointTag + ", received: " + metadata.Tag)
+	}
+
+	if target.MetadataModel() == nil || target.MetadataModel().Measurement(measurement.SignalID) == nil {
+		t.Fatal("TestMetadataCacheRoundTrip: expected metadata model to be loaded from cache")
+	}
+
+	// Configuration change removes the cache
+	target.handleConfigurationChanged()
+
+	if _, err := os.Stat(cachePath); !os.IsNotExist(err) {
+		t.Fatal("TestMetadataCacheRoundTrip: expected cache to be removed on configuration change")
+	}
+
+	target.cachedMetadataLock.Lock()
+	cachedMetadata := target.cachedMetadata
+	target.cachedMetadataLock.Unlock()
+
+	if cachedMetadata != nil {
+		t.Fatal("TestMetadataCacheRoundTrip: expected cached metadata to be cleared on configuration change")
+	}
+
+	if target.MetadataModel() != nil {
+		t.Fatal("TestMetadataCacheRoundTrip: expected metadata model to be cleared on configuration change")
+	}
+
+	// Measurement metadata registry is kept until next metadata refresh
+	if metadata := target.LookupMetadata(measurement.SignalID); metadata.Tag != measurement.PointTag {
+		t.Fatal("TestMetadataCacheRoundTrip: expected measurement metadata to remain after configuration change")
+	}
+
+	if len(errors) > 0 {
+		t.Fatal("TestMetadataCacheRoundTrip: unexpected errors: " + strings.Join(errors, "; "))
+	}
+}
+
+func TestMetadataCacheInvalidFile(t *testing.T) {
+	directory := t.TempDir()
+	var errors []string
+
+	// Missing cache file is not an error when loaded from configuration
+	missingPath := filepath.Join(directory, "missing.xml")
+	subscriber := newMetadataCacheTestSubscriber(missingPath, &errors)
+	defer subscriber.Close()
+
+	subscriber.loadConfiguredMetadataCache()
+
+	if len(errors) > 0 {
+		t.Fatal("TestMetadataCacheInvalidFile: unexpected error for missing cache: " + strings.Join(errors, "; "))
+	}
+
+	if _, err := subscriber.LoadMetadataCache(missingPath); !os.IsNotExist(err) {
+		t.Fatal("TestMetadataCacheInvalidFile: expected not exist error for missing cache")
+	}
+
+	// Corrupt cache file is reported and not applied
+	corruptPath := filepath.Join(directory, "corrupt.xml")
+
+	if err := os.WriteFile(corruptPath, []byte("<DataSet><xs:schema"), 0o644); err != nil {
+		t.Fatal("TestMetadataCacheInvalidFile: failed to write corrupt cache: " + err.Error())
+	}
+
+	subscriber = newMetadataCacheTestSubscriber(corruptPath, &errors)
+	defer subscriber.Close()
+
+	subscriber.loadConfiguredMetadataCache()
+
+	if len(errors) != 1 || !strings.Contains(errors[0], "Failed to load metadata cache") {
+		t.Fatal("TestMetadataCacheInvalidFile: expected corrupt cache error, received: " + strings.Join(errors, "; "))
+	}
+
+	if subscriber.MetadataModel() != nil {
+		t.Fatal("TestMetadataCacheInvalidFile: expected corrupt cache not to be applied")
+	}
+}
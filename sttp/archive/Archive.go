@@ -0,0 +1,321 @@
+//******************************************************************************************************
+//  Archive.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// Package archive defines a local, append-only file format for recording STTP measurements.
+//
+// An archive file starts with an 8-byte header, i.e., the "STTPARC" signature followed by a format
+// version byte, and then contains a sequence of records. Each record is a 1-byte record type and a
+// 4-byte big-endian payload length, followed by the payload and a 4-byte CRC-32C checksum of the
+// record type, length and payload. Signal records define the signal directory, i.e., the runtime ID
+// and MeasurementMetadata of each archived signal. Block records contain a time-ordered set of
+// measurements compressed with TSSC, preceded by the time range and runtime IDs of the signals in
+// the block which are used to build a block index when the archive is opened. Records are only
+// ever appended, a record that is truncated or fails its checksum, e.g., after a crash, marks the
+// end of the archive and is removed when the archive is next opened for writing.
+package archive
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+const (
+	archiveSignature  = "STTPARC"
+	archiveVersion    = 1
+	fileHeaderSize    = 8
+	recordHeaderSize  = 5
+	checksumSize      = 4
+	blockHeaderSize   = 24
+	signalRecordType  = 1
+	blockRecordType   = 2
+	defaultBufferSize = 65536
+)
+
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// BlockInfo defines the index information of an archived block of measurements.
+type BlockInfo struct {
+	// StartTime defines the timestamp of the earliest measurement in the block.
+	StartTime ticks.Ticks
+
+	// EndTime defines the timestamp of the latest measurement in the block.
+	EndTime ticks.Ticks
+
+	// MeasurementCount defines the number of measurements in the block.
+	MeasurementCount int
+
+	runtimeIDs []int32
+	offset     int64
+	size       int
+}
+
+// contains determines if the block contains measurements for any of the specified runtime IDs.
+func (bi *BlockInfo) contains(runtimeIDs map[int32]bool) bool {
+	if runtimeIDs == nil {
+		return true
+	}
+
+	for _, runtimeID := range bi.runtimeIDs {
+		if runtimeIDs[runtimeID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendRecord appends a record with the specified type and payload to the buffer.
+func appendRecord(buffer []byte, recordType byte, payload []byte) []byte {
+	start := len(buffer)
+	buffer = append(buffer, recordType)
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(payload)))
+	buffer = append(buffer, payload...)
+	return binary.BigEndian.AppendUint32(buffer, crc32.Checksum(buffer[start:], checksumTable))
+}
+
+func appendString(buffer []byte, value string) []byte {
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(value)))
+	return append(buffer, value...)
+}
+
+func encodeSignalRecord(runtimeID int32, metadata *transport.MeasurementMetadata) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, uint32(runtimeID))
+	payload = append(payload, metadata.SignalID[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, math.Float64bits(metadata.Adder))
+	payload = binary.BigEndian.AppendUint64(payload, math.Float64bits(metadata.Multiplier))
+	payload = binary.BigEndian.AppendUint64(payload, metadata.ID)
+	payload = appendString(payload, metadata.Source)
+	payload = appendString(payload, metadata.SignalType)
+	payload = appendString(payload, metadata.SignalReference)
+	payload = appendString(payload, metadata.Description)
+	payload = appendString(payload, metadata.Tag)
+
+	updatedOn, _ := metadata.UpdatedOn.MarshalBinary()
+
+	return appendString(payload, string(updatedOn))
+}
+
+// recordDecoder reads fields from a record payload, the first error encountered is retained.
+type recordDecoder struct {
+	payload []byte
+	err     error
+}
+
+func (rd *recordDecoder) next(size int) []byte {
+	if rd.err != nil {
+		return nil
+	}
+
+	if size < 0 || size > len(rd.payload) {
+		rd.err = errors.New("archive record is shorter than expected")
+		return nil
+	}
+
+	value := rd.payload[:size]
+	rd.payload = rd.payload[size:]
+
+	return value
+}
+
+func (rd *recordDecoder) uint32() uint32 {
+	if value := rd.next(4); value != nil {
+		return binary.BigEndian.Uint32(value)
+	}
+
+	return 0
+}
+
+func (rd *recordDecoder) uint64() uint64 {
+	if value := rd.next(8); value != nil {
+		return binary.BigEndian.Uint64(value)
+	}
+
+	return 0
+}
+
+func (rd *recordDecoder) string() string {
+	return string(rd.next(int(rd.uint32())))
+}
+
+func decodeSignalRecord(payload []byte) (int32, *transport.MeasurementMetadata, error) {
+	decoder := recordDecoder{payload: payload}
+	runtimeID := int32(decoder.uint32())
+	metadata := &transport.MeasurementMetadata{}
+	signalID := decoder.next(16)
+
+	if signalID != nil {
+		metadata.SignalID, _ = guid.FromBytes(signalID, false)
+	}
+
+	metadata.Adder = math.Float64frombits(decoder.uint64())
+	metadata.Multiplier = math.Float64frombits(decoder.uint64())
+	metadata.ID = decoder.uint64()
+	metadata.Source = decoder.string()
+	metadata.SignalType = decoder.string()
+	metadata.SignalReference = decoder.string()
+	metadata.Description = decoder.string()
+	metadata.Tag = decoder.string()
+	updatedOn := decoder.string()
+
+	if decoder.err != nil {
+		return 0, nil, decoder.err
+	}
+
+	if err := metadata.UpdatedOn.UnmarshalBinary([]byte(updatedOn)); err != nil {
+		return 0, nil, errors.New("failed to decode signal updated on time: " + err.Error())
+	}
+
+	return runtimeID, metadata, nil
+}
+
+// encodeBlockHeader encodes the block header which is followed by the runtime IDs and TSSC data.
+func encodeBlockHeader(startTime, endTime ticks.Ticks, count int, runtimeIDs []int32) []byte {
+	header := make([]byte, 0, blockHeaderSize+4*len(runtimeIDs))
+	header = binary.BigEndian.AppendUint64(header, uint64(startTime))
+	header = binary.BigEndian.AppendUint64(header, uint64(endTime))
+	header = binary.BigEndian.AppendUint32(header, uint32(count))
+	header = binary.BigEndian.AppendUint32(header, uint32(len(runtimeIDs)))
+
+	for _, runtimeID := range runtimeIDs {
+		header = binary.BigEndian.AppendUint32(header, uint32(runtimeID))
+	}
+
+	return header
+}
+
+// decodeBlockHeader decodes the block header and returns the remaining TSSC data.
+func decodeBlockHeader(payload []byte) (*BlockInfo, []byte, error) {
+	decoder := recordDecoder{payload: payload}
+
+	block := &BlockInfo{
+		StartTime:        ticks.Ticks(decoder.uint64()),
+		EndTime:          ticks.Ticks(decoder.uint64()),
+		MeasurementCount: int(decoder.uint32()),
+	}
+
+	runtimeIDs := decoder.next(4 * int(decoder.uint32()))
+
+	if decoder.err != nil {
+		return nil, nil, decoder.err
+	}
+
+	block.runtimeIDs = make([]int32, len(runtimeIDs)/4)
+
+	for i := range block.runtimeIDs {
+		block.runtimeIDs[i] = int32(binary.BigEndian.Uint32(runtimeIDs[i*4:]))
+	}
+
+	return block, decoder.payload, nil
+}
+
+// scanRecords reads the archive file records, calling handler with the payload of each valid record.
+// Returns the length of the valid portion of the file, i.e., up to the first truncated or corrupt record.
+func scanRecords(file *os.File, handler func(recordType byte, payload []byte, offset int64) error) (int64, error) {
+	fileInfo, err := file.Stat()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	reader := bufio.NewReaderSize(file, defaultBufferSize)
+	header := make([]byte, fileHeaderSize)
+
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return 0, errors.New("failed to read archive header: " + err.Error())
+	}
+
+	if string(header[:len(archiveSignature)]) != archiveSignature {
+		return 0, errors.New("file is not an STTP archive")
+	}
+
+	if header[len(archiveSignature)] != archiveVersion {
+		return 0, errors.New("unsupported STTP archive version " + strconv.Itoa(int(header[len(archiveSignature)])))
+	}
+
+	offset := int64(fileHeaderSize)
+	var record []byte
+
+	for {
+		if offset+recordHeaderSize+checksumSize > fileInfo.Size() {
+			return offset, nil
+		}
+
+		if cap(record) < recordHeaderSize {
+			record = make([]byte, recordHeaderSize, defaultBufferSize)
+		}
+
+		record = record[:recordHeaderSize]
+
+		if _, err := io.ReadFull(reader, record); err != nil {
+			return offset, err
+		}
+
+		recordSize := recordHeaderSize + int64(binary.BigEndian.Uint32(record[1:])) + checksumSize
+
+		// Record length beyond end of file indicates a truncated, or corrupt, record
+		if offset+recordSize > fileInfo.Size() {
+			return offset, nil
+		}
+
+		if int64(cap(record)) < recordSize {
+			record = append(make([]byte, 0, recordSize), record...)
+		}
+
+		record = record[:recordSize]
+
+		if _, err := io.ReadFull(reader, record[recordHeaderSize:]); err != nil {
+			return offset, err
+		}
+
+		checksumOffset := len(record) - checksumSize
+
+		if crc32.Checksum(record[:checksumOffset], checksumTable) != binary.BigEndian.Uint32(record[checksumOffset:]) {
+			return offset, nil
+		}
+
+		if err := handler(record[0], record[recordHeaderSize:checksumOffset], offset); err != nil {
+			return offset, err
+		}
+
+		offset += recordSize
+	}
+}
+
+// timestampValue gets the timestamp used to order and index measurements, i.e., excluding leap-second flags.
+func timestampValue(timestamp ticks.Ticks) ticks.Ticks {
+	return ticks.Ticks(timestamp.TimestampValue())
+}
@@ -0,0 +1,324 @@
+//******************************************************************************************************
+//  Archive_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func testMeasurements(signalIDs []guid.Guid, startTime ticks.Ticks, count int) []transport.Measurement {
+	measurements := make([]transport.Measurement, 0, count*len(signalIDs))
+
+	for i := 0; i < count; i++ {
+		for j, signalID := range signalIDs {
+			measurements = append(measurements, transport.Measurement{
+				SignalID:  signalID,
+				Value:     float64(j*1000 + i),
+				Timestamp: startTime + ticks.Ticks(i)*ticks.PerMillisecond*33,
+				Flags:     transport.StateFlagsEnum(i % 3),
+			})
+		}
+	}
+
+	return measurements
+}
+
+func TestArchiveWriteRead(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.sttparc")
+	signalIDs := []guid.Guid{guid.New(), guid.New(), guid.New()}
+	startTime := ticks.FromTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	writer, err := OpenWriter(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to open writer: " + err.Error())
+	}
+
+	writer.MaxBlockMeasurements = 300
+
+	if err := writer.DefineSignal(&transport.MeasurementMetadata{SignalID: signalIDs[0], Multiplier: 1.0, ID: 1, Source: "PPA", SignalType: "FREQ", Tag: "SHELBY:FREQ"}); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to define signal: " + err.Error())
+	}
+
+	writer.MetadataLookup = func(signalID guid.Guid) *transport.MeasurementMetadata {
+		if signalID == signalIDs[1] {
+			return &transport.MeasurementMetadata{SignalID: signalID, Multiplier: 1.0, ID: 2, Source: "PPA", SignalType: "VPHM"}
+		}
+
+		return nil
+	}
+
+	if err := writer.Write(testMeasurements(signalIDs, startTime, 500)); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to write measurements: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to close writer: " + err.Error())
+	}
+
+	// Reopen archive and append more measurements for a subset of signals
+	if writer, err = OpenWriter(fileName); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to reopen writer: " + err.Error())
+	}
+
+	if err := writer.Write(testMeasurements(signalIDs[1:], startTime+ticks.PerMinute, 100)); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to write measurements: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to close writer: " + err.Error())
+	}
+
+	reader, err := OpenReader(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to open reader: " + err.Error())
+	}
+
+	defer reader.Close()
+
+	signals := reader.Signals()
+
+	if len(signals) != 3 || signals[0].SignalID != signalIDs[0] || signals[1].SignalID != signalIDs[1] || signals[2].SignalID != signalIDs[2] {
+		t.Fatal("TestArchiveWriteRead: unexpected signal directory, received " + strconv.Itoa(len(signals)) + " signals")
+	}
+
+	if metadata := reader.Signal(signalIDs[0]); metadata.Tag != "SHELBY:FREQ" || metadata.ID != 1 || metadata.Source != "PPA" {
+		t.Fatal("TestArchiveWriteRead: unexpected defined signal metadata")
+	}
+
+	if metadata := reader.Signal(signalIDs[1]); metadata.SignalType != "VPHM" || metadata.ID != 2 {
+		t.Fatal("TestArchiveWriteRead: unexpected looked up signal metadata")
+	}
+
+	if metadata := reader.Signal(signalIDs[2]); metadata.Multiplier != 1.0 || metadata.Source != "" {
+		t.Fatal("TestArchiveWriteRead: unexpected default signal metadata")
+	}
+
+	blocks := reader.Blocks()
+
+	if len(blocks) < 6 {
+		t.Fatal("TestArchiveWriteRead: expected at least 6 blocks, received " + strconv.Itoa(len(blocks)))
+	}
+
+	total := 0
+
+	for i, block := range blocks {
+		if block.EndTime < block.StartTime || i > 0 && block.StartTime < blocks[i-1].EndTime {
+			t.Fatal("TestArchiveWriteRead: unexpected block time range for block " + strconv.Itoa(i))
+		}
+
+		total += block.MeasurementCount
+	}
+
+	if total != 1700 {
+		t.Fatal("TestArchiveWriteRead: expected 1700 archived measurements, received " + strconv.Itoa(total))
+	}
+
+	if firstTime, lastTime := reader.TimeRange(); firstTime != startTime || lastTime != startTime+ticks.PerMinute+99*33*ticks.PerMillisecond {
+		t.Fatal("TestArchiveWriteRead: unexpected archive time range")
+	}
+
+	measurements, err := reader.Read(nil, 0, ticks.Max)
+
+	if err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to read measurements: " + err.Error())
+	}
+
+	if len(measurements) != 1700 {
+		t.Fatal("TestArchiveWriteRead: expected 1700 measurements, received " + strconv.Itoa(len(measurements)))
+	}
+
+	expected := testMeasurements(signalIDs, startTime, 500)
+
+	for i := range expected {
+		if measurements[i] != expected[i] {
+			t.Fatal("TestArchiveWriteRead: unexpected measurement at index " + strconv.Itoa(i) + ": " + measurements[i].String())
+		}
+	}
+
+	// Query a signal subset in a time window, end time is exclusive
+	windowStart := startTime + 100*33*ticks.PerMillisecond
+	windowEnd := startTime + 200*33*ticks.PerMillisecond
+
+	if measurements, err = reader.Read([]guid.Guid{signalIDs[2], guid.New()}, windowStart, windowEnd); err != nil {
+		t.Fatal("TestArchiveWriteRead: failed to read measurements: " + err.Error())
+	}
+
+	if len(measurements) != 100 || measurements[0].Timestamp != windowStart || measurements[0].Value != 2100 {
+		t.Fatal("TestArchiveWriteRead: unexpected windowed measurements, received " + strconv.Itoa(len(measurements)))
+	}
+
+	for _, measurement := range measurements {
+		if measurement.SignalID != signalIDs[2] {
+			t.Fatal("TestArchiveWriteRead: unexpected signal in windowed measurements")
+		}
+	}
+
+	if measurements, err = reader.Read([]guid.Guid{signalIDs[0]}, startTime+ticks.PerMinute, ticks.Max); err != nil || len(measurements) != 0 {
+		t.Fatal("TestArchiveWriteRead: expected no measurements for signal outside of time window")
+	}
+}
+
+func TestArchiveRecovery(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.sttparc")
+	signalIDs := []guid.Guid{guid.New(), guid.New()}
+	startTime := ticks.FromTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	writer, err := OpenWriter(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveRecovery: failed to open writer: " + err.Error())
+	}
+
+	if err := writer.Write(testMeasurements(signalIDs, startTime, 100)); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to write measurements: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to close writer: " + err.Error())
+	}
+
+	fileInfo, err := os.Stat(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveRecovery: failed to stat archive: " + err.Error())
+	}
+
+	validSize := fileInfo.Size()
+
+	// Simulate a crash during a block write with a partial record
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_APPEND, 0)
+
+	if err != nil {
+		t.Fatal("TestArchiveRecovery: failed to open archive: " + err.Error())
+	}
+
+	if _, err := file.Write([]byte{blockRecordType, 0x00, 0x01, 0x00, 0x00, 0xDE, 0xAD, 0xBE, 0xEF, 0x00}); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to write partial record: " + err.Error())
+	}
+
+	file.Close()
+
+	reader, err := OpenReader(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveRecovery: failed to open reader: " + err.Error())
+	}
+
+	measurements, err := reader.Read(nil, 0, ticks.Max)
+	reader.Close()
+
+	if err != nil || len(measurements) != 200 {
+		t.Fatal("TestArchiveRecovery: expected 200 measurements before partial record, received " + strconv.Itoa(len(measurements)))
+	}
+
+	if writer, err = OpenWriter(fileName); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to reopen writer: " + err.Error())
+	}
+
+	if fileInfo, err = os.Stat(fileName); err != nil || fileInfo.Size() != validSize {
+		t.Fatal("TestArchiveRecovery: expected partial record to be removed")
+	}
+
+	if err := writer.Write(testMeasurements(signalIDs, startTime+ticks.PerMinute, 100)); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to write measurements: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to close writer: " + err.Error())
+	}
+
+	// Corrupt a byte of the last block, its checksum no longer matches
+	data, err := os.ReadFile(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveRecovery: failed to read archive: " + err.Error())
+	}
+
+	data[len(data)-checksumSize-1] ^= 0xFF
+
+	if err := os.WriteFile(fileName, data, 0o644); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to write archive: " + err.Error())
+	}
+
+	if reader, err = OpenReader(fileName); err != nil {
+		t.Fatal("TestArchiveRecovery: failed to open reader: " + err.Error())
+	}
+
+	defer reader.Close()
+
+	if len(reader.Signals()) != 2 || len(reader.Blocks()) != 1 {
+		t.Fatal("TestArchiveRecovery: expected corrupt block to be ignored, received " + strconv.Itoa(len(reader.Blocks())) + " blocks")
+	}
+
+	if _, err := OpenReader(filepath.Join(t.TempDir(), "missing.sttparc")); err == nil {
+		t.Fatal("TestArchiveRecovery: expected error opening missing archive")
+	}
+}
+
+func TestArchiveUnlimitedBlockMeasurements(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.sttparc")
+	signalIDs := []guid.Guid{guid.New(), guid.New()}
+	startTime := ticks.FromTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	writer, err := OpenWriter(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveUnlimitedBlockMeasurements: failed to open writer: " + err.Error())
+	}
+
+	writer.MaxBlockMeasurements = 0
+
+	// Writes within the block span should be buffered into a single block
+	for i := 0; i < 10; i++ {
+		if err := writer.Write(testMeasurements(signalIDs, startTime+ticks.Ticks(i)*10*33*ticks.PerMillisecond, 10)); err != nil {
+			t.Fatal("TestArchiveUnlimitedBlockMeasurements: failed to write measurements: " + err.Error())
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal("TestArchiveUnlimitedBlockMeasurements: failed to close writer: " + err.Error())
+	}
+
+	reader, err := OpenReader(fileName)
+
+	if err != nil {
+		t.Fatal("TestArchiveUnlimitedBlockMeasurements: failed to open reader: " + err.Error())
+	}
+
+	defer reader.Close()
+
+	if blocks := reader.Blocks(); len(blocks) != 1 || blocks[0].MeasurementCount != 200 {
+		t.Fatal("TestArchiveUnlimitedBlockMeasurements: expected 1 block of 200 measurements, received " + strconv.Itoa(len(blocks)) + " blocks")
+	}
+}
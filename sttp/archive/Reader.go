@@ -0,0 +1,230 @@
+//******************************************************************************************************
+//  Reader.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package archive
+
+import (
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+// Reader queries the measurements in an STTP archive file. The signal directory and block index are
+// loaded when the archive is opened; records appended after the archive is opened are not visible.
+// Reader functions are safe for concurrent use.
+type Reader struct {
+	file       *os.File
+	signals    map[int32]*transport.MeasurementMetadata
+	runtimeIDs map[guid.Guid]int32
+	blocks     []BlockInfo
+}
+
+// OpenReader opens the specified archive file for reading. Any incomplete or corrupt record at the end
+// of the archive, e.g., after a crash, and any records following it are ignored.
+func OpenReader(fileName string) (*Reader, error) {
+	file, err := os.Open(fileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ar := &Reader{
+		file:       file,
+		signals:    make(map[int32]*transport.MeasurementMetadata),
+		runtimeIDs: make(map[guid.Guid]int32),
+	}
+
+	_, err = scanRecords(file, func(recordType byte, payload []byte, offset int64) error {
+		switch recordType {
+		case signalRecordType:
+			runtimeID, metadata, err := decodeSignalRecord(payload)
+
+			if err != nil {
+				return err
+			}
+
+			ar.signals[runtimeID] = metadata
+			ar.runtimeIDs[metadata.SignalID] = runtimeID
+		case blockRecordType:
+			block, _, err := decodeBlockHeader(payload)
+
+			if err != nil {
+				return err
+			}
+
+			block.offset = offset + recordHeaderSize
+			block.size = len(payload)
+			ar.blocks = append(ar.blocks, *block)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return ar, nil
+}
+
+// Close closes the archive file.
+func (ar *Reader) Close() error {
+	return ar.file.Close()
+}
+
+// Signals gets the metadata of the signals in the archive signal directory.
+func (ar *Reader) Signals() []*transport.MeasurementMetadata {
+	signals := make([]*transport.MeasurementMetadata, 0, len(ar.signals))
+
+	for runtimeID := int32(0); len(signals) < len(ar.signals); runtimeID++ {
+		if metadata, ok := ar.signals[runtimeID]; ok {
+			signals = append(signals, metadata)
+		}
+	}
+
+	return signals
+}
+
+// Signal gets the archived metadata for the specified signal ID, or nil if the signal is not archived.
+func (ar *Reader) Signal(signalID guid.Guid) *transport.MeasurementMetadata {
+	if runtimeID, ok := ar.runtimeIDs[signalID]; ok {
+		return ar.signals[runtimeID]
+	}
+
+	return nil
+}
+
+// Blocks gets the block index of the archive, in the order blocks were written.
+func (ar *Reader) Blocks() []BlockInfo {
+	return ar.blocks
+}
+
+// TimeRange gets the timestamps of the earliest and latest archived measurements.
+func (ar *Reader) TimeRange() (startTime ticks.Ticks, endTime ticks.Ticks) {
+	for i := range ar.blocks {
+		if i == 0 || ar.blocks[i].StartTime < startTime {
+			startTime = ar.blocks[i].StartTime
+		}
+
+		if i == 0 || ar.blocks[i].EndTime > endTime {
+			endTime = ar.blocks[i].EndTime
+		}
+	}
+
+	return
+}
+
+// Read gets the archived measurements for the specified signals with a timestamp from startTime, inclusive,
+// to endTime, exclusive, ordered by timestamp. Set signalIDs to nil to read measurements for all signals.
+// Only blocks with a time range that overlaps the time window and that contain a requested signal are read.
+func (ar *Reader) Read(signalIDs []guid.Guid, startTime, endTime ticks.Ticks) ([]transport.Measurement, error) {
+	var runtimeIDs map[int32]bool
+
+	if signalIDs != nil {
+		runtimeIDs = make(map[int32]bool)
+
+		for _, signalID := range signalIDs {
+			if runtimeID, ok := ar.runtimeIDs[signalID]; ok {
+				runtimeIDs[runtimeID] = true
+			}
+		}
+
+		if len(runtimeIDs) == 0 {
+			return nil, nil
+		}
+	}
+
+	var measurements []transport.Measurement
+	var id int32
+	var timestamp int64
+	var stateFlags uint32
+	var value float32
+
+	for i := range ar.blocks {
+		block := &ar.blocks[i]
+
+		if block.EndTime < startTime || block.StartTime >= endTime || !block.contains(runtimeIDs) {
+			continue
+		}
+
+		payload := make([]byte, block.size)
+
+		if _, err := ar.file.ReadAt(payload, block.offset); err != nil {
+			return nil, errors.New("failed to read archive block: " + err.Error())
+		}
+
+		_, data, err := decodeBlockHeader(payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Each block is encoded independently
+		decoder := tssc.NewDecoder()
+		decoder.SetBuffer(data)
+
+		for {
+			ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value)
+
+			if err != nil {
+				return nil, errors.New("failed to decode archive block: " + err.Error())
+			}
+
+			if !ok {
+				break
+			}
+
+			measurementTime := timestampValue(ticks.Ticks(timestamp))
+
+			if measurementTime < startTime || measurementTime >= endTime || runtimeIDs != nil && !runtimeIDs[id] {
+				continue
+			}
+
+			metadata, ok := ar.signals[id]
+
+			if !ok {
+				return nil, errors.New("archive block references undefined signal")
+			}
+
+			measurements = append(measurements, transport.Measurement{
+				SignalID:  metadata.SignalID,
+				Value:     float64(value),
+				Timestamp: ticks.Ticks(timestamp),
+				Flags:     transport.StateFlagsEnum(stateFlags),
+			})
+		}
+	}
+
+	// Blocks written at different times can overlap
+	sort.SliceStable(measurements, func(i, j int) bool {
+		return timestampValue(measurements[i].Timestamp) < timestampValue(measurements[j].Timestamp)
+	})
+
+	return measurements, nil
+}
@@ -0,0 +1,309 @@
+//******************************************************************************************************
+//  Writer.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package archive
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+// Writer appends measurements to an STTP archive file. Written measurements are buffered and
+// written as a TSSC compressed block, ordered by timestamp, when the buffer reaches the configured
+// maximum count or time span, or when Flush is called. Writer functions are safe for concurrent use.
+// Measurement values are archived with single precision, as with TSSC compressed STTP streams.
+type Writer struct {
+	file       *os.File
+	runtimeIDs map[guid.Guid]int32
+	pending    []transport.Measurement
+	minTime    ticks.Ticks
+	maxTime    ticks.Ticks
+	encoder    *tssc.Encoder
+	buffer     []byte
+	mutex      sync.Mutex
+
+	// MetadataLookup is called to get the metadata of a signal that has not been defined with DefineSignal
+	// when its first measurement is written, e.g., the Subscriber LookupMetadata function. When nil, or
+	// when nil is returned, the signal is defined with only its signal ID.
+	MetadataLookup func(signalID guid.Guid) *transport.MeasurementMetadata
+
+	// MaxBlockMeasurements defines the maximum number of measurements in a block, buffering this number
+	// of measurements triggers writing a block. Defaults to 16384, zero or less for no limit.
+	MaxBlockMeasurements int
+
+	// MaxBlockSpan defines the time span of buffered measurement timestamps that triggers writing a block.
+	// Defaults to 10 seconds.
+	MaxBlockSpan time.Duration
+}
+
+// OpenWriter opens the specified archive file for appending measurements, the file is created when it
+// does not exist. When an existing archive ends with an incomplete or corrupt record, e.g., after a
+// crash, the invalid record is removed before new records are appended.
+func OpenWriter(fileName string) (*Writer, error) {
+	file, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0o644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	aw := &Writer{
+		file:                 file,
+		runtimeIDs:           make(map[guid.Guid]int32),
+		encoder:              tssc.NewEncoder(),
+		buffer:               make([]byte, defaultBufferSize),
+		MaxBlockMeasurements: 16384,
+		MaxBlockSpan:         10 * time.Second,
+	}
+
+	if err := aw.open(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return aw, nil
+}
+
+func (aw *Writer) open() error {
+	fileInfo, err := aw.file.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	if fileInfo.Size() == 0 {
+		if _, err := aw.file.Write(append([]byte(archiveSignature), archiveVersion)); err != nil {
+			return err
+		}
+
+		return aw.file.Sync()
+	}
+
+	// Load signal directory and find end of valid records
+	validLength, err := scanRecords(aw.file, func(recordType byte, payload []byte, offset int64) error {
+		if recordType != signalRecordType {
+			return nil
+		}
+
+		runtimeID, metadata, err := decodeSignalRecord(payload)
+
+		if err != nil {
+			return err
+		}
+
+		aw.runtimeIDs[metadata.SignalID] = runtimeID
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if validLength < fileInfo.Size() {
+		if err := aw.file.Truncate(validLength); err != nil {
+			return errors.New("failed to remove incomplete archive record: " + err.Error())
+		}
+	}
+
+	_, err = aw.file.Seek(validLength, io.SeekStart)
+	return err
+}
+
+// DefineSignal adds, or updates, the metadata for a signal in the archive signal directory.
+func (aw *Writer) DefineSignal(metadata *transport.MeasurementMetadata) error {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	if aw.file == nil {
+		return errors.New("archive writer is closed")
+	}
+
+	_, err := aw.defineSignal(metadata)
+	return err
+}
+
+func (aw *Writer) defineSignal(metadata *transport.MeasurementMetadata) (int32, error) {
+	runtimeID, ok := aw.runtimeIDs[metadata.SignalID]
+
+	if !ok {
+		runtimeID = int32(len(aw.runtimeIDs))
+	}
+
+	if _, err := aw.file.Write(appendRecord(nil, signalRecordType, encodeSignalRecord(runtimeID, metadata))); err != nil {
+		return 0, err
+	}
+
+	aw.runtimeIDs[metadata.SignalID] = runtimeID
+
+	return runtimeID, nil
+}
+
+// Write buffers the specified measurements for archiving.
+func (aw *Writer) Write(measurements []transport.Measurement) error {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	if aw.file == nil {
+		return errors.New("archive writer is closed")
+	}
+
+	for i := range measurements {
+		timestamp := timestampValue(measurements[i].Timestamp)
+
+		if len(aw.pending) == 0 {
+			aw.minTime = timestamp
+			aw.maxTime = timestamp
+		} else if timestamp < aw.minTime {
+			aw.minTime = timestamp
+		} else if timestamp > aw.maxTime {
+			aw.maxTime = timestamp
+		}
+
+		aw.pending = append(aw.pending, measurements[i])
+	}
+
+	if aw.MaxBlockMeasurements > 0 && len(aw.pending) >= aw.MaxBlockMeasurements || aw.maxTime-aw.minTime >= ticks.Ticks(aw.MaxBlockSpan/100) {
+		return aw.writeBlocks()
+	}
+
+	return nil
+}
+
+// writeBlocks writes pending measurements as one or more blocks, each limited by MaxBlockMeasurements
+// and by the size of the TSSC buffer.
+func (aw *Writer) writeBlocks() error {
+	sort.SliceStable(aw.pending, func(i, j int) bool {
+		return timestampValue(aw.pending[i].Timestamp) < timestampValue(aw.pending[j].Timestamp)
+	})
+
+	maxCount := aw.MaxBlockMeasurements
+
+	if maxCount <= 0 {
+		maxCount = len(aw.pending)
+	}
+
+	for len(aw.pending) > 0 {
+		aw.encoder.Reset()
+		aw.encoder.SetBuffer(aw.buffer)
+
+		var count int
+		var runtimeIDs []int32
+		blockSignals := make(map[int32]bool)
+
+		for count < len(aw.pending) && count < maxCount {
+			measurement := &aw.pending[count]
+			runtimeID, ok := aw.runtimeIDs[measurement.SignalID]
+
+			if !ok {
+				var metadata *transport.MeasurementMetadata
+
+				if aw.MetadataLookup != nil {
+					metadata = aw.MetadataLookup(measurement.SignalID)
+				}
+
+				if metadata == nil {
+					metadata = &transport.MeasurementMetadata{SignalID: measurement.SignalID, Multiplier: 1.0}
+				}
+
+				var err error
+
+				if runtimeID, err = aw.defineSignal(metadata); err != nil {
+					return err
+				}
+			}
+
+			if !aw.encoder.TryAddMeasurement(runtimeID, int64(measurement.Timestamp), uint32(measurement.Flags), float32(measurement.Value)) {
+				break
+			}
+
+			if !blockSignals[runtimeID] {
+				blockSignals[runtimeID] = true
+				runtimeIDs = append(runtimeIDs, runtimeID)
+			}
+
+			count++
+		}
+
+		// Measurements are sorted, so block time range is defined by first and last measurements
+		record := encodeBlockHeader(timestampValue(aw.pending[0].Timestamp), timestampValue(aw.pending[count-1].Timestamp), count, runtimeIDs)
+		record = append(record, aw.buffer[:aw.encoder.FinishBlock()]...)
+
+		if _, err := aw.file.Write(appendRecord(nil, blockRecordType, record)); err != nil {
+			return err
+		}
+
+		aw.pending = aw.pending[count:]
+	}
+
+	aw.pending = nil
+
+	return nil
+}
+
+// Flush writes any buffered measurements to the archive and commits the archive file to stable storage.
+func (aw *Writer) Flush() error {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	if aw.file == nil {
+		return errors.New("archive writer is closed")
+	}
+
+	if err := aw.writeBlocks(); err != nil {
+		return err
+	}
+
+	return aw.file.Sync()
+}
+
+// Close flushes any buffered measurements and closes the archive file.
+func (aw *Writer) Close() error {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	if aw.file == nil {
+		return nil
+	}
+
+	err := aw.writeBlocks()
+
+	if syncErr := aw.file.Sync(); err == nil {
+		err = syncErr
+	}
+
+	if closeErr := aw.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	aw.file = nil
+
+	return err
+}
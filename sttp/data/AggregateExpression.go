@@ -0,0 +1,338 @@
+//******************************************************************************************************
+//  AggregateExpression.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// AggregateExpression represents an aggregate function expression, e.g., COUNT, SUM or AVG, that is
+// evaluated over a group of rows in a "SELECT" statement.
+type AggregateExpression struct {
+	aggregateType ExpressionAggregateTypeEnum
+	value         Expression
+}
+
+// NewAggregateExpression creates a new aggregate expression. The value parameter can be nil
+// for an aggregate that operates on rows instead of values, i.e., COUNT(*).
+func NewAggregateExpression(aggregateType ExpressionAggregateTypeEnum, value Expression) *AggregateExpression {
+	return &AggregateExpression{
+		aggregateType: aggregateType,
+		value:         value,
+	}
+}
+
+// Type gets expression type of the AggregateExpression.
+func (*AggregateExpression) Type() ExpressionTypeEnum {
+	return ExpressionType.Aggregate
+}
+
+// AggregateType gets aggregate type of the AggregateExpression.
+func (ae *AggregateExpression) AggregateType() ExpressionAggregateTypeEnum {
+	return ae.aggregateType
+}
+
+// Value gets the expression value of the AggregateExpression, evaluated for each row of a group.
+// Value will be nil for an aggregate that operates on rows, i.e., COUNT(*).
+func (ae *AggregateExpression) Value() Expression {
+	return ae.value
+}
+
+// String gets the AggregateExpression formatted as canonical filter expression syntax.
+func (ae *AggregateExpression) String() string {
+	name := strings.ToUpper(ae.aggregateType.String())
+
+	if ae.value == nil {
+		return name + "(*)"
+	}
+
+	return name + "(" + ExpressionString(ae.value) + ")"
+}
+
+// hasAggregate determines if the specified expression, or any of its child expressions,
+// is an AggregateExpression.
+func hasAggregate(expression Expression) bool {
+	if expression == nil {
+		return false
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Aggregate:
+		return true
+	case ExpressionType.Unary:
+		return hasAggregate(expression.(*UnaryExpression).Value())
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+
+		if hasAggregate(inListExpression.Value()) {
+			return true
+		}
+
+		for _, argument := range inListExpression.Arguments() {
+			if hasAggregate(argument) {
+				return true
+			}
+		}
+	case ExpressionType.Function:
+		for _, argument := range expression.(*FunctionExpression).Arguments() {
+			if hasAggregate(argument) {
+				return true
+			}
+		}
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		return hasAggregate(operatorExpression.LeftValue()) || hasAggregate(operatorExpression.RightValue())
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+		return hasAggregate(betweenExpression.Value()) || hasAggregate(betweenExpression.LowerBound()) || hasAggregate(betweenExpression.UpperBound())
+	case ExpressionType.Case:
+		caseExpression := expression.(*CaseExpression)
+
+		if hasAggregate(caseExpression.Value()) || hasAggregate(caseExpression.ElseResult()) {
+			return true
+		}
+
+		for i, condition := range caseExpression.Conditions() {
+			if hasAggregate(condition) || hasAggregate(caseExpression.Results()[i]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (et *ExpressionTree) evaluateAggregate(expression Expression) (*ValueExpression, error) {
+	aggregateExpression := expression.(*AggregateExpression)
+	aggregateType := aggregateExpression.AggregateType()
+	group := et.currentGroup
+
+	if group == nil {
+		return nil, errors.New("\"" + aggregateType.String() + "\" aggregate function is only valid in a \"SELECT\" statement result column")
+	}
+
+	// COUNT(*) operates on rows, no value evaluation needed
+	if aggregateExpression.Value() == nil {
+		if aggregateType != ExpressionAggregateType.Count {
+			return nil, errors.New("\"" + aggregateType.String() + "\" aggregate function expects 1 argument, received 0")
+		}
+
+		return newValueExpression(ExpressionValueType.Int32, int32(len(group))), nil
+	}
+
+	currentRow := et.currentRow
+
+	// Nested aggregate functions are not supported, so group is cleared during value evaluation
+	et.currentGroup = nil
+
+	defer func() {
+		et.currentRow = currentRow
+		et.currentGroup = group
+	}()
+
+	values := make([]*ValueExpression, 0, len(group))
+	valueType := ExpressionValueType.Undefined
+
+	// For an empty group, derive value type from current row which will only have Null values
+	if len(group) == 0 && currentRow != nil {
+		if value, err := et.evaluate(aggregateExpression.Value()); err == nil {
+			valueType = value.ValueType()
+		}
+	}
+
+	for _, row := range group {
+		et.currentRow = row
+		value, err := et.evaluate(aggregateExpression.Value())
+
+		if err != nil {
+			return nil, errors.New("failed while evaluating \"" + aggregateType.String() + "\" aggregate function value: " + err.Error())
+		}
+
+		valueType = value.ValueType()
+
+		// Null values are ignored by aggregate functions
+		if !value.IsNull() {
+			values = append(values, value)
+		}
+	}
+
+	switch aggregateType {
+	case ExpressionAggregateType.Count:
+		return newValueExpression(ExpressionValueType.Int32, int32(len(values))), nil
+	case ExpressionAggregateType.Sum:
+		return et.sum(values, valueType)
+	case ExpressionAggregateType.Avg:
+		return et.avg(values, valueType)
+	case ExpressionAggregateType.Min:
+		return et.min(values, valueType)
+	case ExpressionAggregateType.Max:
+		return et.max(values, valueType)
+	default:
+		return nil, errors.New("unexpected aggregate type encountered")
+	}
+}
+
+func validateNumericAggregate(aggregateType ExpressionAggregateTypeEnum, valueType ExpressionValueTypeEnum) error {
+	switch valueType {
+	case ExpressionValueType.Int32:
+		fallthrough
+	case ExpressionValueType.Int64:
+		fallthrough
+	case ExpressionValueType.Decimal:
+		fallthrough
+	case ExpressionValueType.Double:
+		return nil
+	case ExpressionValueType.Boolean:
+		fallthrough
+	case ExpressionValueType.String:
+		fallthrough
+	case ExpressionValueType.Guid:
+		fallthrough
+	case ExpressionValueType.DateTime:
+		fallthrough
+	case ExpressionValueType.Undefined:
+		return errors.New("cannot apply \"" + aggregateType.String() + "\" aggregate function to \"" + valueType.String() + "\"")
+	default:
+		return errors.New("unexpected expression value type encountered")
+	}
+}
+
+func (et *ExpressionTree) sum(values []*ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	if len(values) == 0 {
+		return NullValue(valueType), nil
+	}
+
+	result := values[0]
+
+	if err := validateNumericAggregate(ExpressionAggregateType.Sum, result.ValueType()); err != nil {
+		return nil, err
+	}
+
+	for i := 1; i < len(values); i++ {
+		nextValue := values[i]
+
+		if err := validateNumericAggregate(ExpressionAggregateType.Sum, nextValue.ValueType()); err != nil {
+			return nil, err
+		}
+
+		valueType, err := ExpressionOperatorType.Add.deriveOperationValueType(result.ValueType(), nextValue.ValueType())
+
+		if err != nil {
+			return nil, errors.New("failed while deriving \"Sum\" aggregate function addition operation value type: " + err.Error())
+		}
+
+		if result, err = et.addOp(result, nextValue, valueType); err != nil {
+			return nil, errors.New("failed while executing \"+\" operation in \"Sum\" aggregate function: " + err.Error())
+		}
+	}
+
+	return result, nil
+}
+
+func (et *ExpressionTree) avg(values []*ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	if len(values) == 0 {
+		if valueType == ExpressionValueType.Decimal {
+			return NullValue(ExpressionValueType.Decimal), nil
+		}
+
+		return NullValue(ExpressionValueType.Double), nil
+	}
+
+	result, err := et.sum(values, valueType)
+
+	if err != nil {
+		return nil, errors.New("\"Avg\" aggregate function " + err.Error())
+	}
+
+	if result.ValueType() == ExpressionValueType.Decimal {
+		return newValueExpression(ExpressionValueType.Decimal, result.decimalValue().Div(decimal.NewFromInt(int64(len(values))))), nil
+	}
+
+	if result, err = result.Convert(ExpressionValueType.Double); err != nil {
+		return nil, errors.New("failed while converting \"Avg\" aggregate function sum to \"Double\": " + err.Error())
+	}
+
+	return newValueExpression(ExpressionValueType.Double, result.doubleValue()/float64(len(values))), nil
+}
+
+func (et *ExpressionTree) min(values []*ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	if len(values) == 0 {
+		return NullValue(valueType), nil
+	}
+
+	testValue := values[0]
+
+	for i := 1; i < len(values); i++ {
+		nextValue := values[i]
+		valueType, err := ExpressionOperatorType.LessThan.deriveComparisonOperationValueType(testValue.ValueType(), nextValue.ValueType())
+
+		if err != nil {
+			return nil, errors.New("failed while deriving \"Min\" aggregate function less than comparison operation value type: " + err.Error())
+		}
+
+		result, err := et.lessThanOp(nextValue, testValue, valueType)
+
+		if err != nil {
+			return nil, errors.New("failed while executing \"<\" comparison operation in \"Min\" aggregate function: " + err.Error())
+		}
+
+		if result.booleanValue() {
+			testValue = nextValue
+		}
+	}
+
+	return testValue, nil
+}
+
+func (et *ExpressionTree) max(values []*ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	if len(values) == 0 {
+		return NullValue(valueType), nil
+	}
+
+	testValue := values[0]
+
+	for i := 1; i < len(values); i++ {
+		nextValue := values[i]
+		valueType, err := ExpressionOperatorType.GreaterThan.deriveComparisonOperationValueType(testValue.ValueType(), nextValue.ValueType())
+
+		if err != nil {
+			return nil, errors.New("failed while deriving \"Max\" aggregate function greater than comparison operation value type: " + err.Error())
+		}
+
+		result, err := et.greaterThanOp(nextValue, testValue, valueType)
+
+		if err != nil {
+			return nil, errors.New("failed while executing \">\" comparison operation in \"Max\" aggregate function: " + err.Error())
+		}
+
+		if result.booleanValue() {
+			testValue = nextValue
+		}
+	}
+
+	return testValue, nil
+}
@@ -0,0 +1,201 @@
+//******************************************************************************************************
+//  AggregateExpression_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func createAggregateDataSet() *DataSet {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Measurements")
+
+	deviceField := createDataColumn(dataTable, "Device", DataType.String)
+	signalTypeField := createDataColumn(dataTable, "SignalType", DataType.String)
+	valueField := createDataColumn(dataTable, "Value", DataType.Double)
+	updatedOnField := createDataColumn(dataTable, "UpdatedOn", DataType.DateTime)
+
+	baseTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []struct {
+		device     string
+		signalType string
+		value      interface{}
+		hours      int
+	}{
+		{"SHELBY", "FREQ", 60.0, 1},
+		{"SHELBY", "VPHM", 10.0, 3},
+		{"SHELBY", "VPHA", nil, 2},
+		{"ROCKWOOD", "FREQ", 59.0, 5},
+		{"ROCKWOOD", "VPHM", 20.0, 4},
+	}
+
+	for _, record := range records {
+		dataRow := dataTable.CreateRow()
+		dataRow.SetValue(deviceField, record.device)
+		dataRow.SetValue(signalTypeField, record.signalType)
+		dataRow.SetValue(valueField, record.value)
+		dataRow.SetValue(updatedOnField, baseTime.Add(time.Duration(record.hours)*time.Hour))
+		dataTable.AddRow(dataRow)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	return dataSet
+}
+
+func TestSelectGroupByCount(t *testing.T) {
+	dataSet := createAggregateDataSet()
+
+	result, err := SelectDataTable(dataSet, "SELECT SignalType, COUNT(*) AS Total FROM Measurements GROUP BY SignalType ORDER BY Total DESC, SignalType", true)
+
+	if err != nil {
+		t.Fatal("TestSelectGroupByCount: error executing SelectDataTable: " + err.Error())
+	}
+
+	if result.ColumnCount() != 2 || result.Column(1).Name() != "Total" || result.Column(1).Type() != DataType.Int32 {
+		t.Fatal("TestSelectGroupByCount: unexpected result columns: " + result.String())
+	}
+
+	expected := []string{"FREQ:2", "VPHM:2", "VPHA:1"}
+
+	if result.RowCount() != len(expected) {
+		t.Fatal("TestSelectGroupByCount: expected row count of " + strconv.Itoa(len(expected)) + ", received: " + strconv.Itoa(result.RowCount()))
+	}
+
+	for i, row := range result.Rows() {
+		if value := row.ValueAsString(0) + ":" + row.ValueAsString(1); value != expected[i] {
+			t.Fatal("TestSelectGroupByCount: expected \"" + expected[i] + "\" for row " + strconv.Itoa(i) + ", received: \"" + value + "\"")
+		}
+	}
+
+	// Count of values ignores nulls
+	if result, err = SelectDataTable(dataSet, "SELECT TOP 1 Device, COUNT(Value) AS Total, MAX(UpdatedOn) AS LastUpdate FROM Measurements GROUP BY Device ORDER BY Device DESC", true); err != nil {
+		t.Fatal("TestSelectGroupByCount: error executing SelectDataTable: " + err.Error())
+	}
+
+	if result.RowCount() != 1 {
+		t.Fatal("TestSelectGroupByCount: expected row count of 1, received: " + strconv.Itoa(result.RowCount()))
+	}
+
+	row := result.Row(0)
+
+	if total, _, _ := row.Int32ValueByName("Total"); row.ValueAsString(0) != "SHELBY" || total != 2 {
+		t.Fatal("TestSelectGroupByCount: unexpected SHELBY result: " + result.String())
+	}
+
+	if lastUpdate, _, _ := row.DateTimeValueByName("LastUpdate"); !lastUpdate.Equal(time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)) {
+		t.Fatal("TestSelectGroupByCount: expected last update of 03:00, received: " + lastUpdate.String())
+	}
+}
+
+func TestSelectAggregateFunctions(t *testing.T) {
+	dataSet := createAggregateDataSet()
+
+	result, err := SelectDataTable(dataSet, "SELECT COUNT(*), SUM(Value), AVG(Value), MIN(Value) AS Minimum, MAX(Value) * 2 AS Maximum FROM Measurements WHERE SignalType <> 'VPHA'", true)
+
+	if err != nil {
+		t.Fatal("TestSelectAggregateFunctions: error executing SelectDataTable: " + err.Error())
+	}
+
+	if result.RowCount() != 1 {
+		t.Fatal("TestSelectAggregateFunctions: expected row count of 1, received: " + strconv.Itoa(result.RowCount()))
+	}
+
+	row := result.Row(0)
+	count, _, _ := row.Int32ValueByName("Column1")
+	sum, _, _ := row.DoubleValueByName("Column2")
+	avg, _, _ := row.DoubleValueByName("Column3")
+	minimum, _, _ := row.DoubleValueByName("Minimum")
+	maximum, _, _ := row.DoubleValueByName("Maximum")
+
+	if count != 4 || sum != 149.0 || avg != 37.25 || minimum != 10.0 || maximum != 120.0 {
+		t.Fatal("TestSelectAggregateFunctions: unexpected aggregate results: " + result.String())
+	}
+
+	// Aggregates over no rows produce a single row with a zero count and Null values
+	if result, err = SelectDataTable(dataSet, "SELECT COUNT(*) AS Total, SUM(Value) AS Sum FROM Measurements WHERE Device = 'NONE'", true); err != nil {
+		t.Fatal("TestSelectAggregateFunctions: error executing SelectDataTable: " + err.Error())
+	}
+
+	if total, _, _ := result.Row(0).Int32Value(0); result.RowCount() != 1 || total != 0 {
+		t.Fatal("TestSelectAggregateFunctions: expected zero count for empty selection: " + result.String())
+	}
+
+	if _, null, _ := result.Row(0).DoubleValue(1); !null {
+		t.Fatal("TestSelectAggregateFunctions: expected Null sum for empty selection")
+	}
+}
+
+func TestSelectProjection(t *testing.T) {
+	dataSet := createAggregateDataSet()
+
+	result, err := SelectDataTable(dataSet, "SELECT TOP 2 Device, Value * 10 AS Scaled FROM Measurements WHERE Value IS NOT NULL ORDER BY Scaled", true)
+
+	if err != nil {
+		t.Fatal("TestSelectProjection: error executing SelectDataTable: " + err.Error())
+	}
+
+	if result.RowCount() != 2 || result.Column(0).Name() != "Device" {
+		t.Fatal("TestSelectProjection: unexpected result: " + result.String())
+	}
+
+	if scaled, _, _ := result.Row(1).DoubleValueByName("Scaled"); scaled != 200.0 {
+		t.Fatal("TestSelectProjection: expected second scaled value of 200, received: " + strconv.FormatFloat(scaled, 'f', -1, 64))
+	}
+}
+
+func TestSelectStatementErrors(t *testing.T) {
+	dataSet := createAggregateDataSet()
+
+	statements := []string{
+		"SELECT Device FROM Measurements WHERE COUNT(*) > 1",
+		"SELECT Device, COUNT(*) FROM Measurements GROUP BY Missing",
+		"SELECT Device FROM Measurements ORDER BY SignalType",
+		"SELECT SUM(Device) FROM Measurements",
+		"SELECT MAX(*) FROM Measurements",
+		"SELECT Len(*) FROM Measurements",
+		"FILTER Measurements WHERE Len(*) > 1",
+		"SELECT SUM(MAX(Value)) FROM Measurements",
+		"SELECT Device, Device FROM Measurements",
+		"SELECT Device FROM Missing",
+		"FILTER Measurements WHERE COUNT(*) > 1",
+	}
+
+	for _, statement := range statements {
+		if _, err := SelectDataTable(dataSet, statement, true); err == nil {
+			t.Fatal("TestSelectStatementErrors: expected error for statement \"" + statement + "\"")
+		}
+	}
+
+	if _, err := SelectDataRows(dataSet, "FILTER Measurements WHERE COUNT(*) > 1", "", nil, true); err == nil {
+		t.Fatal("TestSelectStatementErrors: expected error for aggregate function in filter statement")
+	}
+
+	if err := RegisterFunction("Count", nil, DataType.Int32, func([]interface{}) (interface{}, error) { return int32(0), nil }); err == nil {
+		t.Fatal("TestSelectStatementErrors: expected registration error for aggregate function name")
+	}
+}
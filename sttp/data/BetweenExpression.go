@@ -0,0 +1,133 @@
+//******************************************************************************************************
+//  BetweenExpression.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+)
+
+// BetweenExpression represents a "BETWEEN" expression, i.e., value BETWEEN lowerBound AND upperBound,
+// which is equivalent to value >= lowerBound AND value <= upperBound.
+type BetweenExpression struct {
+	value         Expression
+	lowerBound    Expression
+	upperBound    Expression
+	hasNotKeyword bool
+}
+
+// NewBetweenExpression creates a new between expression.
+func NewBetweenExpression(value, lowerBound, upperBound Expression, hasNotKeyword bool) *BetweenExpression {
+	return &BetweenExpression{
+		value:         value,
+		lowerBound:    lowerBound,
+		upperBound:    upperBound,
+		hasNotKeyword: hasNotKeyword,
+	}
+}
+
+// Type gets expression type of the BetweenExpression.
+func (*BetweenExpression) Type() ExpressionTypeEnum {
+	return ExpressionType.Between
+}
+
+// Value gets the expression value of the BetweenExpression.
+func (be *BetweenExpression) Value() Expression {
+	return be.value
+}
+
+// LowerBound gets the inclusive lower bound expression of the BetweenExpression.
+func (be *BetweenExpression) LowerBound() Expression {
+	return be.lowerBound
+}
+
+// UpperBound gets the inclusive upper bound expression of the BetweenExpression.
+func (be *BetweenExpression) UpperBound() Expression {
+	return be.upperBound
+}
+
+// HasNotKeyword gets a flag that determines if the BetweenExpression has the "NOT" keyword.
+func (be *BetweenExpression) HasNotKeyword() bool {
+	return be.hasNotKeyword
+}
+
+// String gets the BetweenExpression formatted as canonical filter expression syntax.
+func (be *BetweenExpression) String() string {
+	operator := " BETWEEN "
+
+	if be.hasNotKeyword {
+		operator = " NOT BETWEEN "
+	}
+
+	return operandString(be.value, predicatePrecedence) + operator +
+		operandString(be.lowerBound, predicatePrecedence) + " AND " +
+		operandString(be.upperBound, predicatePrecedence)
+}
+
+func (et *ExpressionTree) evaluateBetween(expression Expression) (*ValueExpression, error) {
+	betweenExpression := expression.(*BetweenExpression)
+	var value, lowerBound, upperBound *ValueExpression
+	var err error
+
+	if value, err = et.evaluate(betweenExpression.Value()); err != nil {
+		return nil, errors.New("failed while evaluating \"BETWEEN\" expression source value: " + err.Error())
+	}
+
+	if lowerBound, err = et.evaluate(betweenExpression.LowerBound()); err != nil {
+		return nil, errors.New("failed while evaluating \"BETWEEN\" expression lower bound: " + err.Error())
+	}
+
+	if upperBound, err = et.evaluate(betweenExpression.UpperBound()); err != nil {
+		return nil, errors.New("failed while evaluating \"BETWEEN\" expression upper bound: " + err.Error())
+	}
+
+	var valueType ExpressionValueTypeEnum
+	var lowerResult, upperResult, result *ValueExpression
+
+	if valueType, err = ExpressionOperatorType.GreaterThanOrEqual.deriveComparisonOperationValueType(value.ValueType(), lowerBound.ValueType()); err != nil {
+		return nil, errors.New("failed while deriving \"BETWEEN\" expression lower bound comparison operation value type: " + err.Error())
+	}
+
+	if lowerResult, err = et.greaterThanOrEqualOp(value, lowerBound, valueType); err != nil {
+		return nil, errors.New("failed while comparing \"BETWEEN\" expression source value to lower bound: " + err.Error())
+	}
+
+	if valueType, err = ExpressionOperatorType.LessThanOrEqual.deriveComparisonOperationValueType(value.ValueType(), upperBound.ValueType()); err != nil {
+		return nil, errors.New("failed while deriving \"BETWEEN\" expression upper bound comparison operation value type: " + err.Error())
+	}
+
+	if upperResult, err = et.lessThanOrEqualOp(value, upperBound, valueType); err != nil {
+		return nil, errors.New("failed while comparing \"BETWEEN\" expression source value to upper bound: " + err.Error())
+	}
+
+	if result, err = et.andOp(lowerResult, upperResult); err != nil {
+		return nil, err
+	}
+
+	// If source value or either bound is Null, result is Null
+	if result.IsNull() || !betweenExpression.HasNotKeyword() {
+		return result, nil
+	}
+
+	return newValueExpression(ExpressionValueType.Boolean, !result.booleanValue()), nil
+}
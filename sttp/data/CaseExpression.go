@@ -0,0 +1,168 @@
+//******************************************************************************************************
+//  CaseExpression.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// CaseExpression represents a "CASE" expression. A simple case expression, i.e., one with a value, selects
+// the result of the first "WHEN" condition that is equal to the value. A searched case expression, i.e., one
+// without a value, selects the result of the first "WHEN" condition that evaluates to True. When no condition
+// matches, the "ELSE" result is selected, or Null when there is no "ELSE" result.
+type CaseExpression struct {
+	value      Expression
+	conditions []Expression
+	results    []Expression
+	elseResult Expression
+}
+
+// NewCaseExpression creates a new case expression. The value parameter is nil for a searched case expression
+// and the elseResult parameter is nil when there is no "ELSE" result. Each condition must have a result.
+func NewCaseExpression(value Expression, conditions, results []Expression, elseResult Expression) *CaseExpression {
+	return &CaseExpression{
+		value:      value,
+		conditions: conditions,
+		results:    results,
+		elseResult: elseResult,
+	}
+}
+
+// Type gets expression type of the CaseExpression.
+func (*CaseExpression) Type() ExpressionTypeEnum {
+	return ExpressionType.Case
+}
+
+// Value gets the expression value of the CaseExpression, or nil for a searched case expression.
+func (ce *CaseExpression) Value() Expression {
+	return ce.value
+}
+
+// Conditions gets the "WHEN" condition expressions of the CaseExpression.
+func (ce *CaseExpression) Conditions() []Expression {
+	return ce.conditions
+}
+
+// Results gets the "THEN" result expressions of the CaseExpression, one for each condition.
+func (ce *CaseExpression) Results() []Expression {
+	return ce.results
+}
+
+// ElseResult gets the "ELSE" result expression of the CaseExpression, or nil when not defined.
+func (ce *CaseExpression) ElseResult() Expression {
+	return ce.elseResult
+}
+
+// String gets the CaseExpression formatted as canonical filter expression syntax.
+func (ce *CaseExpression) String() string {
+	var image strings.Builder
+
+	image.WriteString("CASE")
+
+	if ce.value != nil {
+		image.WriteString(" " + ExpressionString(ce.value))
+	}
+
+	for i, condition := range ce.conditions {
+		image.WriteString(" WHEN " + ExpressionString(condition) + " THEN ")
+
+		if i < len(ce.results) {
+			image.WriteString(ExpressionString(ce.results[i]))
+		} else {
+			image.WriteString("NULL")
+		}
+	}
+
+	if ce.elseResult != nil {
+		image.WriteString(" ELSE " + ExpressionString(ce.elseResult))
+	}
+
+	image.WriteString(" END")
+
+	return image.String()
+}
+
+func (et *ExpressionTree) evaluateCase(expression Expression, targetValueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	caseExpression := expression.(*CaseExpression)
+	conditions := caseExpression.Conditions()
+	results := caseExpression.Results()
+	var caseValue, conditionValue, result *ValueExpression
+	var err error
+
+	if len(conditions) != len(results) {
+		return nil, errors.New("\"CASE\" expression is malformed, each \"WHEN\" condition must have a result")
+	}
+
+	if caseExpression.Value() != nil {
+		if caseValue, err = et.evaluate(caseExpression.Value()); err != nil {
+			return nil, errors.New("failed while evaluating \"CASE\" expression source value: " + err.Error())
+		}
+
+		// Null case value never matches a condition
+		if caseValue.IsNull() {
+			conditions = nil
+		}
+	}
+
+	// Not pre-evaluating conditions or results - only evaluating until a condition matches
+	for i := 0; i < len(conditions); i++ {
+		if conditionValue, err = et.evaluate(conditions[i]); err != nil {
+			return nil, errors.New("failed while evaluating \"CASE\" expression \"WHEN\" condition " + strconv.Itoa(i) + ": " + err.Error())
+		}
+
+		if caseValue != nil {
+			var valueType ExpressionValueTypeEnum
+
+			if valueType, err = ExpressionOperatorType.Equal.deriveComparisonOperationValueType(caseValue.ValueType(), conditionValue.ValueType()); err != nil {
+				return nil, errors.New("failed while deriving \"CASE\" expression equality comparison operation value type: " + err.Error())
+			}
+
+			if conditionValue, err = et.equalOp(caseValue, conditionValue, valueType, false); err != nil {
+				return nil, errors.New("failed while comparing \"CASE\" expression source value to \"WHEN\" condition " + strconv.Itoa(i) + " for equality: " + err.Error())
+			}
+		} else if conditionValue.ValueType() != ExpressionValueType.Boolean {
+			return nil, errors.New("\"CASE\" expression \"WHEN\" condition " + strconv.Itoa(i) + " must be a \"Boolean\", received \"" + conditionValue.ValueType().String() + "\"")
+		}
+
+		// Null condition evaluates to false
+		if !conditionValue.booleanValue() {
+			continue
+		}
+
+		if result, err = et.evaluateAs(results[i], targetValueType); err != nil {
+			return nil, errors.New("failed while evaluating \"CASE\" expression \"THEN\" result " + strconv.Itoa(i) + ": " + err.Error())
+		}
+
+		return result, nil
+	}
+
+	// Without an "ELSE" result, result is Null
+	if result, err = et.evaluateAs(caseExpression.ElseResult(), targetValueType); err != nil {
+		return nil, errors.New("failed while evaluating \"CASE\" expression \"ELSE\" result: " + err.Error())
+	}
+
+	return result, nil
+}
@@ -23,9 +23,14 @@
 
 package data
 
+import (
+	"strings"
+)
+
 // ColumnExpression represents a column expression.
 type ColumnExpression struct {
 	dataColumn *DataColumn
+	relations  []*DataRelation
 }
 
 // NewColumnExpression creates a new column expression.
@@ -35,6 +40,15 @@ func NewColumnExpression(dataColumn *DataColumn) *ColumnExpression {
 	}
 }
 
+// NewRelatedColumnExpression creates a new column expression for a column of a related table. The relations
+// parameter defines the path of relations, in order, from the evaluated table to the table of dataColumn.
+func NewRelatedColumnExpression(dataColumn *DataColumn, relations []*DataRelation) *ColumnExpression {
+	return &ColumnExpression{
+		dataColumn: dataColumn,
+		relations:  relations,
+	}
+}
+
 // Type gets expression type of the ColumnExpression.
 func (*ColumnExpression) Type() ExpressionTypeEnum {
 	return ExpressionType.Column
@@ -44,3 +58,26 @@ func (*ColumnExpression) Type() ExpressionTypeEnum {
 func (ce *ColumnExpression) DataColumn() *DataColumn {
 	return ce.dataColumn
 }
+
+// Relations gets the path of relations used to reach the data column of the ColumnExpression
+// from the evaluated table, if any. Result will be empty for columns of the evaluated table.
+func (ce *ColumnExpression) Relations() []*DataRelation {
+	return ce.relations
+}
+
+// String gets the ColumnExpression formatted as canonical filter expression syntax, i.e., the column
+// name, preceded by the names of any relations, separated by periods.
+func (ce *ColumnExpression) String() string {
+	var columnPath strings.Builder
+
+	for _, relation := range ce.relations {
+		columnPath.WriteString(IdentifierString(relation.Name()))
+		columnPath.WriteByte('.')
+	}
+
+	if ce.dataColumn != nil {
+		columnPath.WriteString(IdentifierString(ce.dataColumn.Name()))
+	}
+
+	return columnPath.String()
+}
@@ -0,0 +1,397 @@
+//******************************************************************************************************
+//  CompiledFilter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"container/list"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/hashset"
+)
+
+// DefaultCompiledFilterCacheSize defines the default maximum number of compiled filters cached by Compile.
+const DefaultCompiledFilterCacheSize = 256
+
+// CompiledFilter represents a filter expression that has been parsed and validated against the schema of
+// a DataTable once so that it can be evaluated many times, against any DataTable with the same schema,
+// without parsing the expression again. A compiled filter can reference named parameters, e.g., "@source",
+// with values bound at evaluation time; since parameter values are never parsed as expression text, they
+// avoid the injection issues of building filter expressions with string concatenation. Compiled filters
+// are safe for concurrent use. Use Compile to create a CompiledFilter.
+type CompiledFilter struct {
+	filterExpression string
+	tableName        string
+	schema           string
+	expressionTrees  []*ExpressionTree
+	parameters       []string
+}
+
+// Compile parses the filterExpression and validates its column references against the schema of the
+// dataTable, returning a CompiledFilter that can be evaluated against any DataTable with the same columns.
+// The filter expression can contain multiple semi-colon separated statements where each statement is a
+// boolean expression or a "FILTER" statement for the dataTable. Identifier statements, "SELECT" statements
+// and column paths of related tables are not supported. Compiled filters are cached by the table schema
+// and expression text, see SetCompiledFilterCacheSize. An error will be returned if dataTable parameter
+// is nil, the filterExpression is empty, expression fails to parse or has an unsupported statement.
+func Compile(dataTable *DataTable, filterExpression string) (*CompiledFilter, error) {
+	if dataTable == nil {
+		return nil, errors.New("dataTable parameter is nil")
+	}
+
+	if len(strings.TrimSpace(filterExpression)) == 0 {
+		return nil, errors.New("filter expression is empty")
+	}
+
+	schema := tableSchema(dataTable)
+	key := strings.ToUpper(dataTable.Name()) + "\x1E" + schema + "\x1E" + filterExpression
+
+	if compiledFilter := compiledFilters.get(key); compiledFilter != nil {
+		return compiledFilter, nil
+	}
+
+	// Parse expression against a copy of the table schema so compiled filter does not reference source data
+	dataSet := NewDataSet()
+	schemaTable := dataSet.CreateTable(dataTable.Name())
+	schemaTable.InitColumns(dataTable.ColumnCount())
+
+	for i := 0; i < dataTable.ColumnCount(); i++ {
+		schemaTable.AddColumn(schemaTable.CloneColumn(dataTable.Column(i)))
+	}
+
+	dataSet.AddTable(schemaTable)
+
+	parser, err := NewFilterExpressionParserForDataSet(dataSet, filterExpression, dataTable.Name(), nil, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	expressionTrees, err := parser.ExpressionTrees()
+
+	if err != nil {
+		return nil, errors.New("failed to compile filter expression \"" + filterExpression + "\": " + err.Error())
+	}
+
+	// Identifier statements, e.g., point tags, do not produce an expression tree
+	if len(expressionTrees) == 0 || len(expressionTrees) != parser.FilterExpressionStatementCount() {
+		return nil, errors.New("failed to compile filter expression \"" + filterExpression + "\": identifier statements are not supported by compiled filters")
+	}
+
+	parameters := make([]string, 0)
+	found := make(map[string]bool)
+
+	for _, expressionTree := range expressionTrees {
+		if len(expressionTree.SelectTerms) > 0 {
+			return nil, errors.New("failed to compile filter expression \"" + filterExpression + "\": \"SELECT\" statements are not supported by compiled filters")
+		}
+
+		if len(expressionTree.TableName) > 0 && !strings.EqualFold(expressionTree.TableName, dataTable.Name()) {
+			return nil, errors.New("failed to compile filter expression \"" + filterExpression + "\": statement table \"" + expressionTree.TableName + "\" does not match compiled table \"" + dataTable.Name() + "\"")
+		}
+
+		if expressionTree.Root == nil {
+			return nil, errors.New("failed to compile filter expression \"" + filterExpression + "\": each statement must define a boolean predicate")
+		}
+
+		for _, name := range expressionTree.Parameters() {
+			if key := strings.ToUpper(name); !found[key] {
+				found[key] = true
+				parameters = append(parameters, name)
+			}
+		}
+	}
+
+	compiledFilter := &CompiledFilter{
+		filterExpression: filterExpression,
+		tableName:        dataTable.Name(),
+		schema:           schema,
+		expressionTrees:  expressionTrees,
+		parameters:       parameters,
+	}
+
+	compiledFilters.add(key, compiledFilter)
+	return compiledFilter, nil
+}
+
+// FilterExpression gets the source filter expression of the CompiledFilter.
+func (cf *CompiledFilter) FilterExpression() string {
+	return cf.filterExpression
+}
+
+// TableName gets the name of the DataTable the CompiledFilter was compiled for.
+func (cf *CompiledFilter) TableName() string {
+	return cf.tableName
+}
+
+// Parameters gets the distinct names, without "@" prefix, of the parameters referenced by the CompiledFilter.
+func (cf *CompiledFilter) Parameters() []string {
+	return append([]string(nil), cf.parameters...)
+}
+
+// Select returns the rows of the dataTable matching the CompiledFilter using the specified parameters values,
+// see ExpressionTree.SetParameters. When the filter has multiple statements, the combined unique results of
+// each statement are returned. Any "TOP" limit or "ORDER BY" clauses will be respected. An error will be
+// returned if the dataTable schema does not match the compiled schema, a parameter value is missing or any
+// row expression evaluation fails.
+func (cf *CompiledFilter) Select(dataTable *DataTable, parameters map[string]interface{}) ([]*DataRow, error) {
+	if err := cf.validateSchema(dataTable); err != nil {
+		return nil, err
+	}
+
+	expressionTrees, err := cf.bind(parameters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filteredRows := make([]*DataRow, 0)
+	var filteredRowSet hashset.HashSet[*DataRow]
+
+	if len(expressionTrees) > 1 {
+		filteredRowSet = hashset.HashSet[*DataRow]{}
+	}
+
+	for _, expressionTree := range expressionTrees {
+		matchedRows, err := expressionTree.Select(dataTable)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range matchedRows {
+			if filteredRowSet == nil || filteredRowSet.Add(row) {
+				filteredRows = append(filteredRows, row)
+			}
+		}
+	}
+
+	return filteredRows, nil
+}
+
+// Matches determines if the dataRow matches any statement of the CompiledFilter using the specified parameters
+// values, see ExpressionTree.SetParameters. Null results do not match. An error will be returned if dataRow is
+// nil, its table schema does not match the compiled schema, a parameter value is missing, the evaluation fails
+// or does not evaluate to a boolean value.
+func (cf *CompiledFilter) Matches(dataRow *DataRow, parameters map[string]interface{}) (bool, error) {
+	if dataRow == nil {
+		return false, errors.New("dataRow parameter is nil")
+	}
+
+	if err := cf.validateSchema(dataRow.Parent()); err != nil {
+		return false, err
+	}
+
+	expressionTrees, err := cf.bind(parameters)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, expressionTree := range expressionTrees {
+		result, err := expressionTree.Evaluate(dataRow)
+
+		if err != nil {
+			return false, err
+		}
+
+		if result.ValueType() != ExpressionValueType.Boolean {
+			return false, errors.New("filter expression \"" + cf.filterExpression + "\" did not evaluate to a boolean value, result data type is \"" + result.ValueType().String() + "\"")
+		}
+
+		if result.booleanValue() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Evaluate returns the result of the first statement of the CompiledFilter evaluated for the dataRow using the
+// specified parameters values, see ExpressionTree.SetParameters. The dataRow parameter can be nil if there are
+// no columns referenced in the filter expression. An error will be returned if the dataRow table schema does
+// not match the compiled schema, a parameter value is missing or the evaluation fails.
+func (cf *CompiledFilter) Evaluate(dataRow *DataRow, parameters map[string]interface{}) (*ValueExpression, error) {
+	if dataRow != nil {
+		if err := cf.validateSchema(dataRow.Parent()); err != nil {
+			return nil, err
+		}
+	}
+
+	expressionTrees, err := cf.bind(parameters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expressionTrees[0].Evaluate(dataRow)
+}
+
+// bind gets copies of the compiled expression trees with the parameters values assigned, copies
+// have their own evaluation state so the compiled filter can be safely used concurrently.
+func (cf *CompiledFilter) bind(parameters map[string]interface{}) ([]*ExpressionTree, error) {
+	expressionTrees := make([]*ExpressionTree, len(cf.expressionTrees))
+
+	for i, expressionTree := range cf.expressionTrees {
+		boundTree := *expressionTree
+
+		if err := boundTree.SetParameters(parameters); err != nil {
+			return nil, errors.New("failed to bind filter expression \"" + cf.filterExpression + "\" parameters: " + err.Error())
+		}
+
+		expressionTrees[i] = &boundTree
+	}
+
+	return expressionTrees, nil
+}
+
+func (cf *CompiledFilter) validateSchema(dataTable *DataTable) error {
+	if dataTable == nil {
+		return errors.New("dataTable parameter is nil")
+	}
+
+	if tableSchema(dataTable) != cf.schema {
+		return errors.New("schema of table \"" + dataTable.Name() + "\" does not match schema of table \"" + cf.tableName + "\" used to compile filter expression \"" + cf.filterExpression + "\"")
+	}
+
+	return nil
+}
+
+// tableSchema gets a representation of the columns of the dataTable that is the same for tables
+// where compiled column references are interchangeable.
+func tableSchema(dataTable *DataTable) string {
+	var schema strings.Builder
+
+	for i := 0; i < dataTable.ColumnCount(); i++ {
+		column := dataTable.Column(i)
+
+		if i > 0 {
+			schema.WriteByte('\x1F')
+		}
+
+		schema.WriteString(strings.ToUpper(column.Name()))
+		schema.WriteByte(':')
+		schema.WriteString(column.Type().String())
+
+		if len(column.Expression()) > 0 {
+			schema.WriteByte('=')
+			schema.WriteString(column.Expression())
+		}
+	}
+
+	return schema.String()
+}
+
+// SetCompiledFilterCacheSize sets the maximum number of compiled filters cached by Compile, least recently
+// used compiled filters are removed first. Set size to zero to disable caching. Defaults to
+// DefaultCompiledFilterCacheSize.
+func SetCompiledFilterCacheSize(size int) {
+	compiledFilters.resize(size)
+}
+
+// ClearCompiledFilterCache removes all compiled filters cached by Compile.
+func ClearCompiledFilterCache() {
+	compiledFilters.resize(-1)
+}
+
+var compiledFilters = &compiledFilterCache{
+	capacity: DefaultCompiledFilterCacheSize,
+	entries:  make(map[string]*list.Element),
+	order:    list.New(),
+}
+
+type compiledFilterCache struct {
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	lock     sync.Mutex
+}
+
+type compiledFilterCacheEntry struct {
+	key            string
+	compiledFilter *CompiledFilter
+}
+
+func (cfc *compiledFilterCache) get(key string) *CompiledFilter {
+	cfc.lock.Lock()
+	defer cfc.lock.Unlock()
+
+	element, ok := cfc.entries[key]
+
+	if !ok {
+		return nil
+	}
+
+	cfc.order.MoveToFront(element)
+	return element.Value.(*compiledFilterCacheEntry).compiledFilter
+}
+
+func (cfc *compiledFilterCache) add(key string, compiledFilter *CompiledFilter) {
+	cfc.lock.Lock()
+	defer cfc.lock.Unlock()
+
+	if cfc.capacity <= 0 {
+		return
+	}
+
+	if element, ok := cfc.entries[key]; ok {
+		element.Value.(*compiledFilterCacheEntry).compiledFilter = compiledFilter
+		cfc.order.MoveToFront(element)
+		return
+	}
+
+	cfc.entries[key] = cfc.order.PushFront(&compiledFilterCacheEntry{key: key, compiledFilter: compiledFilter})
+	cfc.trim()
+}
+
+// resize sets the cache capacity, a negative size clears the cache and keeps the current capacity.
+func (cfc *compiledFilterCache) resize(size int) {
+	cfc.lock.Lock()
+	defer cfc.lock.Unlock()
+
+	if size < 0 {
+		cfc.entries = make(map[string]*list.Element)
+		cfc.order.Init()
+		return
+	}
+
+	cfc.capacity = size
+	cfc.trim()
+}
+
+func (cfc *compiledFilterCache) len() int {
+	cfc.lock.Lock()
+	defer cfc.lock.Unlock()
+
+	return cfc.order.Len()
+}
+
+func (cfc *compiledFilterCache) trim() {
+	for cfc.order.Len() > cfc.capacity {
+		element := cfc.order.Back()
+		cfc.order.Remove(element)
+		delete(cfc.entries, element.Value.(*compiledFilterCacheEntry).key)
+	}
+}
@@ -0,0 +1,165 @@
+//******************************************************************************************************
+//  CompiledFilter_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCompiledFilterParameters(t *testing.T) {
+	dataSet, _, _, statID, freqID := createDataSet()
+	dataTable := dataSet.Table("ActiveMeasurements")
+
+	compiledFilter, err := Compile(dataTable, "FILTER ActiveMeasurements WHERE SignalType = @signalType")
+
+	if err != nil {
+		t.Fatal("TestCompiledFilterParameters: failed to compile filter expression: " + err.Error())
+	}
+
+	if parameters := compiledFilter.Parameters(); len(parameters) != 1 || parameters[0] != "signalType" {
+		t.Fatal("TestCompiledFilterParameters: unexpected parameters")
+	}
+
+	rows, err := compiledFilter.Select(dataTable, map[string]interface{}{"SignalType": "FREQ"})
+
+	if err != nil {
+		t.Fatal("TestCompiledFilterParameters: failed to select rows: " + err.Error())
+	}
+
+	if len(rows) != 1 {
+		t.Fatal("TestCompiledFilterParameters: expected 1 row, received: " + strconv.Itoa(len(rows)))
+	}
+
+	if signalID, _, _ := rows[0].GuidValueByName("SignalID"); signalID != freqID {
+		t.Fatal("TestCompiledFilterParameters: expected FREQ row")
+	}
+
+	matched, err := compiledFilter.Matches(dataTable.Row(0), map[string]interface{}{"@signalType": "STAT"})
+
+	if err != nil || !matched {
+		t.Fatal("TestCompiledFilterParameters: expected STAT row to match")
+	}
+
+	if signalID, _, _ := dataTable.Row(0).GuidValueByName("SignalID"); signalID != statID {
+		t.Fatal("TestCompiledFilterParameters: expected STAT row")
+	}
+
+	// Parameter values are never parsed as expression text
+	if rows, err = compiledFilter.Select(dataTable, map[string]interface{}{"signalType": "FREQ' OR '1' = '1"}); err != nil {
+		t.Fatal("TestCompiledFilterParameters: failed to select rows: " + err.Error())
+	}
+
+	if len(rows) != 0 {
+		t.Fatal("TestCompiledFilterParameters: expected no rows for injected value, received: " + strconv.Itoa(len(rows)))
+	}
+
+	if _, err = compiledFilter.Select(dataTable, nil); err == nil {
+		t.Fatal("TestCompiledFilterParameters: expected missing parameter error")
+	}
+
+	result, err := compiledFilter.Evaluate(dataTable.Row(1), map[string]interface{}{"signalType": nil})
+
+	if err != nil {
+		t.Fatal("TestCompiledFilterParameters: failed to evaluate row: " + err.Error())
+	}
+
+	if !result.IsNull() {
+		t.Fatal("TestCompiledFilterParameters: expected Null result for Null parameter, received: " + result.String())
+	}
+}
+
+func TestCompiledFilterSchema(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+	otherDataSet, _, _, _, otherFreqID := createDataSet()
+	dataTable := dataSet.Table("ActiveMeasurements")
+
+	compiledFilter, err := Compile(dataTable, "SignalType IN (@first, @second); SignalID = @signalID")
+
+	if err != nil {
+		t.Fatal("TestCompiledFilterSchema: failed to compile filter expression: " + err.Error())
+	}
+
+	// Compiled filters can be reused across tables with the same schema
+	rows, err := compiledFilter.Select(otherDataSet.Table("ActiveMeasurements"), map[string]interface{}{"first": "FREQ", "second": "FREQ", "signalID": otherFreqID})
+
+	if err != nil {
+		t.Fatal("TestCompiledFilterSchema: failed to select rows: " + err.Error())
+	}
+
+	if len(rows) != 1 {
+		t.Fatal("TestCompiledFilterSchema: expected 1 unique row, received: " + strconv.Itoa(len(rows)))
+	}
+
+	otherTable := dataSet.CreateTable("ActiveMeasurements")
+	createDataColumn(otherTable, "SignalID", DataType.Guid)
+	createDataColumn(otherTable, "SignalType", DataType.Int32)
+
+	if _, err = compiledFilter.Select(otherTable, map[string]interface{}{"first": 1, "second": 2, "signalID": nil}); err == nil {
+		t.Fatal("TestCompiledFilterSchema: expected schema mismatch error")
+	}
+
+	if _, err = Compile(dataTable, "Unknown = @value"); err == nil {
+		t.Fatal("TestCompiledFilterSchema: expected unknown column error")
+	}
+
+	for _, filterExpression := range []string{"", "FREQ", "{" + otherFreqID.String() + "}", "SELECT COUNT(*) FROM ActiveMeasurements", "FILTER MeasurementDetail WHERE True"} {
+		if _, err = Compile(dataTable, filterExpression); err == nil {
+			t.Fatal("TestCompiledFilterSchema: expected compile error for filter expression \"" + filterExpression + "\"")
+		}
+	}
+}
+
+func TestCompiledFilterCache(t *testing.T) {
+	defer SetCompiledFilterCacheSize(DefaultCompiledFilterCacheSize)
+
+	dataSet, _, _, _, _ := createDataSet()
+	dataTable := dataSet.Table("ActiveMeasurements")
+
+	ClearCompiledFilterCache()
+	SetCompiledFilterCacheSize(2)
+
+	first, _ := Compile(dataTable, "SignalType = @a")
+
+	if cached, _ := Compile(dataTable, "SignalType = @a"); cached != first {
+		t.Fatal("TestCompiledFilterCache: expected cached compiled filter")
+	}
+
+	Compile(dataTable, "SignalType = @b")
+	Compile(dataTable, "SignalType = @c")
+
+	if compiledFilters.len() != 2 {
+		t.Fatal("TestCompiledFilterCache: expected cache size of 2, received: " + strconv.Itoa(compiledFilters.len()))
+	}
+
+	if cached, _ := Compile(dataTable, "SignalType = @a"); cached == first {
+		t.Fatal("TestCompiledFilterCache: expected least recently used compiled filter to be removed")
+	}
+
+	SetCompiledFilterCacheSize(0)
+
+	if compiledFilters.len() != 0 {
+		t.Fatal("TestCompiledFilterCache: expected empty cache")
+	}
+}
@@ -47,13 +47,25 @@ var ExpressionType = struct {
 	Function ExpressionTypeEnum
 	// Operator defines an operator expression type.
 	Operator ExpressionTypeEnum
+	// Aggregate defines an aggregate expression type.
+	Aggregate ExpressionTypeEnum
+	// Parameter defines a named parameter expression type.
+	Parameter ExpressionTypeEnum
+	// Between defines a between expression type.
+	Between ExpressionTypeEnum
+	// Case defines a case expression type.
+	Case ExpressionTypeEnum
 }{
-	Value:    0,
-	Unary:    1,
-	Column:   2,
-	InList:   3,
-	Function: 4,
-	Operator: 5,
+	Value:     0,
+	Unary:     1,
+	Column:    2,
+	InList:    3,
+	Function:  4,
+	Operator:  5,
+	Aggregate: 6,
+	Parameter: 7,
+	Between:   8,
+	Case:      9,
 }
 
 // String gets the ExpressionType enumeration value as a string.
@@ -71,6 +83,14 @@ func (ete ExpressionTypeEnum) String() string {
 		return "Function"
 	case ExpressionType.Operator:
 		return "Operator"
+	case ExpressionType.Aggregate:
+		return "Aggregate"
+	case ExpressionType.Parameter:
+		return "Parameter"
+	case ExpressionType.Between:
+		return "Between"
+	case ExpressionType.Case:
+		return "Case"
 	default:
 		return "0x" + strconv.FormatInt(int64(ete), 16)
 	}
@@ -211,6 +231,70 @@ func (eute ExpressionUnaryTypeEnum) String() string {
 	}
 }
 
+// ExpressionAggregateTypeEnum defines the type of the ExpressionAggregateType enumeration.
+type ExpressionAggregateTypeEnum int
+
+// ExpressionAggregateType is an enumeration of possible aggregate function types. Aggregate
+// functions are only valid within a "SELECT" statement where they are evaluated over each
+// group of rows defined by the "GROUP BY" clause, or over all selected rows when no
+// "GROUP BY" clause is specified.
+var ExpressionAggregateType = struct {
+	// Count defines an aggregate type that returns the number of rows in a group, or the number of non-null expression values.
+	Count ExpressionAggregateTypeEnum
+	// Sum defines an aggregate type that returns the sum of the non-null numeric expression values in a group.
+	Sum ExpressionAggregateTypeEnum
+	// Avg defines an aggregate type that returns the average of the non-null numeric expression values in a group.
+	Avg ExpressionAggregateTypeEnum
+	// Min defines an aggregate type that returns the minimum non-null expression value in a group.
+	Min ExpressionAggregateTypeEnum
+	// Max defines an aggregate type that returns the maximum non-null expression value in a group.
+	Max ExpressionAggregateTypeEnum
+}{
+	Count: 0,
+	Sum:   1,
+	Avg:   2,
+	Min:   3,
+	Max:   4,
+}
+
+// String gets the ExpressionAggregateType enumeration value as a string.
+func (eate ExpressionAggregateTypeEnum) String() string {
+	switch eate {
+	case ExpressionAggregateType.Count:
+		return "Count"
+	case ExpressionAggregateType.Sum:
+		return "Sum"
+	case ExpressionAggregateType.Avg:
+		return "Avg"
+	case ExpressionAggregateType.Min:
+		return "Min"
+	case ExpressionAggregateType.Max:
+		return "Max"
+	default:
+		return "0x" + strconv.FormatInt(int64(eate), 16)
+	}
+}
+
+// ParseExpressionAggregateType gets the ExpressionAggregateType parsed from the specified aggregate function name. Case insensitive.
+func ParseExpressionAggregateType(name string) (ExpressionAggregateTypeEnum, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+
+	switch name {
+	case "COUNT":
+		return ExpressionAggregateType.Count, nil
+	case "SUM":
+		return ExpressionAggregateType.Sum, nil
+	case "AVG":
+		return ExpressionAggregateType.Avg, nil
+	case "MIN":
+		return ExpressionAggregateType.Min, nil
+	case "MAX":
+		return ExpressionAggregateType.Max, nil
+	default:
+		return ExpressionAggregateType.Count, fmt.Errorf("specified aggregate function \"%s\" is unrecognized", name)
+	}
+}
+
 // ExpressionFunctionTypeEnum defines the type of the ExpressionFunctionType enumeration.
 type ExpressionFunctionTypeEnum int
 
@@ -298,6 +382,8 @@ var ExpressionFunctionType = struct {
 	Upper ExpressionFunctionTypeEnum
 	// UtcNow defines a function type that returns a DateTime value representing the current UTC system time.
 	UtcNow ExpressionFunctionTypeEnum
+	// UserDefined defines a function type that calls a user-defined function registered with RegisterFunction.
+	UserDefined ExpressionFunctionTypeEnum
 }{
 	Abs:         0,
 	Ceiling:     1,
@@ -340,6 +426,7 @@ var ExpressionFunctionType = struct {
 	TrimRight:   38,
 	Upper:       39,
 	UtcNow:      40,
+	UserDefined: 41,
 }
 
 // String gets the ExpressionFunctionType enumeration value as a string.
@@ -428,6 +515,8 @@ func (efte ExpressionFunctionTypeEnum) String() string {
 		return "Upper"
 	case ExpressionFunctionType.UtcNow:
 		return "UtcNow"
+	case ExpressionFunctionType.UserDefined:
+		return "UserDefined"
 	default:
 		return "0x" + strconv.FormatInt(int64(efte), 16)
 	}
@@ -490,6 +579,8 @@ var ExpressionOperatorType = struct {
 	And ExpressionOperatorTypeEnum
 	// Or defines an "OR" or "||" operator type.
 	Or ExpressionOperatorTypeEnum
+	// Concatenate defines a ".." string concatenation operator type.
+	Concatenate ExpressionOperatorTypeEnum
 }{
 	Multiply:           0,
 	Divide:             1,
@@ -517,6 +608,7 @@ var ExpressionOperatorType = struct {
 	NotLikeExactMatch:  23,
 	And:                24,
 	Or:                 25,
+	Concatenate:        26,
 }
 
 // String gets the ExpressionOperatorType enumeration value as a string.
@@ -575,6 +667,8 @@ func (eote ExpressionOperatorTypeEnum) String() string {
 		return "AND"
 	case ExpressionOperatorType.Or:
 		return "OR"
+	case ExpressionOperatorType.Concatenate:
+		return ".."
 	default:
 		return "0x" + strconv.FormatInt(int64(eote), 16)
 	}
@@ -689,6 +783,8 @@ func (eote ExpressionOperatorTypeEnum) deriveOperationValueType(leftValueType, r
 		fallthrough
 	case ExpressionOperatorType.Or:
 		return eote.deriveBooleanOperationValueType(leftValueType, rightValueType)
+	case ExpressionOperatorType.Concatenate:
+		return ExpressionValueType.String, nil
 	case ExpressionOperatorType.BitShiftLeft:
 		fallthrough
 	case ExpressionOperatorType.BitShiftRight:
@@ -0,0 +1,647 @@
+//******************************************************************************************************
+//  DataIndex.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// DataIndexTypeEnum defines the type of the DataIndexType enumeration.
+type DataIndexTypeEnum int
+
+// DataIndexType is an enumeration of the possible DataIndex types.
+var DataIndexType = struct {
+	// Hash defines an index that accelerates equality and "IN" predicates.
+	Hash DataIndexTypeEnum
+	// Sorted defines an index that accelerates equality, "IN" and range predicates.
+	Sorted DataIndexTypeEnum
+}{
+	Hash:   0,
+	Sorted: 1,
+}
+
+// String gets the DataIndexType enumeration value as a string.
+func (dite DataIndexTypeEnum) String() string {
+	switch dite {
+	case DataIndexType.Hash:
+		return "Hash"
+	case DataIndexType.Sorted:
+		return "Sorted"
+	default:
+		return "0x" + strconv.FormatInt(int64(dite), 16)
+	}
+}
+
+// DataIndex represents an index of the values of a DataColumn in a DataTable. Indexes are optional
+// and are used during filter expression evaluation to find the rows matching equality, "IN" and, for
+// sorted indexes, range predicates that compare the column to literal values without evaluating every
+// row. String values are indexed case-insensitively. An index is rebuilt on next use after the rows
+// of the DataTable are modified.
+type DataIndex struct {
+	column    *DataColumn
+	indexType DataIndexTypeEnum
+	valueType ExpressionValueTypeEnum
+
+	keys      map[interface{}][]int
+	entries   []dataIndexEntry
+	unindexed []int
+	version   int
+	built     bool
+	lock      sync.Mutex
+}
+
+type dataIndexEntry struct {
+	key interface{}
+	row int
+}
+
+// CreateIndex creates a new DataIndex of the specified indexType for the column with the specified
+// columnName and associates it with the DataTable. Any existing index for the column is replaced.
+// An error will be returned if the column does not exist or its data type cannot be indexed.
+func (dt *DataTable) CreateIndex(columnName string, indexType DataIndexTypeEnum) (*DataIndex, error) {
+	column := dt.ColumnByName(columnName)
+
+	if column == nil {
+		return nil, errors.New("cannot create index, column \"" + columnName + "\" was not found in table \"" + dt.name + "\"")
+	}
+
+	if indexType != DataIndexType.Hash && indexType != DataIndexType.Sorted {
+		return nil, errors.New("cannot create index for column \"" + column.Name() + "\" in table \"" + dt.name + "\", unexpected index type \"" + indexType.String() + "\"")
+	}
+
+	valueType := dataTypeValueType(column.Type())
+
+	if valueType == ExpressionValueType.Undefined {
+		return nil, errors.New("cannot create index for column \"" + column.Name() + "\" in table \"" + dt.name + "\", data type \"" + column.Type().String() + "\" cannot be indexed")
+	}
+
+	index := &DataIndex{
+		column:    column,
+		indexType: indexType,
+		valueType: valueType,
+	}
+
+	dt.indexes[strings.ToUpper(column.Name())] = index
+	return index, nil
+}
+
+// RemoveIndex removes any DataIndex for the column with the specified columnName from the DataTable.
+// Returns true if an index was removed; otherwise, false.
+func (dt *DataTable) RemoveIndex(columnName string) bool {
+	columnName = strings.ToUpper(columnName)
+
+	if _, ok := dt.indexes[columnName]; ok {
+		delete(dt.indexes, columnName)
+		return true
+	}
+
+	return false
+}
+
+// IndexByName gets the DataIndex for the column with the specified columnName if the column is
+// indexed; otherwise, nil is returned. Lookup is case-insensitive.
+func (dt *DataTable) IndexByName(columnName string) *DataIndex {
+	return dt.indexes[strings.ToUpper(columnName)]
+}
+
+// Indexes gets the DataIndex collection of the DataTable.
+func (dt *DataTable) Indexes() []*DataIndex {
+	indexes := make([]*DataIndex, 0, len(dt.indexes))
+
+	for _, column := range dt.columns {
+		if index, ok := dt.indexes[strings.ToUpper(column.Name())]; ok {
+			indexes = append(indexes, index)
+		}
+	}
+
+	return indexes
+}
+
+// Column gets the DataColumn indexed by the DataIndex.
+func (di *DataIndex) Column() *DataColumn {
+	return di.column
+}
+
+// Type gets the DataIndexType of the DataIndex.
+func (di *DataIndex) Type() DataIndexTypeEnum {
+	return di.indexType
+}
+
+// String gets a representation of the DataIndex as a string.
+func (di *DataIndex) String() string {
+	return di.column.Name() + " (" + di.indexType.String() + ")"
+}
+
+// update builds the index when the rows of the parent table have changed since it was last built.
+func (di *DataIndex) update() error {
+	table := di.column.Parent()
+
+	if di.built && di.version == table.rowsVersion {
+		return nil
+	}
+
+	keys := make(map[interface{}][]int)
+	entries := make([]dataIndexEntry, 0)
+	unindexed := make([]int, 0)
+
+	for i, row := range table.rows {
+		if row == nil {
+			continue
+		}
+
+		value, err := dataRowColumnValue(row, di.column)
+
+		if err != nil {
+			return errors.New("failed to build index for column \"" + di.column.Name() + "\" in table \"" + table.Name() + "\": " + err.Error())
+		}
+
+		// Null values never match a comparison
+		if value.IsNull() {
+			continue
+		}
+
+		// Values stored as another type, e.g., a UInt64 that exceeds Int64 range, are always evaluated
+		if value.ValueType() != di.valueType {
+			unindexed = append(unindexed, i)
+			continue
+		}
+
+		key := dataIndexKey(value)
+
+		if di.indexType == DataIndexType.Sorted {
+			entries = append(entries, dataIndexEntry{key: key, row: i})
+		} else {
+			hashKey := dataIndexHashKey(key)
+			keys[hashKey] = append(keys[hashKey], i)
+		}
+	}
+
+	if di.indexType == DataIndexType.Sorted {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return compareDataIndexKeys(entries[i].key, entries[j].key) < 0
+		})
+	}
+
+	di.keys = keys
+	di.entries = entries
+	di.unindexed = unindexed
+	di.version = table.rowsVersion
+	di.built = true
+
+	return nil
+}
+
+// indexValue converts the value to the index value type. Returns false if the value cannot be used
+// with the index, i.e., when the comparison of the column with the value, using the specified operator
+// and operand order, would not be evaluated using the index value type.
+func (di *DataIndex) indexValue(value *ValueExpression, operatorType ExpressionOperatorTypeEnum, columnIsLeft bool) (interface{}, bool) {
+	var valueType ExpressionValueTypeEnum
+	var err error
+
+	if columnIsLeft {
+		valueType, err = operatorType.deriveOperationValueType(di.valueType, value.ValueType())
+	} else {
+		valueType, err = operatorType.deriveOperationValueType(value.ValueType(), di.valueType)
+	}
+
+	if err != nil || valueType != di.valueType {
+		return nil, false
+	}
+
+	if value, err = value.Convert(valueType); err != nil {
+		return nil, false
+	}
+
+	return dataIndexKey(value), true
+}
+
+// lookup gets the sorted indexes of the rows that may equal the key.
+func (di *DataIndex) lookup(key interface{}) []int {
+	if di.indexType == DataIndexType.Sorted {
+		return di.rangeRows(key, true, key, true)
+	}
+
+	keyRows := di.keys[dataIndexHashKey(key)]
+	rows := make([]int, 0, len(keyRows)+len(di.unindexed))
+	rows = append(rows, keyRows...)
+	rows = append(rows, di.unindexed...)
+	sort.Ints(rows)
+
+	return rows
+}
+
+// rangeRows gets the sorted indexes of the rows that may be within the lower and upper keys. Set a key
+// to nil for an unbounded range. Only valid for sorted indexes.
+func (di *DataIndex) rangeRows(lower interface{}, lowerInclusive bool, upper interface{}, upperInclusive bool) []int {
+	start, end := 0, len(di.entries)
+
+	if lower != nil {
+		start = sort.Search(len(di.entries), func(i int) bool {
+			result := compareDataIndexKeys(di.entries[i].key, lower)
+			return result > 0 || result == 0 && lowerInclusive
+		})
+	}
+
+	if upper != nil {
+		end = sort.Search(len(di.entries), func(i int) bool {
+			result := compareDataIndexKeys(di.entries[i].key, upper)
+			return result > 0 || result == 0 && !upperInclusive
+		})
+	}
+
+	rows := make([]int, 0, len(di.unindexed)+max(end-start, 0))
+
+	for i := start; i < end; i++ {
+		rows = append(rows, di.entries[i].row)
+	}
+
+	rows = append(rows, di.unindexed...)
+	sort.Ints(rows)
+
+	return rows
+}
+
+// rows gets the sorted indexes of the rows selected by the find function, updating the index first as needed.
+func (di *DataIndex) rows(find func() []int) ([]int, error) {
+	di.lock.Lock()
+	defer di.lock.Unlock()
+
+	if err := di.update(); err != nil {
+		return nil, err
+	}
+
+	return find(), nil
+}
+
+// indexedRows gets the sorted indexes of the table rows that can match the expression, i.e., evaluate to
+// True, as determined by the table indexes. Each returned row still needs to be evaluated. Returns false
+// when the expression cannot be resolved using the indexes, in which case every row must be evaluated.
+//gocyclo:ignore
+func (et *ExpressionTree) indexedRows(table *DataTable, expression Expression) ([]int, bool, error) {
+	if expression == nil || len(table.indexes) == 0 {
+		return nil, false, nil
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+
+		switch operatorExpression.OperatorType() {
+		case ExpressionOperatorType.And:
+			leftRows, leftIndexed, err := et.indexedRows(table, operatorExpression.LeftValue())
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			rightRows, rightIndexed, err := et.indexedRows(table, operatorExpression.RightValue())
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			switch {
+			case leftIndexed && rightIndexed:
+				return intersectRows(leftRows, rightRows), true, nil
+			case leftIndexed:
+				return leftRows, true, nil
+			case rightIndexed:
+				return rightRows, true, nil
+			default:
+				return nil, false, nil
+			}
+		case ExpressionOperatorType.Or:
+			leftRows, leftIndexed, err := et.indexedRows(table, operatorExpression.LeftValue())
+
+			if err != nil || !leftIndexed {
+				return nil, false, err
+			}
+
+			rightRows, rightIndexed, err := et.indexedRows(table, operatorExpression.RightValue())
+
+			if err != nil || !rightIndexed {
+				return nil, false, err
+			}
+
+			return unionRows(leftRows, rightRows), true, nil
+		case ExpressionOperatorType.Equal, ExpressionOperatorType.EqualExactMatch,
+			ExpressionOperatorType.LessThan, ExpressionOperatorType.LessThanOrEqual,
+			ExpressionOperatorType.GreaterThan, ExpressionOperatorType.GreaterThanOrEqual:
+			return et.indexedComparisonRows(table, operatorExpression)
+		}
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+
+		if inListExpression.HasNotKeyword() {
+			return nil, false, nil
+		}
+
+		index := tableColumnIndex(table, inListExpression.Value())
+
+		if index == nil {
+			return nil, false, nil
+		}
+
+		keys := make([]interface{}, 0, len(inListExpression.Arguments()))
+
+		for _, argument := range inListExpression.Arguments() {
+			value, ok := et.constantValue(argument)
+
+			if !ok {
+				return nil, false, nil
+			}
+
+			// Null arguments never match
+			if value.IsNull() {
+				continue
+			}
+
+			key, ok := index.indexValue(value, ExpressionOperatorType.Equal, true)
+
+			if !ok {
+				return nil, false, nil
+			}
+
+			keys = append(keys, key)
+		}
+
+		rows, err := index.rows(func() []int {
+			rows := make([]int, 0)
+
+			for _, key := range keys {
+				rows = unionRows(rows, index.lookup(key))
+			}
+
+			return rows
+		})
+
+		return rows, err == nil, err
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+
+		if betweenExpression.HasNotKeyword() {
+			return nil, false, nil
+		}
+
+		// Value BETWEEN lower AND upper is resolved as value >= lower AND value <= upper
+		return et.indexedRows(table, NewOperatorExpression(ExpressionOperatorType.And,
+			NewOperatorExpression(ExpressionOperatorType.GreaterThanOrEqual, betweenExpression.Value(), betweenExpression.LowerBound()),
+			NewOperatorExpression(ExpressionOperatorType.LessThanOrEqual, betweenExpression.Value(), betweenExpression.UpperBound())))
+	}
+
+	return nil, false, nil
+}
+
+// indexedComparisonRows gets the sorted indexes of the table rows that can match a comparison of an
+// indexed column to a literal value.
+func (et *ExpressionTree) indexedComparisonRows(table *DataTable, operatorExpression *OperatorExpression) ([]int, bool, error) {
+	operatorType := operatorExpression.OperatorType()
+	columnIsLeft := true
+	index := tableColumnIndex(table, operatorExpression.LeftValue())
+	operand := operatorExpression.RightValue()
+
+	if index == nil {
+		columnIsLeft = false
+		index = tableColumnIndex(table, operatorExpression.RightValue())
+		operand = operatorExpression.LeftValue()
+	}
+
+	if index == nil {
+		return nil, false, nil
+	}
+
+	value, ok := et.constantValue(operand)
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	// Comparisons with Null never match
+	if value.IsNull() {
+		return []int{}, true, nil
+	}
+
+	key, ok := index.indexValue(value, operatorType, columnIsLeft)
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	// Normalize range operator so that column is on the left, e.g., "5 < Value" becomes "Value > 5"
+	if !columnIsLeft {
+		switch operatorType {
+		case ExpressionOperatorType.LessThan:
+			operatorType = ExpressionOperatorType.GreaterThan
+		case ExpressionOperatorType.LessThanOrEqual:
+			operatorType = ExpressionOperatorType.GreaterThanOrEqual
+		case ExpressionOperatorType.GreaterThan:
+			operatorType = ExpressionOperatorType.LessThan
+		case ExpressionOperatorType.GreaterThanOrEqual:
+			operatorType = ExpressionOperatorType.LessThanOrEqual
+		}
+	}
+
+	var find func() []int
+
+	switch operatorType {
+	case ExpressionOperatorType.Equal, ExpressionOperatorType.EqualExactMatch:
+		find = func() []int { return index.lookup(key) }
+	default:
+		if index.indexType != DataIndexType.Sorted {
+			return nil, false, nil
+		}
+
+		switch operatorType {
+		case ExpressionOperatorType.LessThan:
+			find = func() []int { return index.rangeRows(nil, false, key, false) }
+		case ExpressionOperatorType.LessThanOrEqual:
+			find = func() []int { return index.rangeRows(nil, false, key, true) }
+		case ExpressionOperatorType.GreaterThan:
+			find = func() []int { return index.rangeRows(key, false, nil, false) }
+		default:
+			find = func() []int { return index.rangeRows(key, true, nil, false) }
+		}
+	}
+
+	rows, err := index.rows(find)
+	return rows, err == nil, err
+}
+
+// columnEqualRows gets the sorted indexes of the table rows that can have a column value equal to the
+// specified value using any index defined for the column. Returns false when no index can be used.
+func columnEqualRows(table *DataTable, column *DataColumn, value *ValueExpression) ([]int, bool) {
+	index := tableColumnIndex(table, NewColumnExpression(column))
+
+	if index == nil {
+		return nil, false
+	}
+
+	key, ok := index.indexValue(value, ExpressionOperatorType.Equal, true)
+
+	if !ok {
+		return nil, false
+	}
+
+	rows, err := index.rows(func() []int { return index.lookup(key) })
+	return rows, err == nil
+}
+
+// tableColumnIndex gets the DataIndex for an expression that references an indexed column of the table;
+// otherwise, nil is returned.
+func tableColumnIndex(table *DataTable, expression Expression) *DataIndex {
+	if expression.Type() != ExpressionType.Column {
+		return nil
+	}
+
+	columnExpression := expression.(*ColumnExpression)
+	column := columnExpression.DataColumn()
+
+	if column == nil || len(columnExpression.Relations()) > 0 {
+		return nil
+	}
+
+	// Column can be from another table with the same schema, e.g., for a CompiledFilter
+	index := table.IndexByName(column.Name())
+
+	if index == nil || index.column.Index() != column.Index() || index.column.Type() != column.Type() {
+		return nil
+	}
+
+	return index
+}
+
+// dataIndexKey gets the index key of a non-null value. Keys are ordered the same as the values
+// are ordered by the filter expression comparison operators.
+func dataIndexKey(value *ValueExpression) interface{} {
+	switch value.ValueType() {
+	case ExpressionValueType.Boolean:
+		return value.booleanValueAsInt()
+	case ExpressionValueType.String:
+		return strings.ToUpper(value.stringValue())
+	case ExpressionValueType.DateTime:
+		return value.dateTimeValue()
+	default:
+		return value.Value()
+	}
+}
+
+// dataIndexHashKey gets a comparable representation of an index key for use as a map key.
+func dataIndexHashKey(key interface{}) interface{} {
+	switch value := key.(type) {
+	case decimal.Decimal:
+		return value.String()
+	case time.Time:
+		// Time values for the same instant can differ by location, so key on Unix time
+		return [2]int64{value.Unix(), int64(value.Nanosecond())}
+	default:
+		return key
+	}
+}
+
+func compareDataIndexKeys(left, right interface{}) int {
+	switch leftKey := left.(type) {
+	case int:
+		return compareOrdered(leftKey, right.(int))
+	case int32:
+		return compareOrdered(leftKey, right.(int32))
+	case int64:
+		return compareOrdered(leftKey, right.(int64))
+	case float64:
+		return compareOrdered(leftKey, right.(float64))
+	case string:
+		return strings.Compare(leftKey, right.(string))
+	case decimal.Decimal:
+		return leftKey.Cmp(right.(decimal.Decimal))
+	case guid.Guid:
+		return guid.Compare(leftKey, right.(guid.Guid))
+	case time.Time:
+		return leftKey.Compare(right.(time.Time))
+	default:
+		return 0
+	}
+}
+
+func compareOrdered[T int | int32 | int64 | float64](left, right T) int {
+	if left < right {
+		return -1
+	}
+
+	if left > right {
+		return 1
+	}
+
+	return 0
+}
+
+// intersectRows gets the row indexes common to both sorted row index slices.
+func intersectRows(left, right []int) []int {
+	rows := make([]int, 0, min(len(left), len(right)))
+
+	for i, j := 0, 0; i < len(left) && j < len(right); {
+		switch {
+		case left[i] < right[j]:
+			i++
+		case left[i] > right[j]:
+			j++
+		default:
+			rows = append(rows, left[i])
+			i++
+			j++
+		}
+	}
+
+	return rows
+}
+
+// unionRows gets the distinct row indexes of both sorted row index slices.
+func unionRows(left, right []int) []int {
+	rows := make([]int, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		switch {
+		case left[i] < right[j]:
+			rows = append(rows, left[i])
+			i++
+		case left[i] > right[j]:
+			rows = append(rows, right[j])
+			j++
+		default:
+			rows = append(rows, left[i])
+			i++
+			j++
+		}
+	}
+
+	rows = append(rows, left[i:]...)
+	return append(rows, right[j:]...)
+}
@@ -0,0 +1,239 @@
+//******************************************************************************************************
+//  DataIndex_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+func createIndexedDataSet() (*DataSet, *DataTable) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("ActiveMeasurements")
+
+	signalIDField := createDataColumn(dataTable, "SignalID", DataType.Guid)
+	idField := createDataColumn(dataTable, "ID", DataType.UInt64)
+	pointTagField := createDataColumn(dataTable, "PointTag", DataType.String)
+	signalTypeField := createDataColumn(dataTable, "SignalType", DataType.String)
+	valueField := createDataColumn(dataTable, "Value", DataType.Double)
+	updatedOnField := createDataColumn(dataTable, "UpdatedOn", DataType.DateTime)
+
+	signalTypes := []string{"FREQ", "DFDT", "VPHM", "VPHA", "STAT"}
+
+	for i := 0; i < 500; i++ {
+		row := dataTable.CreateRow()
+		row.SetValue(signalIDField, guid.New())
+		row.SetValue(idField, uint64(i))
+		row.SetValue(pointTagField, "GPA_TEST-"+strconv.Itoa(i))
+		row.SetValue(valueField, float64(i%50)/2.0)
+
+		// Include dates outside of the range representable by Unix nanoseconds
+		switch i % 100 {
+		case 0:
+			row.SetValue(updatedOnField, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+		case 1:
+			row.SetValue(updatedOnField, time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC))
+		default:
+			row.SetValue(updatedOnField, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, i))
+		}
+
+		// Leave some signal types null
+		if i%7 != 0 {
+			row.SetValue(signalTypeField, signalTypes[i%len(signalTypes)])
+		}
+
+		dataTable.AddRow(row)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	return dataSet, dataTable
+}
+
+func selectRowIDs(t *testing.T, dataTable *DataTable, filterExpression string, sortOrder string, limit int) []uint64 {
+	rows, err := dataTable.Select(filterExpression, sortOrder, limit)
+
+	if err != nil {
+		t.Fatal("TestDataIndexSelect: error executing select for \"" + filterExpression + "\": " + err.Error())
+	}
+
+	ids := make([]uint64, len(rows))
+
+	for i, row := range rows {
+		ids[i], _, _ = row.UInt64ValueByName("ID")
+	}
+
+	return ids
+}
+
+func TestDataIndexSelect(t *testing.T) {
+	_, dataTable := createIndexedDataSet()
+
+	filterExpressions := []string{
+		"SignalType = 'freq'",
+		"SignalType === 'FREQ'",
+		"'VPHM' = SignalType",
+		"SignalType IN ('FREQ', 'dfdt', NULL)",
+		"SignalType = NULL",
+		"Value = 5",
+		"Value >= 10 AND Value < 12.5",
+		"12.5 > Value AND SignalType = 'VPHA'",
+		"ID <= 3 OR ID > 495",
+		"ID = '42' OR PointTag = 'gpa_test-43'",
+		"SignalType = 'STAT' AND Len(PointTag) > 11",
+		"SignalType = 'STAT' OR Len(PointTag) > 11",
+		"Value > 10.25 OR SignalType IS NULL",
+		"SignalType NOT IN ('FREQ', 'DFDT')",
+		"Value = 1.5 AND ID = 1.5",
+		"Value BETWEEN 10 AND 12.5",
+		"ID NOT BETWEEN 3 AND 495 AND SignalType BETWEEN 'A' AND 'G'",
+		"UpdatedOn > #2021-01-01#",
+		"UpdatedOn < #2020-02-01#",
+		"UpdatedOn = #9999-12-31# OR UpdatedOn = #1600-01-01#",
+		"UpdatedOn BETWEEN #1500-01-01# AND #2020-01-10#",
+	}
+
+	// Evaluate each filter expression with a full scan
+	expected := make([][]uint64, len(filterExpressions))
+
+	for i, filterExpression := range filterExpressions {
+		expected[i] = selectRowIDs(t, dataTable, filterExpression, "", -1)
+	}
+
+	if _, err := dataTable.CreateIndex("SignalType", DataIndexType.Hash); err != nil {
+		t.Fatal("TestDataIndexSelect: error creating index: " + err.Error())
+	}
+
+	if _, err := dataTable.CreateIndex("value", DataIndexType.Sorted); err != nil {
+		t.Fatal("TestDataIndexSelect: error creating index: " + err.Error())
+	}
+
+	if _, err := dataTable.CreateIndex("ID", DataIndexType.Sorted); err != nil {
+		t.Fatal("TestDataIndexSelect: error creating index: " + err.Error())
+	}
+
+	if _, err := dataTable.CreateIndex("PointTag", DataIndexType.Hash); err != nil {
+		t.Fatal("TestDataIndexSelect: error creating index: " + err.Error())
+	}
+
+	if _, err := dataTable.CreateIndex("UpdatedOn", DataIndexType.Sorted); err != nil {
+		t.Fatal("TestDataIndexSelect: error creating index: " + err.Error())
+	}
+
+	if len(dataTable.Indexes()) != 5 || dataTable.IndexByName("VALUE").Type() != DataIndexType.Sorted {
+		t.Fatal("TestDataIndexSelect: unexpected indexes defined for table")
+	}
+
+	for i, filterExpression := range filterExpressions {
+		actual := selectRowIDs(t, dataTable, filterExpression, "", -1)
+
+		if len(actual) != len(expected[i]) {
+			t.Fatal("TestDataIndexSelect: expected " + strconv.Itoa(len(expected[i])) + " rows for \"" + filterExpression + "\", received: " + strconv.Itoa(len(actual)))
+		}
+
+		for j := range actual {
+			if actual[j] != expected[i][j] {
+				t.Fatal("TestDataIndexSelect: unexpected row order for \"" + filterExpression + "\"")
+			}
+		}
+	}
+
+	// Limit should apply to indexed rows in natural order
+	ids := selectRowIDs(t, dataTable, "Value < 1", "", 3)
+
+	if len(ids) != 3 || ids[0] != 0 || ids[1] != 1 || ids[2] != 50 {
+		t.Fatal("TestDataIndexSelect: unexpected limited indexed rows")
+	}
+
+	ids = selectRowIDs(t, dataTable, "Value < 1", "ID DESC", 2)
+
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 0 {
+		t.Fatal("TestDataIndexSelect: unexpected sorted indexed rows")
+	}
+}
+
+func TestDataIndexUpdate(t *testing.T) {
+	dataSet, dataTable := createIndexedDataSet()
+
+	if _, err := dataTable.CreateIndex("PointTag", DataIndexType.Hash); err != nil {
+		t.Fatal("TestDataIndexUpdate: error creating index: " + err.Error())
+	}
+
+	if _, err := dataTable.CreateIndex("SignalID", DataIndexType.Sorted); err != nil {
+		t.Fatal("TestDataIndexUpdate: error creating index: " + err.Error())
+	}
+
+	if ids := selectRowIDs(t, dataTable, "PointTag = 'GPA_TEST-10'", "", -1); len(ids) != 1 || ids[0] != 10 {
+		t.Fatal("TestDataIndexUpdate: expected indexed row 10")
+	}
+
+	// Modified and added rows should be reflected by index
+	dataTable.Row(10).SetValueByName("PointTag", "GPA_TEST-RENAMED")
+
+	row := dataTable.CreateRow()
+	row.SetValueByName("ID", uint64(1000))
+	row.SetValueByName("PointTag", "GPA_TEST-10")
+	dataTable.AddRow(row)
+
+	if ids := selectRowIDs(t, dataTable, "PointTag = 'GPA_TEST-10' OR PointTag = 'GPA_TEST-RENAMED'", "", -1); len(ids) != 2 || ids[0] != 10 || ids[1] != 1000 {
+		t.Fatal("TestDataIndexUpdate: expected updated index rows")
+	}
+
+	// Identifier statements should resolve using indexes
+	signalID, _, _ := dataTable.Row(20).GuidValueByName("SignalID")
+
+	rows, err := SelectDataRows(dataSet, "\"GPA_TEST-RENAMED\"; \"GPA_TEST-30\"; "+signalID.String(), "ActiveMeasurements", nil, true)
+
+	if err != nil {
+		t.Fatal("TestDataIndexUpdate: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(rows) != 3 || rows[0] != dataTable.Row(10) || rows[1] != dataTable.Row(30) || rows[2] != dataTable.Row(20) {
+		t.Fatal("TestDataIndexUpdate: unexpected identifier statement rows")
+	}
+
+	// Hashed date times should match the same instant in any time zone
+	if _, err := dataTable.CreateIndex("UpdatedOn", DataIndexType.Hash); err != nil {
+		t.Fatal("TestDataIndexUpdate: error creating index: " + err.Error())
+	}
+
+	if ids := selectRowIDs(t, dataTable, "UpdatedOn = #2020-01-03T02:00:00+02:00#", "", -1); len(ids) != 1 || ids[0] != 2 {
+		t.Fatal("TestDataIndexUpdate: expected indexed date time row 2")
+	}
+
+	if ids := selectRowIDs(t, dataTable, "UpdatedOn = #9999-12-31#", "", -1); len(ids) != 5 || ids[4] != 400 {
+		t.Fatal("TestDataIndexUpdate: expected 5 indexed maximum date time rows, received: " + strconv.Itoa(len(ids)))
+	}
+
+	if !dataTable.RemoveIndex("pointtag") || dataTable.RemoveIndex("PointTag") || dataTable.IndexByName("PointTag") != nil {
+		t.Fatal("TestDataIndexUpdate: expected index to be removed")
+	}
+
+	if _, err := dataTable.CreateIndex("Undefined", DataIndexType.Hash); err == nil {
+		t.Fatal("TestDataIndexUpdate: expected error for undefined column")
+	}
+}
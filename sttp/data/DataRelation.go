@@ -0,0 +1,216 @@
+//******************************************************************************************************
+//  DataRelation.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// DataRelation represents a parent/child relationship between two DataTable objects where the values
+// of a child table column, i.e., a foreign key, reference the values of a key column in a parent table.
+// Filter expressions use relations to access columns of related tables with a column path that is
+// prefixed by the relation name, e.g., "Device.CompanyAcronym". Key lookups are case-insensitive.
+type DataRelation struct {
+	name         string
+	childColumn  *DataColumn
+	parentColumn *DataColumn
+
+	parentRows        map[string]*DataRow
+	parentRowsVersion int
+	parentRowsLock    sync.Mutex
+}
+
+// NewDataRelation creates a new DataRelation with the specified name between the childColumn, i.e.,
+// the foreign key, and the parentColumn, i.e., the referenced key. An error will be returned if the
+// name is empty or either column is nil or not associated with a DataTable.
+func NewDataRelation(name string, childColumn *DataColumn, parentColumn *DataColumn) (*DataRelation, error) {
+	if len(name) == 0 {
+		return nil, errors.New("cannot create data relation, name is empty")
+	}
+
+	if childColumn == nil || childColumn.Parent() == nil {
+		return nil, errors.New("cannot create data relation \"" + name + "\", child column is not defined")
+	}
+
+	if parentColumn == nil || parentColumn.Parent() == nil {
+		return nil, errors.New("cannot create data relation \"" + name + "\", parent column is not defined")
+	}
+
+	return &DataRelation{
+		name:         name,
+		childColumn:  childColumn,
+		parentColumn: parentColumn,
+	}, nil
+}
+
+// Name gets the name of the DataRelation.
+func (dr *DataRelation) Name() string {
+	return dr.name
+}
+
+// ChildColumn gets the child column, i.e., the foreign key, of the DataRelation.
+func (dr *DataRelation) ChildColumn() *DataColumn {
+	return dr.childColumn
+}
+
+// ParentColumn gets the parent column, i.e., the referenced key, of the DataRelation.
+func (dr *DataRelation) ParentColumn() *DataColumn {
+	return dr.parentColumn
+}
+
+// ChildTable gets the child DataTable of the DataRelation.
+func (dr *DataRelation) ChildTable() *DataTable {
+	return dr.childColumn.Parent()
+}
+
+// ParentTable gets the parent DataTable of the DataRelation.
+func (dr *DataRelation) ParentTable() *DataTable {
+	return dr.parentColumn.Parent()
+}
+
+// String gets a representation of the DataRelation as a string.
+func (dr *DataRelation) String() string {
+	return fmt.Sprintf("%s [%s.%s -> %s.%s]", dr.name, dr.ChildTable().Name(), dr.childColumn.Name(), dr.ParentTable().Name(), dr.parentColumn.Name())
+}
+
+// ParentRow gets the parent DataRow referenced by the childRow, or nil if the child column
+// value is null or no parent row has a matching key value. When multiple parent rows have
+// the same key value, the first row is returned. An error will be returned if childRow is
+// nil or is not a row of the child table.
+func (dr *DataRelation) ParentRow(childRow *DataRow) (*DataRow, error) {
+	if childRow == nil {
+		return nil, errors.New("cannot get parent row for data relation \"" + dr.name + "\", child row is nil")
+	}
+
+	if childRow.Parent() != dr.ChildTable() {
+		return nil, errors.New("cannot get parent row for data relation \"" + dr.name + "\", row is not from child table \"" + dr.ChildTable().Name() + "\"")
+	}
+
+	value, err := childRow.Value(dr.childColumn.Index())
+
+	if err != nil {
+		return nil, errors.New("failed while getting data relation \"" + dr.name + "\" child column value: " + err.Error())
+	}
+
+	key, ok := relationKey(value)
+
+	if !ok {
+		return nil, nil
+	}
+
+	dr.parentRowsLock.Lock()
+	defer dr.parentRowsLock.Unlock()
+
+	parentTable := dr.ParentTable()
+
+	// Parent row index is rebuilt when parent table rows, or their values, change
+	if dr.parentRows == nil || dr.parentRowsVersion != parentTable.rowsVersion {
+		dr.parentRowsVersion = parentTable.rowsVersion
+		dr.parentRows = make(map[string]*DataRow, parentTable.RowCount())
+
+		for i := 0; i < parentTable.RowCount(); i++ {
+			parentRow := parentTable.Row(i)
+
+			if parentRow == nil {
+				continue
+			}
+
+			parentValue, err := parentRow.Value(dr.parentColumn.Index())
+
+			if err != nil {
+				dr.parentRows = nil
+				return nil, errors.New("failed while getting data relation \"" + dr.name + "\" parent column value: " + err.Error())
+			}
+
+			if parentKey, ok := relationKey(parentValue); ok {
+				if _, exists := dr.parentRows[parentKey]; !exists {
+					dr.parentRows[parentKey] = parentRow
+				}
+			}
+		}
+	}
+
+	return dr.parentRows[key], nil
+}
+
+// relationKey gets a normalized key for a relation column value so that keys of different,
+// but compatible, column types will match. Returns false if value is nil.
+func relationKey(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return strings.ToUpper(v), true
+	case guid.Guid:
+		return v.String(), true
+	case int8:
+		return strconv.FormatInt(int64(v), 10), true
+	case int16:
+		return strconv.FormatInt(int64(v), 10), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), true
+	case uint64:
+		return strconv.FormatUint(v, 10), true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// DataRelationFields represents the table and column names that define a DataRelation.
+type DataRelationFields struct {
+	// Name defines the name of the relation used as the column path prefix, e.g., "Device".
+	Name string
+	// ChildTableName defines the name of the table that contains the foreign key column.
+	ChildTableName string
+	// ChildColumnName defines the name of the foreign key column.
+	ChildColumnName string
+	// ParentTableName defines the name of the table that contains the referenced key column.
+	ParentTableName string
+	// ParentColumnName defines the name of the referenced key column.
+	ParentColumnName string
+}
+
+// DefaultDataRelations defines the common relations between standard STTP metadata tables. These relations
+// are available to filter expressions when a DataSet does not define a relation with the same name, e.g.:
+// FILTER MeasurementDetail WHERE Device.CompanyAcronym = 'GPA'
+var DefaultDataRelations = []*DataRelationFields{
+	{Name: "Device", ChildTableName: "ActiveMeasurements", ChildColumnName: "Device", ParentTableName: "DeviceDetail", ParentColumnName: "Acronym"},
+	{Name: "Device", ChildTableName: "MeasurementDetail", ChildColumnName: "DeviceAcronym", ParentTableName: "DeviceDetail", ParentColumnName: "Acronym"},
+	{Name: "Device", ChildTableName: "PhasorDetail", ChildColumnName: "DeviceAcronym", ParentTableName: "DeviceDetail", ParentColumnName: "Acronym"},
+	{Name: "Parent", ChildTableName: "DeviceDetail", ChildColumnName: "ParentAcronym", ParentTableName: "DeviceDetail", ParentColumnName: "Acronym"},
+}
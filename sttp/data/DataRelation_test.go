@@ -0,0 +1,239 @@
+//******************************************************************************************************
+//  DataRelation_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func TestDefaultDataRelations(t *testing.T) {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("TestDefaultDataRelations: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("TestDefaultDataRelations: error loading DataSet from XML document: " + err.Error())
+	}
+
+	expected, err := SelectDataRows(dataSet, "FILTER MeasurementDetail WHERE DeviceAcronym = 'SHELBY'", "MeasurementDetail", nil, true)
+
+	if err != nil {
+		t.Fatal("TestDefaultDataRelations: error executing SelectDataRows: " + err.Error())
+	}
+
+	rows, err := SelectDataRows(dataSet, "FILTER MeasurementDetail WHERE Device.CompanyAcronym = 'tva' AND Device.Acronym = DeviceAcronym", "MeasurementDetail", nil, true)
+
+	if err != nil {
+		t.Fatal("TestDefaultDataRelations: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(expected) == 0 || len(rows) != len(expected) {
+		t.Fatal("TestDefaultDataRelations: expected " + strconv.Itoa(len(expected)) + " related rows, received: " + strconv.Itoa(len(rows)))
+	}
+
+	// Measurements without a related device have Null related column values
+	unrelated, err := SelectDataRows(dataSet, "FILTER MeasurementDetail WHERE Device.Acronym IS NULL", "MeasurementDetail", nil, true)
+
+	if err != nil {
+		t.Fatal("TestDefaultDataRelations: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(unrelated)+len(rows) != dataSet.Table("MeasurementDetail").RowCount() {
+		t.Fatal("TestDefaultDataRelations: expected unrelated rows to be the remaining rows, received: " + strconv.Itoa(len(unrelated)))
+	}
+
+	result, err := SelectDataTable(dataSet, "SELECT Device.Name AS DeviceName, COUNT(*) AS Total FROM PhasorDetail GROUP BY DeviceAcronym", true)
+
+	if err != nil {
+		t.Fatal("TestDefaultDataRelations: error executing SelectDataTable: " + err.Error())
+	}
+
+	if result.RowCount() == 0 || result.Row(0).ValueAsStringByName("DeviceName") != "Shelby" {
+		t.Fatal("TestDefaultDataRelations: unexpected related select result: " + result.String())
+	}
+
+	// Default relations are added to the DataSet when first requested
+	relation := dataSet.Relation("MeasurementDetail", "Device")
+
+	if relation == nil || dataSet.Relation("measurementdetail", "DEVICE") != relation {
+		t.Fatal("TestDefaultDataRelations: expected same default relation for each lookup")
+	}
+
+	// Replacing a related table removes its relations so default relations are rebuilt
+	deviceTable := dataSet.Table("DeviceDetail")
+	dataSet.AddTable(deviceTable)
+
+	if dataSet.Relation("MeasurementDetail", "Device") != relation {
+		t.Fatal("TestDefaultDataRelations: expected default relation to remain when table is added again")
+	}
+
+	replacementTable := dataSet.CreateTable(deviceTable.Name())
+	replacementTable.AddColumn(replacementTable.CreateColumn("Acronym", DataType.String, ""))
+	dataSet.AddTable(replacementTable)
+
+	if relation = dataSet.Relation("MeasurementDetail", "Device"); relation == nil || relation.ParentTable() != replacementTable {
+		t.Fatal("TestDefaultDataRelations: expected default relation to reference replacement table")
+	}
+}
+
+func TestDataRelationPaths(t *testing.T) {
+	dataSet := NewDataSet()
+
+	companyTable := dataSet.CreateTable("Company")
+	companyIDField := createDataColumn(companyTable, "ID", DataType.Int32)
+	companyNameField := createDataColumn(companyTable, "Name", DataType.String)
+
+	deviceTable := dataSet.CreateTable("Device")
+	deviceAcronymField := createDataColumn(deviceTable, "Acronym", DataType.String)
+	deviceCompanyIDField := createDataColumn(deviceTable, "CompanyID", DataType.Int64)
+
+	measurementTable := dataSet.CreateTable("Measurement")
+	measurementTagField := createDataColumn(measurementTable, "PointTag", DataType.String)
+	measurementDeviceField := createDataColumn(measurementTable, "Device", DataType.String)
+
+	for _, company := range []struct {
+		id   int32
+		name string
+	}{{1, "GPA"}, {2, "TVA"}} {
+		row := companyTable.CreateRow()
+		row.SetValue(companyIDField, company.id)
+		row.SetValue(companyNameField, company.name)
+		companyTable.AddRow(row)
+	}
+
+	for _, device := range []struct {
+		acronym   string
+		companyID interface{}
+	}{{"SHELBY", int64(2)}, {"TESTDEVICE", int64(1)}, {"ORPHAN", nil}} {
+		row := deviceTable.CreateRow()
+		row.SetValue(deviceAcronymField, device.acronym)
+		row.SetValue(deviceCompanyIDField, device.companyID)
+		deviceTable.AddRow(row)
+	}
+
+	for _, measurement := range []struct {
+		pointTag string
+		device   string
+	}{{"SHELBY:FREQ", "shelby"}, {"TEST:FREQ", "TESTDEVICE"}, {"ORPHAN:FREQ", "ORPHAN"}, {"MISSING:FREQ", "MISSING"}} {
+		row := measurementTable.CreateRow()
+		row.SetValue(measurementTagField, measurement.pointTag)
+		row.SetValue(measurementDeviceField, measurement.device)
+		measurementTable.AddRow(row)
+	}
+
+	dataSet.AddTable(companyTable)
+	dataSet.AddTable(deviceTable)
+	dataSet.AddTable(measurementTable)
+
+	deviceRelation, err := NewDataRelation("Device", measurementTable.Column(measurementDeviceField), deviceTable.Column(deviceAcronymField))
+
+	if err != nil {
+		t.Fatal("TestDataRelationPaths: error creating data relation: " + err.Error())
+	}
+
+	companyRelation, err := NewDataRelation("Company", deviceTable.Column(deviceCompanyIDField), companyTable.Column(companyIDField))
+
+	if err != nil {
+		t.Fatal("TestDataRelationPaths: error creating data relation: " + err.Error())
+	}
+
+	dataSet.AddRelation(deviceRelation)
+	dataSet.AddRelation(companyRelation)
+
+	if len(dataSet.Relations()) != 2 || dataSet.Relation("measurement", "DEVICE") != deviceRelation {
+		t.Fatal("TestDataRelationPaths: unexpected relations in DataSet")
+	}
+
+	rows, err := SelectDataRows(dataSet, "FILTER Measurement WHERE Device.Company.Name = 'TVA' OR Device.[Company].Name = 'GPA' ORDER BY PointTag", "Measurement", nil, true)
+
+	if err != nil {
+		t.Fatal("TestDataRelationPaths: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(rows) != 2 || rows[0].ValueAsString(measurementTagField) != "SHELBY:FREQ" || rows[1].ValueAsString(measurementTagField) != "TEST:FREQ" {
+		t.Fatal("TestDataRelationPaths: expected 2 related measurements, received: " + strconv.Itoa(len(rows)))
+	}
+
+	if rows, err = SelectDataRows(dataSet, "FILTER Measurement WHERE Device.Company.Name IS NULL", "Measurement", nil, true); err != nil {
+		t.Fatal("TestDataRelationPaths: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(rows) != 2 {
+		t.Fatal("TestDataRelationPaths: expected 2 measurements without a related company, received: " + strconv.Itoa(len(rows)))
+	}
+
+	for _, filterExpression := range []string{
+		"FILTER Measurement WHERE Vendor.Name = 'ABB'",
+		"FILTER Measurement WHERE Device.Missing = 'ABB'",
+		"FILTER Company WHERE Device.Acronym = 'SHELBY'",
+	} {
+		if _, err := SelectDataRows(dataSet, filterExpression, "Measurement", nil, true); err == nil {
+			t.Fatal("TestDataRelationPaths: expected error for filter expression \"" + filterExpression + "\"")
+		}
+	}
+
+	// Replace company rows with the same row count, parent row index should be rebuilt
+	companyTable.InitRows(2)
+
+	for _, company := range []struct {
+		id   int32
+		name string
+	}{{1, "ABB"}, {2, "SEL"}} {
+		row := companyTable.CreateRow()
+		row.SetValue(companyIDField, company.id)
+		row.SetValue(companyNameField, company.name)
+		companyTable.AddRow(row)
+	}
+
+	if rows, err = SelectDataRows(dataSet, "FILTER Measurement WHERE Device.Company.Name = 'SEL'", "Measurement", nil, true); err != nil {
+		t.Fatal("TestDataRelationPaths: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(rows) != 1 || rows[0].ValueAsString(measurementTagField) != "SHELBY:FREQ" {
+		t.Fatal("TestDataRelationPaths: expected 1 measurement related to replaced company, received: " + strconv.Itoa(len(rows)))
+	}
+
+	// Update parent key value in place, parent row index should be rebuilt
+	companyTable.Row(0).SetValue(companyIDField, int32(2))
+	companyTable.Row(1).SetValue(companyIDField, int32(1))
+
+	if rows, err = SelectDataRows(dataSet, "FILTER Measurement WHERE Device.Company.Name = 'SEL'", "Measurement", nil, true); err != nil {
+		t.Fatal("TestDataRelationPaths: error executing SelectDataRows: " + err.Error())
+	}
+
+	if len(rows) != 1 || rows[0].ValueAsString(measurementTagField) != "TEST:FREQ" {
+		t.Fatal("TestDataRelationPaths: expected 1 measurement related to updated company, received: " + strconv.Itoa(len(rows)))
+	}
+
+	if !dataSet.RemoveTable("Company") || len(dataSet.Relations()) != 1 {
+		t.Fatal("TestDataRelationPaths: expected relations of removed table to be removed")
+	}
+}
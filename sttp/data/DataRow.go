@@ -124,8 +124,11 @@ func (dr *DataRow) getComputedValue(column *DataColumn) (interface{}, error) {
 		return nil, errors.New("failed to evaluate expression defined for computed DataColumn \"" + column.Name() + "\" for table \"" + dr.parent.Name() + "\": " + err.Error())
 	}
 
-	targetType := column.Type()
+	return convertValueExpression(sourceValue, column.Type())
+}
 
+// convertValueExpression converts the non-null sourceValue to the Go type of the targetType.
+func convertValueExpression(sourceValue *ValueExpression, targetType DataTypeEnum) (interface{}, error) {
 	switch sourceValue.ValueType() {
 	case ExpressionValueType.Boolean:
 		return convertFromBoolean(sourceValue.booleanValue(), targetType)
@@ -515,6 +518,7 @@ func (dr *DataRow) SetValue(columnIndex int, value interface{}) error {
 	}
 
 	dr.values[columnIndex] = value
+	dr.parent.rowsVersion++
 	return nil
 }
 
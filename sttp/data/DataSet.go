@@ -52,7 +52,8 @@ const (
 // Note that this implementation uses a case-insensitive map for DataTable name lookups.
 // Internally, case-insensitive lookups are accomplished using `strings.ToUpper`.
 type DataSet struct {
-	tables map[string]*DataTable
+	tables    map[string]*DataTable
+	relations map[string]*DataRelation
 
 	// Name defines the name of the DataSet.
 	Name string
@@ -61,14 +62,22 @@ type DataSet struct {
 // NewDataSet creates a new DataSet.
 func NewDataSet() *DataSet {
 	return &DataSet{
-		tables: make(map[string]*DataTable),
-		Name:   "DataSet",
+		tables:    make(map[string]*DataTable),
+		relations: make(map[string]*DataRelation),
+		Name:      "DataSet",
 	}
 }
 
-// AddTable adds the specified table to the DataSet.
+// AddTable adds the specified table to the DataSet. Any existing table with the same
+// name will be replaced along with any relations that reference the replaced table.
 func (ds *DataSet) AddTable(table *DataTable) {
-	ds.tables[strings.ToUpper(table.Name())] = table
+	tableName := strings.ToUpper(table.Name())
+
+	if existing, ok := ds.tables[tableName]; ok && existing != table {
+		ds.removeTableRelations(existing)
+	}
+
+	ds.tables[tableName] = table
 }
 
 // Table gets the DataTable for the specified tableName if the name exists;
@@ -120,14 +129,85 @@ func (ds *DataSet) TableCount() int {
 func (ds *DataSet) RemoveTable(tableName string) bool {
 	tableName = strings.ToUpper(tableName)
 
-	if _, ok := ds.tables[tableName]; ok {
+	if table, ok := ds.tables[tableName]; ok {
 		delete(ds.tables, tableName)
+		ds.removeTableRelations(table)
+
 		return true
 	}
 
 	return false
 }
 
+// removeTableRelations removes any relations that reference the specified table.
+func (ds *DataSet) removeTableRelations(table *DataTable) {
+	for key, relation := range ds.relations {
+		if relation.ChildTable() == table || relation.ParentTable() == table {
+			delete(ds.relations, key)
+		}
+	}
+}
+
+// AddRelation adds the specified relation to the DataSet. Any existing relation with the
+// same name for the same child table will be replaced.
+func (ds *DataSet) AddRelation(relation *DataRelation) {
+	if ds.relations == nil {
+		ds.relations = make(map[string]*DataRelation)
+	}
+
+	ds.relations[relationMapKey(relation.ChildTable().Name(), relation.Name())] = relation
+}
+
+// Relation gets the DataRelation with the specified relationName for the child tableName. When no relation
+// with the name has been added, a new relation is created from any matching DefaultDataRelations definition
+// when its tables and columns exist in the DataSet and is added to the DataSet so that later lookups return
+// the same relation; otherwise, nil is returned. Lookup is case-insensitive.
+func (ds *DataSet) Relation(tableName string, relationName string) *DataRelation {
+	if relation, ok := ds.relations[relationMapKey(tableName, relationName)]; ok {
+		return relation
+	}
+
+	for _, fields := range DefaultDataRelations {
+		if !strings.EqualFold(fields.ChildTableName, tableName) || !strings.EqualFold(fields.Name, relationName) {
+			continue
+		}
+
+		childTable := ds.Table(fields.ChildTableName)
+		parentTable := ds.Table(fields.ParentTableName)
+
+		if childTable == nil || parentTable == nil {
+			return nil
+		}
+
+		relation, err := NewDataRelation(fields.Name, childTable.ColumnByName(fields.ChildColumnName), parentTable.ColumnByName(fields.ParentColumnName))
+
+		if err != nil {
+			return nil
+		}
+
+		ds.AddRelation(relation)
+
+		return relation
+	}
+
+	return nil
+}
+
+// Relations gets the DataRelation instances added to the DataSet.
+func (ds *DataSet) Relations() []*DataRelation {
+	relations := make([]*DataRelation, 0, len(ds.relations))
+
+	for _, relation := range ds.relations {
+		relations = append(relations, relation)
+	}
+
+	return relations
+}
+
+func relationMapKey(tableName string, relationName string) string {
+	return strings.ToUpper(tableName) + "." + strings.ToUpper(relationName)
+}
+
 // String get a representation of the DataSet as a string.
 func (ds *DataSet) String() string {
 	var image strings.Builder
@@ -0,0 +1,517 @@
+//******************************************************************************************************
+//  DataSetDiff.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/format"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// DataSetDiff represents the differences between two DataSet snapshots, e.g., between
+// two metadata refreshes received from a data publisher.
+type DataSetDiff struct {
+	// AddedTables defines the tables that only exist in the new DataSet.
+	AddedTables []*DataTable
+
+	// RemovedTables defines the tables that only exist in the old DataSet.
+	RemovedTables []*DataTable
+
+	// ModifiedTables defines the changes for tables that exist in both DataSets.
+	// Tables with no schema or row changes are not included.
+	ModifiedTables []*DataTableDiff
+}
+
+// DataTableDiff represents the differences between two versions of a DataTable.
+type DataTableDiff struct {
+	// TableName is the name of the compared DataTable, taken from the new DataSet.
+	TableName string
+
+	// KeyColumns defines the column names used to match rows between the tables.
+	// When empty, rows were matched by comparing all common column values.
+	KeyColumns []string
+
+	// AddedColumns defines the columns that only exist in the new table.
+	AddedColumns []*DataColumn
+
+	// RemovedColumns defines the columns that only exist in the old table.
+	RemovedColumns []*DataColumn
+
+	// ModifiedColumns defines the columns whose data type or expression changed.
+	ModifiedColumns []*DataColumnDiff
+
+	// AddedRows defines the rows that only exist in the new table.
+	AddedRows []*DataRow
+
+	// RemovedRows defines the rows that only exist in the old table.
+	RemovedRows []*DataRow
+
+	// ModifiedRows defines the rows that exist in both tables with different values.
+	ModifiedRows []*DataRowDiff
+}
+
+// DataColumnDiff represents a schema change for a DataColumn that exists in both tables.
+type DataColumnDiff struct {
+	// ColumnName is the name of the changed column.
+	ColumnName string
+
+	// OldType is the column data type in the old table.
+	OldType DataTypeEnum
+
+	// NewType is the column data type in the new table.
+	NewType DataTypeEnum
+
+	// OldExpression is the computed column expression, if any, in the old table.
+	OldExpression string
+
+	// NewExpression is the computed column expression, if any, in the new table.
+	NewExpression string
+}
+
+// DataRowDiff represents the value changes for a DataRow that exists in both tables.
+type DataRowDiff struct {
+	// Key is the encoded key column values used to match the row, see KeyColumns. Each value
+	// is encoded as its string length, a colon and its string value, where Guid and String
+	// values are upper-cased since they are matched case-insensitively, and a null value is
+	// encoded as "N;", e.g., "6:SHELBY1:5" for key values "Shelby" and 5. Use OldRow or
+	// NewRow to read the key column values.
+	Key string
+
+	// OldRow is the row from the old table.
+	OldRow *DataRow
+
+	// NewRow is the row from the new table.
+	NewRow *DataRow
+
+	// Changes defines the before and after values for each changed column.
+	Changes []*DataValueDiff
+}
+
+// DataValueDiff represents a value change for a single column of a DataRow.
+type DataValueDiff struct {
+	// ColumnName is the name of the changed column.
+	ColumnName string
+
+	// OldValue is the column value in the old row; nil represents a null value.
+	OldValue interface{}
+
+	// NewValue is the column value in the new row; nil represents a null value.
+	NewValue interface{}
+}
+
+// Diff compares the old and new DataSet snapshots and returns the added, removed and modified
+// tables, columns and rows. The keyColumns parameter maps a table name to the column name used
+// to match rows between the old and new tables, e.g., "MeasurementDetail" to "SignalID"; a
+// composite key can be specified as a comma separated list of column names. Table name lookups
+// in keyColumns are case-insensitive. For tables without a key column mapping, rows are matched
+// when all common column values are equal, so changed rows are reported as a removal and an add.
+// Either DataSet can be nil which is treated as an empty DataSet.
+func Diff(oldDataSet, newDataSet *DataSet, keyColumns map[string]string) (*DataSetDiff, error) {
+	if oldDataSet == nil {
+		oldDataSet = NewDataSet()
+	}
+
+	if newDataSet == nil {
+		newDataSet = NewDataSet()
+	}
+
+	tableKeys := make(map[string][]string, len(keyColumns))
+
+	for tableName, columnNames := range keyColumns {
+		keys := make([]string, 0)
+
+		for _, columnName := range strings.Split(columnNames, ",") {
+			columnName = strings.TrimSpace(columnName)
+
+			if len(columnName) > 0 {
+				keys = append(keys, columnName)
+			}
+		}
+
+		tableKeys[strings.ToUpper(tableName)] = keys
+	}
+
+	diff := &DataSetDiff{
+		AddedTables:    make([]*DataTable, 0),
+		RemovedTables:  make([]*DataTable, 0),
+		ModifiedTables: make([]*DataTableDiff, 0),
+	}
+
+	for _, oldTable := range sortedTables(oldDataSet) {
+		if newDataSet.Table(oldTable.Name()) == nil {
+			diff.RemovedTables = append(diff.RemovedTables, oldTable)
+		}
+	}
+
+	for _, newTable := range sortedTables(newDataSet) {
+		oldTable := oldDataSet.Table(newTable.Name())
+
+		if oldTable == nil {
+			diff.AddedTables = append(diff.AddedTables, newTable)
+			continue
+		}
+
+		tableDiff, err := DiffTables(oldTable, newTable, tableKeys[strings.ToUpper(newTable.Name())])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !tableDiff.IsEmpty() {
+			diff.ModifiedTables = append(diff.ModifiedTables, tableDiff)
+		}
+	}
+
+	return diff, nil
+}
+
+// DiffTables compares the old and new versions of a DataTable using the specified key column
+// names to match rows. When no key column names are specified, rows are matched when all common
+// column values are equal. An error is returned if a key column does not exist in both tables.
+//
+//gocyclo:ignore
+func DiffTables(oldTable, newTable *DataTable, keyColumns []string) (*DataTableDiff, error) {
+	diff := &DataTableDiff{
+		TableName:       newTable.Name(),
+		KeyColumns:      keyColumns,
+		AddedColumns:    make([]*DataColumn, 0),
+		RemovedColumns:  make([]*DataColumn, 0),
+		ModifiedColumns: make([]*DataColumnDiff, 0),
+		AddedRows:       make([]*DataRow, 0),
+		RemovedRows:     make([]*DataRow, 0),
+		ModifiedRows:    make([]*DataRowDiff, 0),
+	}
+
+	// Compare table schemas
+	commonColumns := make([][2]*DataColumn, 0, newTable.ColumnCount())
+
+	for i := 0; i < oldTable.ColumnCount(); i++ {
+		oldColumn := oldTable.Column(i)
+
+		if newTable.ColumnByName(oldColumn.Name()) == nil {
+			diff.RemovedColumns = append(diff.RemovedColumns, oldColumn)
+		}
+	}
+
+	for i := 0; i < newTable.ColumnCount(); i++ {
+		newColumn := newTable.Column(i)
+		oldColumn := oldTable.ColumnByName(newColumn.Name())
+
+		if oldColumn == nil {
+			diff.AddedColumns = append(diff.AddedColumns, newColumn)
+			continue
+		}
+
+		if oldColumn.Type() != newColumn.Type() || oldColumn.Expression() != newColumn.Expression() {
+			diff.ModifiedColumns = append(diff.ModifiedColumns, &DataColumnDiff{
+				ColumnName:    newColumn.Name(),
+				OldType:       oldColumn.Type(),
+				NewType:       newColumn.Type(),
+				OldExpression: oldColumn.Expression(),
+				NewExpression: newColumn.Expression(),
+			})
+		}
+
+		commonColumns = append(commonColumns, [2]*DataColumn{oldColumn, newColumn})
+	}
+
+	// Lookup key columns
+	oldKeys := make([]*DataColumn, 0, len(keyColumns))
+	newKeys := make([]*DataColumn, 0, len(keyColumns))
+
+	for _, columnName := range keyColumns {
+		oldColumn := oldTable.ColumnByName(columnName)
+		newColumn := newTable.ColumnByName(columnName)
+
+		if oldColumn == nil || newColumn == nil {
+			return nil, errors.New("key column \"" + columnName + "\" was not found in both versions of table \"" + newTable.Name() + "\"")
+		}
+
+		oldKeys = append(oldKeys, oldColumn)
+		newKeys = append(newKeys, newColumn)
+	}
+
+	if len(keyColumns) == 0 {
+		// Without a key, all common column values define row identity
+		for _, columns := range commonColumns {
+			oldKeys = append(oldKeys, columns[0])
+			newKeys = append(newKeys, columns[1])
+		}
+	}
+
+	// Index old rows by key, duplicate keys are matched in row order
+	oldRows := make(map[string][]*DataRow, oldTable.RowCount())
+
+	for _, row := range oldTable.Rows() {
+		if row == nil {
+			continue
+		}
+
+		key := rowKey(row, oldKeys)
+		oldRows[key] = append(oldRows[key], row)
+	}
+
+	matched := make(map[*DataRow]bool, oldTable.RowCount())
+
+	for _, newRow := range newTable.Rows() {
+		if newRow == nil {
+			continue
+		}
+
+		key := rowKey(newRow, newKeys)
+		candidates := oldRows[key]
+
+		if len(candidates) == 0 {
+			diff.AddedRows = append(diff.AddedRows, newRow)
+			continue
+		}
+
+		oldRow := candidates[0]
+		oldRows[key] = candidates[1:]
+		matched[oldRow] = true
+
+		if len(keyColumns) == 0 {
+			continue
+		}
+
+		changes := make([]*DataValueDiff, 0)
+
+		for _, columns := range commonColumns {
+			oldValue, _ := oldRow.Value(columns[0].Index())
+			newValue, _ := newRow.Value(columns[1].Index())
+
+			var equal bool
+
+			if columns[0].Type() == columns[1].Type() {
+				equal = valuesEqual(oldValue, newValue)
+			} else {
+				equal = (oldValue == nil) == (newValue == nil) && oldRow.ColumnValueAsString(columns[0]) == newRow.ColumnValueAsString(columns[1])
+			}
+
+			if !equal {
+				changes = append(changes, &DataValueDiff{
+					ColumnName: columns[1].Name(),
+					OldValue:   oldValue,
+					NewValue:   newValue,
+				})
+			}
+		}
+
+		if len(changes) > 0 {
+			diff.ModifiedRows = append(diff.ModifiedRows, &DataRowDiff{
+				Key:     key,
+				OldRow:  oldRow,
+				NewRow:  newRow,
+				Changes: changes,
+			})
+		}
+	}
+
+	for _, row := range oldTable.Rows() {
+		if row != nil && !matched[row] {
+			diff.RemovedRows = append(diff.RemovedRows, row)
+		}
+	}
+
+	return diff, nil
+}
+
+// IsEmpty determines if the DataSetDiff contains no changes.
+func (dsd *DataSetDiff) IsEmpty() bool {
+	return len(dsd.AddedTables) == 0 && len(dsd.RemovedTables) == 0 && len(dsd.ModifiedTables) == 0
+}
+
+// Table gets the DataTableDiff for the specified tableName if the table was modified;
+// otherwise, nil is returned. Lookup is case-insensitive.
+func (dsd *DataSetDiff) Table(tableName string) *DataTableDiff {
+	for _, tableDiff := range dsd.ModifiedTables {
+		if strings.EqualFold(tableDiff.TableName, tableName) {
+			return tableDiff
+		}
+	}
+
+	return nil
+}
+
+// String gets a summary representation of the DataSetDiff as a string.
+func (dsd *DataSetDiff) String() string {
+	if dsd.IsEmpty() {
+		return "No changes"
+	}
+
+	var image strings.Builder
+
+	for _, table := range dsd.AddedTables {
+		image.WriteString("Added table ")
+		image.WriteString(table.Name())
+		image.WriteString(" with ")
+		image.WriteString(format.Int(table.RowCount()))
+		image.WriteString(" rows\n")
+	}
+
+	for _, table := range dsd.RemovedTables {
+		image.WriteString("Removed table ")
+		image.WriteString(table.Name())
+		image.WriteString(" with ")
+		image.WriteString(format.Int(table.RowCount()))
+		image.WriteString(" rows\n")
+	}
+
+	for _, tableDiff := range dsd.ModifiedTables {
+		image.WriteString(tableDiff.String())
+		image.WriteRune('\n')
+	}
+
+	return strings.TrimSuffix(image.String(), "\n")
+}
+
+// IsEmpty determines if the DataTableDiff contains no changes.
+func (dtd *DataTableDiff) IsEmpty() bool {
+	return !dtd.SchemaChanged() && len(dtd.AddedRows) == 0 && len(dtd.RemovedRows) == 0 && len(dtd.ModifiedRows) == 0
+}
+
+// SchemaChanged determines if any columns were added, removed or modified.
+func (dtd *DataTableDiff) SchemaChanged() bool {
+	return len(dtd.AddedColumns) > 0 || len(dtd.RemovedColumns) > 0 || len(dtd.ModifiedColumns) > 0
+}
+
+// String gets a summary representation of the DataTableDiff as a string.
+func (dtd *DataTableDiff) String() string {
+	var image strings.Builder
+
+	image.WriteString("Modified table ")
+	image.WriteString(dtd.TableName)
+	image.WriteString(": ")
+	image.WriteString(format.Int(len(dtd.AddedRows)))
+	image.WriteString(" rows added, ")
+	image.WriteString(format.Int(len(dtd.RemovedRows)))
+	image.WriteString(" rows removed, ")
+	image.WriteString(format.Int(len(dtd.ModifiedRows)))
+	image.WriteString(" rows modified")
+
+	for _, column := range dtd.AddedColumns {
+		image.WriteString("\n    Added column ")
+		image.WriteString(column.String())
+	}
+
+	for _, column := range dtd.RemovedColumns {
+		image.WriteString("\n    Removed column ")
+		image.WriteString(column.String())
+	}
+
+	for _, column := range dtd.ModifiedColumns {
+		image.WriteString("\n    Modified column ")
+		image.WriteString(column.String())
+	}
+
+	return image.String()
+}
+
+// String gets a representation of the DataColumnDiff as a string.
+func (dcd *DataColumnDiff) String() string {
+	var image strings.Builder
+
+	image.WriteString(dcd.ColumnName)
+	image.WriteString(" (")
+	image.WriteString(dcd.OldType.String())
+
+	if dcd.OldType != dcd.NewType {
+		image.WriteString(" -> ")
+		image.WriteString(dcd.NewType.String())
+	}
+
+	image.WriteRune(')')
+
+	if dcd.OldExpression != dcd.NewExpression {
+		image.WriteString(" expression \"")
+		image.WriteString(dcd.OldExpression)
+		image.WriteString("\" -> \"")
+		image.WriteString(dcd.NewExpression)
+		image.WriteRune('"')
+	}
+
+	return image.String()
+}
+
+func sortedTables(dataSet *DataSet) []*DataTable {
+	tables := dataSet.Tables()
+
+	sort.Slice(tables, func(i, j int) bool {
+		return strings.ToUpper(tables[i].Name()) < strings.ToUpper(tables[j].Name())
+	})
+
+	return tables
+}
+
+// rowKey encodes the key column values of a row so that distinct values always produce distinct keys,
+// i.e., each value is length-prefixed and null values use a separate marker.
+func rowKey(row *DataRow, keyColumns []*DataColumn) string {
+	var key strings.Builder
+
+	for _, column := range keyColumns {
+		if value, _ := row.Value(column.Index()); value == nil {
+			key.WriteString("N;")
+			continue
+		}
+
+		value := row.ColumnValueAsString(column)
+
+		// Guid and string keys are matched case-insensitively
+		if column.Type() == DataType.Guid || column.Type() == DataType.String {
+			value = strings.ToUpper(value)
+		}
+
+		key.WriteString(strconv.Itoa(len(value)))
+		key.WriteRune(':')
+		key.WriteString(value)
+	}
+
+	return key.String()
+}
+
+func valuesEqual(left, right interface{}) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+
+	switch leftValue := left.(type) {
+	case time.Time:
+		rightValue, ok := right.(time.Time)
+		return ok && leftValue.Equal(rightValue)
+	case decimal.Decimal:
+		rightValue, ok := right.(decimal.Decimal)
+		return ok && leftValue.Equal(rightValue)
+	case guid.Guid:
+		rightValue, ok := right.(guid.Guid)
+		return ok && leftValue.Equal(rightValue)
+	default:
+		return left == right
+	}
+}
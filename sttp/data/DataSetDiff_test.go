@@ -0,0 +1,226 @@
+//******************************************************************************************************
+//  DataSetDiff_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+func TestDiffIdenticalDataSets(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+
+	diff, err := Diff(dataSet, dataSet, map[string]string{"ActiveMeasurements": "SignalID"})
+
+	if err != nil {
+		t.Fatal("TestDiffIdenticalDataSets: unexpected error: " + err.Error())
+	}
+
+	if !diff.IsEmpty() {
+		t.Fatal("TestDiffIdenticalDataSets: expected no changes, received: " + diff.String())
+	}
+}
+
+func TestDiffModifiedRows(t *testing.T) {
+	oldDataSet, signalIDField, signalTypeField, statID, freqID := createDataSet()
+	newDataSet := NewDataSet()
+	newTable := newDataSet.CreateTable("ActiveMeasurements")
+
+	createDataColumn(newTable, "SignalID", DataType.Guid)
+	createDataColumn(newTable, "SignalType", DataType.String)
+	descriptionField := createDataColumn(newTable, "Description", DataType.String)
+
+	// Modified row
+	dataRow := newTable.CreateRow()
+	dataRow.SetValue(signalIDField, freqID)
+	dataRow.SetValue(signalTypeField, "DFDT")
+	newTable.AddRow(dataRow)
+
+	// Added row
+	addedID := guid.New()
+	dataRow = newTable.CreateRow()
+	dataRow.SetValue(signalIDField, addedID)
+	dataRow.SetValue(signalTypeField, "IPHM")
+	dataRow.SetValue(descriptionField, "Current Magnitude")
+	newTable.AddRow(dataRow)
+
+	newDataSet.AddTable(newTable)
+	newDataSet.AddTable(newDataSet.CreateTable("DeviceDetail"))
+
+	diff, err := Diff(oldDataSet, newDataSet, map[string]string{"activemeasurements": "SignalID"})
+
+	if err != nil {
+		t.Fatal("TestDiffModifiedRows: unexpected error: " + err.Error())
+	}
+
+	if len(diff.AddedTables) != 1 || diff.AddedTables[0].Name() != "DeviceDetail" {
+		t.Fatal("TestDiffModifiedRows: expected DeviceDetail table to be added")
+	}
+
+	tableDiff := diff.Table("ActiveMeasurements")
+
+	if tableDiff == nil {
+		t.Fatal("TestDiffModifiedRows: expected ActiveMeasurements table to be modified")
+	}
+
+	if len(tableDiff.AddedColumns) != 1 || tableDiff.AddedColumns[0].Name() != "Description" {
+		t.Fatal("TestDiffModifiedRows: expected Description column to be added")
+	}
+
+	if len(tableDiff.AddedRows) != 1 || !tableDiff.AddedRows[0].values[signalIDField].(guid.Guid).Equal(addedID) {
+		t.Fatal("TestDiffModifiedRows: expected 1 added row, received: " + strconv.Itoa(len(tableDiff.AddedRows)))
+	}
+
+	if len(tableDiff.RemovedRows) != 1 || !tableDiff.RemovedRows[0].values[signalIDField].(guid.Guid).Equal(statID) {
+		t.Fatal("TestDiffModifiedRows: expected 1 removed row, received: " + strconv.Itoa(len(tableDiff.RemovedRows)))
+	}
+
+	if len(tableDiff.ModifiedRows) != 1 {
+		t.Fatal("TestDiffModifiedRows: expected 1 modified row, received: " + strconv.Itoa(len(tableDiff.ModifiedRows)))
+	}
+
+	changes := tableDiff.ModifiedRows[0].Changes
+
+	if len(changes) != 1 || changes[0].ColumnName != "SignalType" || changes[0].OldValue != "FREQ" || changes[0].NewValue != "DFDT" {
+		t.Fatal("TestDiffModifiedRows: unexpected row changes")
+	}
+}
+
+func TestDiffRetypedColumn(t *testing.T) {
+	oldDataSet := NewDataSet()
+	oldTable := oldDataSet.CreateTable("SchemaVersion")
+	createDataColumn(oldTable, "VersionNumber", DataType.Int32)
+	oldDataSet.AddTable(oldTable)
+
+	newDataSet := NewDataSet()
+	newTable := newDataSet.CreateTable("SchemaVersion")
+	createDataColumn(newTable, "VersionNumber", DataType.Int64)
+	newDataSet.AddTable(newTable)
+
+	diff, err := Diff(oldDataSet, newDataSet, nil)
+
+	if err != nil {
+		t.Fatal("TestDiffRetypedColumn: unexpected error: " + err.Error())
+	}
+
+	tableDiff := diff.Table("SchemaVersion")
+
+	if tableDiff == nil || len(tableDiff.ModifiedColumns) != 1 {
+		t.Fatal("TestDiffRetypedColumn: expected 1 modified column")
+	}
+
+	columnDiff := tableDiff.ModifiedColumns[0]
+
+	if columnDiff.OldType != DataType.Int32 || columnDiff.NewType != DataType.Int64 {
+		t.Fatal("TestDiffRetypedColumn: unexpected column types: " + columnDiff.String())
+	}
+}
+
+func TestDiffCompositeKeyCollision(t *testing.T) {
+	createTable := func(rows ...[]interface{}) *DataTable {
+		dataSet := NewDataSet()
+		table := dataSet.CreateTable("Keys")
+		createDataColumn(table, "A", DataType.String)
+		createDataColumn(table, "B", DataType.String)
+
+		for _, values := range rows {
+			row := table.CreateRow()
+			row.SetValue(0, values[0])
+			row.SetValue(1, values[1])
+			table.AddRow(row)
+		}
+
+		dataSet.AddTable(table)
+		return table
+	}
+
+	// Rows only match when all key parts match, i.e., separators and nulls in values cannot collide
+	oldTable := createTable([]interface{}{"x,y", "z"}, []interface{}{nil, "n"})
+	newTable := createTable([]interface{}{"x", "y,z"}, []interface{}{"", "n"})
+
+	tableDiff, err := DiffTables(oldTable, newTable, []string{"A", "B"})
+
+	if err != nil {
+		t.Fatal("TestDiffCompositeKeyCollision: unexpected error: " + err.Error())
+	}
+
+	if len(tableDiff.AddedRows) != 2 || len(tableDiff.RemovedRows) != 2 || len(tableDiff.ModifiedRows) != 0 {
+		t.Fatalf("TestDiffCompositeKeyCollision: expected 2 added and 2 removed rows, received: %d added, %d removed, %d modified",
+			len(tableDiff.AddedRows), len(tableDiff.RemovedRows), len(tableDiff.ModifiedRows))
+	}
+
+	// Key is the length-prefixed, upper-cased encoding of the key column values
+	oldTable = createTable([]interface{}{"Shelby", "1"})
+	newTable = createTable([]interface{}{"shelby", "2"})
+
+	if tableDiff, err = DiffTables(oldTable, newTable, []string{"A"}); err != nil {
+		t.Fatal("TestDiffCompositeKeyCollision: unexpected error: " + err.Error())
+	}
+
+	if len(tableDiff.ModifiedRows) != 1 || tableDiff.ModifiedRows[0].Key != "6:SHELBY" {
+		t.Fatal("TestDiffCompositeKeyCollision: expected 1 modified row with key \"6:SHELBY\"")
+	}
+}
+
+func TestDiffInvalidKeyColumn(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+
+	if _, err := Diff(dataSet, dataSet, map[string]string{"ActiveMeasurements": "PointTag"}); err == nil {
+		t.Fatal("TestDiffInvalidKeyColumn: expected error for missing key column")
+	}
+}
+
+func TestDiffMetadataSamples(t *testing.T) {
+	oldXml, err := os.ReadFile("../../test/MetadataSample1.xml")
+
+	if err != nil {
+		t.Fatal("TestDiffMetadataSamples: failed to read metadata sample: " + err.Error())
+	}
+
+	newXml, err := os.ReadFile("../../test/MetadataSample2.xml")
+
+	if err != nil {
+		t.Fatal("TestDiffMetadataSamples: failed to read metadata sample: " + err.Error())
+	}
+
+	oldDataSet := FromXml(oldXml)
+	newDataSet := FromXml(newXml)
+
+	diff, err := Diff(oldDataSet, oldDataSet, map[string]string{"MeasurementDetail": "SignalID"})
+
+	if err != nil {
+		t.Fatal("TestDiffMetadataSamples: unexpected error: " + err.Error())
+	}
+
+	if !diff.IsEmpty() {
+		t.Fatal("TestDiffMetadataSamples: expected no changes comparing DataSet to itself")
+	}
+
+	if _, err = Diff(oldDataSet, newDataSet, map[string]string{"MeasurementDetail": "SignalID"}); err != nil {
+		t.Fatal("TestDiffMetadataSamples: unexpected error: " + err.Error())
+	}
+}
@@ -0,0 +1,333 @@
+//******************************************************************************************************
+//  DataSetJson.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// JSON serialization of a DataSet preserves the table schemas, i.e., column names, data types
+// and computed expressions, along with the row values. Rows are encoded as objects keyed by
+// column name. Values that have no lossless JSON representation, i.e., DateTime, Decimal, Guid
+// and non-finite floating-point values, are encoded as strings. Int64 and UInt64 values are also
+// encoded as strings since JSON readers commonly decode numbers as doubles, which can only exactly
+// represent integers up to 2^53. Example:
+//
+//	{
+//	  "name": "DataSet",
+//	  "tables": [{
+//	    "name": "ActiveMeasurements",
+//	    "columns": [
+//	      {"name": "SignalID", "type": "Guid"},
+//	      {"name": "SignalType", "type": "String"}
+//	    ],
+//	    "rows": [
+//	      {"SignalID": "8a4ef3a4-ec2d-4e96-a2b7-bd0ad21f5bb6", "SignalType": "FREQ"}
+//	    ]
+//	  }]
+//	}
+
+type jsonDataSet struct {
+	Name   string          `json:"name"`
+	Tables []jsonDataTable `json:"tables"`
+}
+
+type jsonDataTable struct {
+	Name    string            `json:"name"`
+	Columns []jsonDataColumn  `json:"columns"`
+	Rows    []json.RawMessage `json:"rows"`
+}
+
+type jsonDataColumn struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// MarshalJSON encodes the DataSet, including all table schemas and rows, as JSON.
+func (ds *DataSet) MarshalJSON() ([]byte, error) {
+	tables := sortedTables(ds)
+	jds := jsonDataSet{
+		Name:   ds.Name,
+		Tables: make([]jsonDataTable, 0, len(tables)),
+	}
+
+	for _, table := range tables {
+		jdt, err := table.toJson()
+
+		if err != nil {
+			return nil, err
+		}
+
+		jds.Tables = append(jds.Tables, jdt)
+	}
+
+	return json.Marshal(jds)
+}
+
+// UnmarshalJSON decodes the DataSet from JSON as encoded by MarshalJSON. Any existing
+// tables and relations in the DataSet will be replaced.
+func (ds *DataSet) UnmarshalJSON(data []byte) error {
+	var jds jsonDataSet
+
+	if err := json.Unmarshal(data, &jds); err != nil {
+		return errors.New("failed to parse DataSet JSON: " + err.Error())
+	}
+
+	ds.tables = make(map[string]*DataTable, len(jds.Tables))
+	ds.relations = make(map[string]*DataRelation)
+
+	if len(jds.Name) > 0 {
+		ds.Name = jds.Name
+	}
+
+	for _, jdt := range jds.Tables {
+		table := ds.CreateTable(jdt.Name)
+
+		if err := table.fromJson(jdt); err != nil {
+			return err
+		}
+
+		ds.AddTable(table)
+	}
+
+	return nil
+}
+
+// ParseJson loads the DataSet from the JSON in the specified buffer.
+func (ds *DataSet) ParseJson(data []byte) error {
+	return ds.UnmarshalJSON(data)
+}
+
+// FromJson creates a new DataSet as read from the JSON in the specified buffer.
+func FromJson(buffer []byte) (*DataSet, error) {
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseJson(buffer); err != nil {
+		return nil, err
+	}
+
+	return dataSet, nil
+}
+
+// MarshalJSON encodes the DataTable, including its schema and rows, as JSON.
+func (dt *DataTable) MarshalJSON() ([]byte, error) {
+	jdt, err := dt.toJson()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jdt)
+}
+
+// UnmarshalJSON decodes the DataTable from JSON as encoded by MarshalJSON. Any existing
+// columns and rows in the DataTable will be replaced. When the DataTable has no parent,
+// a new DataSet is created as the parent, but the DataTable is not added to it.
+func (dt *DataTable) UnmarshalJSON(data []byte) error {
+	var jdt jsonDataTable
+
+	if err := json.Unmarshal(data, &jdt); err != nil {
+		return errors.New("failed to parse DataTable JSON: " + err.Error())
+	}
+
+	if dt.parent == nil {
+		dt.parent = NewDataSet()
+	}
+
+	dt.name = jdt.Name
+
+	return dt.fromJson(jdt)
+}
+
+func (dt *DataTable) toJson() (jsonDataTable, error) {
+	jdt := jsonDataTable{
+		Name:    dt.name,
+		Columns: make([]jsonDataColumn, 0, len(dt.columns)),
+		Rows:    make([]json.RawMessage, 0, len(dt.rows)),
+	}
+
+	for _, column := range dt.columns {
+		jdt.Columns = append(jdt.Columns, jsonDataColumn{
+			Name:       column.Name(),
+			Type:       column.Type().String(),
+			Expression: column.Expression(),
+		})
+	}
+
+	for _, row := range dt.rows {
+		if row == nil {
+			continue
+		}
+
+		data, err := row.MarshalJSON()
+
+		if err != nil {
+			return jdt, err
+		}
+
+		jdt.Rows = append(jdt.Rows, data)
+	}
+
+	return jdt, nil
+}
+
+func (dt *DataTable) fromJson(jdt jsonDataTable) error {
+	dt.InitColumns(len(jdt.Columns))
+
+	for _, jdc := range jdt.Columns {
+		dataType, ok := ParseDataType(jdc.Type)
+
+		if !ok {
+			return errors.New("failed to parse DataTable JSON: column \"" + jdc.Name + "\" for table \"" + jdt.Name + "\" has unsupported data type \"" + jdc.Type + "\"")
+		}
+
+		dt.AddColumn(dt.CreateColumn(jdc.Name, dataType, jdc.Expression))
+	}
+
+	dt.InitRows(len(jdt.Rows))
+
+	for _, data := range jdt.Rows {
+		row := dt.CreateRow()
+
+		if err := row.UnmarshalJSON(data); err != nil {
+			return err
+		}
+
+		dt.AddRow(row)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the DataRow values as a JSON object keyed by column name.
+// Computed column values are evaluated and included in the encoded object.
+func (dr *DataRow) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('{')
+
+	for i, column := range dr.parent.columns {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		name, _ := json.Marshal(column.Name())
+		buffer.Write(name)
+		buffer.WriteByte(':')
+
+		value, err := dr.Value(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if value == nil {
+			buffer.WriteString("null")
+			continue
+		}
+
+		var encoded []byte
+
+		switch column.Type() {
+		case DataType.String, DataType.DateTime, DataType.Decimal, DataType.Guid, DataType.Int64, DataType.UInt64:
+			encoded, err = json.Marshal(formatDataValue(value))
+		case DataType.Single, DataType.Double:
+			if isFiniteDataValue(value) {
+				encoded = []byte(formatDataValue(value))
+			} else {
+				encoded, err = json.Marshal(formatDataValue(value))
+			}
+		default:
+			encoded = []byte(formatDataValue(value))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(encoded)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON decodes the DataRow values from a JSON object keyed by column name.
+// Column name matching is case-insensitive. Values for computed columns are ignored.
+func (dr *DataRow) UnmarshalJSON(data []byte) error {
+	if dr.parent == nil {
+		return errors.New("cannot decode DataRow JSON, row has no parent table: use DataTable.CreateRow")
+	}
+
+	var fields map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&fields); err != nil {
+		return errors.New("failed to parse DataRow JSON for table \"" + dr.parent.Name() + "\": " + err.Error())
+	}
+
+	dr.values = make([]interface{}, dr.parent.ColumnCount())
+
+	for name, field := range fields {
+		column := dr.parent.ColumnByName(name)
+
+		if column == nil || column.computed || field == nil {
+			continue
+		}
+
+		var value interface{}
+		var err error
+
+		switch typedField := field.(type) {
+		case bool:
+			if column.Type() == DataType.Boolean {
+				value = typedField
+			} else {
+				value, err = parseDataValue(formatDataValue(typedField), column.Type())
+			}
+		case json.Number:
+			value, err = parseDataValue(typedField.String(), column.Type())
+		case string:
+			value, err = parseDataValue(typedField, column.Type())
+		default:
+			err = errors.New("unsupported JSON value type")
+		}
+
+		if err != nil {
+			return errors.New("failed to parse DataRow JSON value for column \"" + column.Name() + "\" in table \"" + dr.parent.Name() + "\" as \"" + column.Type().String() + "\": " + strings.TrimPrefix(err.Error(), "strconv."))
+		}
+
+		dr.values[column.Index()] = value
+	}
+
+	dr.parent.rowsVersion++
+	return nil
+}
@@ -0,0 +1,355 @@
+//******************************************************************************************************
+//  DataSetJson_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDataSetJsonRoundTrip(t *testing.T) {
+	buffer, err := os.ReadFile("../../test/MetadataSample1.xml")
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonRoundTrip: failed to read metadata sample: " + err.Error())
+	}
+
+	source := FromXml(buffer)
+	encoded, err := json.Marshal(source)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonRoundTrip: failed to encode DataSet: " + err.Error())
+	}
+
+	target, err := FromJson(encoded)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonRoundTrip: failed to decode DataSet: " + err.Error())
+	}
+
+	if target.TableCount() != source.TableCount() {
+		t.Fatal("TestDataSetJsonRoundTrip: expected table count of " + strconv.Itoa(source.TableCount()) + ", received: " + strconv.Itoa(target.TableCount()))
+	}
+
+	diff, err := Diff(source, target, nil)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonRoundTrip: unexpected error comparing DataSets: " + err.Error())
+	}
+
+	if !diff.IsEmpty() {
+		t.Fatal("TestDataSetJsonRoundTrip: decoded DataSet does not match source: " + diff.String())
+	}
+}
+
+func TestDataSetJsonComputedColumn(t *testing.T) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Values")
+
+	valueField := createDataColumn(dataTable, "Value", DataType.Double)
+	dataTable.AddColumn(dataTable.CreateColumn("Doubled", DataType.Double, "Value * 2"))
+
+	dataRow := dataTable.CreateRow()
+	dataRow.SetValue(valueField, 1.5)
+	dataTable.AddRow(dataRow)
+
+	dataRow = dataTable.CreateRow()
+	dataRow.SetValue(valueField, math.NaN())
+	dataTable.AddRow(dataRow)
+
+	dataSet.AddTable(dataTable)
+
+	encoded, err := json.Marshal(dataSet)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonComputedColumn: failed to encode DataSet: " + err.Error())
+	}
+
+	if !strings.Contains(string(encoded), `"expression":"Value * 2"`) {
+		t.Fatal("TestDataSetJsonComputedColumn: expected computed column expression in JSON: " + string(encoded))
+	}
+
+	target, err := FromJson(encoded)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonComputedColumn: failed to decode DataSet: " + err.Error())
+	}
+
+	doubled, null, err := target.Table("Values").Row(0).DoubleValueByName("Doubled")
+
+	if err != nil || null || doubled != 3.0 {
+		t.Fatal("TestDataSetJsonComputedColumn: unexpected computed column value after decode")
+	}
+
+	value, null, err := target.Table("Values").Row(1).DoubleValue(valueField)
+
+	if err != nil || null || !math.IsNaN(value) {
+		t.Fatal("TestDataSetJsonComputedColumn: expected NaN value after decode")
+	}
+}
+
+func TestDataSetJsonLargeIntegers(t *testing.T) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Values")
+
+	signedField := createDataColumn(dataTable, "Signed", DataType.Int64)
+	unsignedField := createDataColumn(dataTable, "Unsigned", DataType.UInt64)
+
+	dataRow := dataTable.CreateRow()
+	dataRow.SetValue(signedField, int64(math.MinInt64))
+	dataRow.SetValue(unsignedField, uint64(math.MaxUint64))
+	dataTable.AddRow(dataRow)
+
+	dataSet.AddTable(dataTable)
+
+	encoded, err := json.Marshal(dataSet)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonLargeIntegers: failed to encode DataSet: " + err.Error())
+	}
+
+	// 64-bit integers are encoded as strings so readers decoding numbers as doubles do not lose precision
+	if !strings.Contains(string(encoded), `{"Signed":"-9223372036854775808","Unsigned":"18446744073709551615"}`) {
+		t.Fatal("TestDataSetJsonLargeIntegers: expected 64-bit integers encoded as strings in JSON: " + string(encoded))
+	}
+
+	target, err := FromJson(encoded)
+
+	if err != nil {
+		t.Fatal("TestDataSetJsonLargeIntegers: failed to decode DataSet: " + err.Error())
+	}
+
+	if signed, _, _ := target.Table("Values").Row(0).Int64Value(signedField); signed != math.MinInt64 {
+		t.Fatalf("TestDataSetJsonLargeIntegers: unexpected Signed value after decode: %d", signed)
+	}
+
+	if unsigned, _, _ := target.Table("Values").Row(0).UInt64Value(unsignedField); unsigned != math.MaxUint64 {
+		t.Fatalf("TestDataSetJsonLargeIntegers: unexpected Unsigned value after decode: %d", unsigned)
+	}
+
+	// 64-bit integers encoded as JSON numbers are still accepted
+	dataRow = dataTable.CreateRow()
+
+	if err := dataRow.UnmarshalJSON([]byte(`{"Signed":9007199254740993,"Unsigned":18446744073709551615}`)); err != nil {
+		t.Fatal("TestDataSetJsonLargeIntegers: failed to decode DataRow: " + err.Error())
+	}
+
+	if signed, _, _ := dataRow.Int64Value(signedField); signed != 9007199254740993 {
+		t.Fatalf("TestDataSetJsonLargeIntegers: unexpected Signed value after decode: %d", signed)
+	}
+}
+
+func TestDataTableCsvRoundTrip(t *testing.T) {
+	source, _, _, _, _ := createDataSet()
+	sourceTable := source.Table("ActiveMeasurements")
+
+	for _, includeTypes := range []bool{true, false} {
+		var buffer bytes.Buffer
+
+		if err := sourceTable.WriteCsv(&buffer, includeTypes); err != nil {
+			t.Fatal("TestDataTableCsvRoundTrip: failed to write CSV: " + err.Error())
+		}
+
+		target := NewDataSet()
+		targetTable, err := target.ParseCsv("ActiveMeasurements", &buffer)
+
+		if err != nil {
+			t.Fatal("TestDataTableCsvRoundTrip: failed to parse CSV: " + err.Error())
+		}
+
+		if targetTable.ColumnByName("SignalID").Type() != DataType.Guid {
+			t.Fatal("TestDataTableCsvRoundTrip: expected SignalID column type of Guid, received: " + targetTable.ColumnByName("SignalID").Type().String())
+		}
+
+		diff, err := Diff(source, target, map[string]string{"ActiveMeasurements": "SignalID"})
+
+		if err != nil {
+			t.Fatal("TestDataTableCsvRoundTrip: unexpected error comparing DataSets: " + err.Error())
+		}
+
+		if !diff.IsEmpty() {
+			t.Fatal("TestDataTableCsvRoundTrip: parsed table does not match source: " + diff.String())
+		}
+	}
+}
+
+func TestDataTableCsvNullAndEmptyString(t *testing.T) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Values")
+
+	idField := createDataColumn(dataTable, "ID", DataType.Int32)
+	nameField := createDataColumn(dataTable, "Name", DataType.String)
+	valueField := createDataColumn(dataTable, "Value", DataType.Double)
+
+	for i, name := range []interface{}{"", nil, "A", " B,\"C\"\n"} {
+		dataRow := dataTable.CreateRow()
+		dataRow.SetValue(idField, int32(i))
+		dataRow.SetValue(nameField, name)
+
+		// Leave some values null
+		if i > 1 {
+			dataRow.SetValue(valueField, float64(i)/2.0)
+		}
+
+		dataTable.AddRow(dataRow)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	for _, includeTypes := range []bool{true, false} {
+		var buffer bytes.Buffer
+
+		if err := dataTable.WriteCsv(&buffer, includeTypes); err != nil {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: failed to write CSV: " + err.Error())
+		}
+
+		if !strings.Contains(buffer.String(), "\n0,\"\",\n1,,\n") {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: expected empty string to be quoted and null to be empty: " + buffer.String())
+		}
+
+		target := NewDataSet()
+
+		if _, err := target.ParseCsv("Values", &buffer); err != nil {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: failed to parse CSV: " + err.Error())
+		}
+
+		diff, err := Diff(dataSet, target, map[string]string{"Values": "ID"})
+
+		if err != nil {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: unexpected error comparing DataSets: " + err.Error())
+		}
+
+		if !diff.IsEmpty() {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: parsed table does not match source: " + diff.String())
+		}
+
+		if name, null, _ := target.Table("Values").Row(0).StringValue(nameField); null || name != "" {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: expected empty string value")
+		}
+
+		if _, null, _ := target.Table("Values").Row(1).StringValue(nameField); !null {
+			t.Fatal("TestDataTableCsvNullAndEmptyString: expected null value")
+		}
+	}
+
+	// Quoted empty fields in non-string columns are null
+	parsedTable, err := NewDataSet().ParseCsv("Quoted", strings.NewReader("ID,Name\n\"1\",\"\"\n\"\",\"\"\n"))
+
+	if err != nil {
+		t.Fatal("TestDataTableCsvNullAndEmptyString: failed to parse CSV: " + err.Error())
+	}
+
+	if parsedTable.ColumnByName("ID").Type() != DataType.Int32 {
+		t.Fatal("TestDataTableCsvNullAndEmptyString: expected ID column type of Int32, received: " + parsedTable.ColumnByName("ID").Type().String())
+	}
+
+	if _, null, _ := parsedTable.Row(1).Int32ValueByName("ID"); !null {
+		t.Fatal("TestDataTableCsvNullAndEmptyString: expected quoted empty integer field to be null")
+	}
+
+	if name, null, _ := parsedTable.Row(1).StringValueByName("Name"); null || name != "" {
+		t.Fatal("TestDataTableCsvNullAndEmptyString: expected quoted empty string field to be an empty string")
+	}
+}
+
+func TestDataTableCsvTypeInference(t *testing.T) {
+	csv := "Flag,Small,Large,Real,Updated,Name,Empty\n" +
+		"true,1,1,1,2021-10-11T12:00:00Z,A,\n" +
+		"FALSE,2,9876543210,2.5,2022-01-01T00:00:00Z,1,\n" +
+		",,,,,,\n"
+
+	dataTable, err := NewDataSet().ParseCsv("Inferred", strings.NewReader(csv))
+
+	if err != nil {
+		t.Fatal("TestDataTableCsvTypeInference: failed to parse CSV: " + err.Error())
+	}
+
+	expected := map[string]DataTypeEnum{
+		"Flag":    DataType.Boolean,
+		"Small":   DataType.Int32,
+		"Large":   DataType.Int64,
+		"Real":    DataType.Double,
+		"Updated": DataType.DateTime,
+		"Name":    DataType.String,
+		"Empty":   DataType.String,
+	}
+
+	for columnName, dataType := range expected {
+		if column := dataTable.ColumnByName(columnName); column == nil || column.Type() != dataType {
+			t.Fatal("TestDataTableCsvTypeInference: expected " + columnName + " column type of " + dataType.String())
+		}
+	}
+
+	if _, null, _ := dataTable.Row(2).Int32ValueByName("Small"); !null {
+		t.Fatal("TestDataTableCsvTypeInference: expected empty field to be null")
+	}
+}
+
+func TestDataTableCsvLeadingZeros(t *testing.T) {
+	csv := "Code,Count,Hex\n" +
+		"01234,010,0x10\n" +
+		"00501,007,0x20\n"
+
+	dataTable, err := NewDataSet().ParseCsv("Codes", strings.NewReader(csv))
+
+	if err != nil {
+		t.Fatal("TestDataTableCsvLeadingZeros: failed to parse CSV: " + err.Error())
+	}
+
+	// Integers are always parsed as base 10, i.e., leading zeros are not an octal prefix
+	if code, _, _ := dataTable.Row(0).Int32ValueByName("Code"); code != 1234 {
+		t.Fatalf("TestDataTableCsvLeadingZeros: expected Code of 1234, received: %d", code)
+	}
+
+	if code, _, _ := dataTable.Row(1).Int32ValueByName("Code"); code != 501 {
+		t.Fatalf("TestDataTableCsvLeadingZeros: expected Code of 501, received: %d", code)
+	}
+
+	if count, _, _ := dataTable.Row(0).Int32ValueByName("Count"); count != 10 {
+		t.Fatalf("TestDataTableCsvLeadingZeros: expected Count of 10, received: %d", count)
+	}
+
+	if column := dataTable.ColumnByName("Hex"); column.Type() != DataType.String || dataTable.RowValueAsStringByName(0, "Hex") != "0x10" {
+		t.Fatal("TestDataTableCsvLeadingZeros: expected hexadecimal prefixed values to remain strings")
+	}
+}
+
+func TestParseDataType(t *testing.T) {
+	for dataType := DataType.String; dataType <= DataType.UInt64; dataType++ {
+		parsed, ok := ParseDataType(strings.ToLower(dataType.String()))
+
+		if !ok || parsed != dataType {
+			t.Fatal("TestParseDataType: failed to parse data type " + dataType.String())
+		}
+	}
+
+	if _, ok := ParseDataType("Undefined"); ok {
+		t.Fatal("TestParseDataType: expected parse failure for undefined data type")
+	}
+}
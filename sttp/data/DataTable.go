@@ -42,6 +42,8 @@ type DataTable struct {
 	columnIndexes map[string]int
 	columns       []*DataColumn
 	rows          []*DataRow
+	indexes       map[string]*DataIndex
+	rowsVersion   int // Incremented on row changes so indexes can be rebuilt
 }
 
 func newDataTable(parent *DataSet, name string) *DataTable {
@@ -49,6 +51,7 @@ func newDataTable(parent *DataSet, name string) *DataTable {
 		parent:        parent,
 		name:          name,
 		columnIndexes: make(map[string]int),
+		indexes:       make(map[string]*DataIndex),
 	}
 }
 
@@ -67,6 +70,7 @@ func (dt *DataTable) Name() string {
 func (dt *DataTable) InitColumns(length int) {
 	dt.columns = make([]*DataColumn, 0, length)
 	dt.columnIndexes = make(map[string]int, length)
+	dt.indexes = make(map[string]*DataIndex)
 }
 
 // AddColumn adds the specified column to the DataTable.
@@ -128,6 +132,7 @@ func (dt *DataTable) ColumnCount() int {
 // Any existing rows will be deleted.
 func (dt *DataTable) InitRows(length int) {
 	dt.rows = make([]*DataRow, 0, length)
+	dt.rowsVersion++
 }
 
 func (dt *DataTable) Rows() []*DataRow {
@@ -137,6 +142,7 @@ func (dt *DataTable) Rows() []*DataRow {
 // AddRow adds the specified row to the DataTable.
 func (dt *DataTable) AddRow(row *DataRow) {
 	dt.rows = append(dt.rows, row)
+	dt.rowsVersion++
 }
 
 // Row gets the DataRow at the specified rowIndex if the index is in range;
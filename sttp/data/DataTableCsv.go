@@ -0,0 +1,315 @@
+//******************************************************************************************************
+//  DataTableCsv.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// csvInferenceTypes defines the order in which data types are tested when inferring the
+// type of a CSV column, from most to least specific.
+var csvInferenceTypes = []DataTypeEnum{
+	DataType.Boolean,
+	DataType.Int32,
+	DataType.Int64,
+	DataType.Double,
+	DataType.Guid,
+	DataType.DateTime,
+}
+
+// WriteCsv writes the DataTable as CSV to the specified writer. The first record is a header
+// with the column names. When includeTypes is true, each header field is suffixed with the
+// column data type, e.g., "SignalID:Guid", so that ParseCsv can restore the exact schema.
+// Null values are written as empty fields and empty strings as quoted empty fields, i.e., "",
+// so that ParseCsv can distinguish them. Computed column values are evaluated and written.
+func (dt *DataTable) WriteCsv(writer io.Writer, includeTypes bool) error {
+	csvWriter := bufio.NewWriter(writer)
+	record := make([]string, len(dt.columns))
+	nulls := make([]bool, len(dt.columns))
+
+	for i, column := range dt.columns {
+		if includeTypes {
+			record[i] = column.Name() + ":" + column.Type().String()
+		} else {
+			record[i] = column.Name()
+		}
+	}
+
+	if err := writeCsvRecord(csvWriter, record, nulls); err != nil {
+		return err
+	}
+
+	for _, row := range dt.rows {
+		if row == nil {
+			continue
+		}
+
+		for i := range dt.columns {
+			value, err := row.Value(i)
+
+			if err != nil {
+				return err
+			}
+
+			if value == nil {
+				record[i] = ""
+			} else {
+				record[i] = formatDataValue(value)
+			}
+
+			nulls[i] = value == nil
+		}
+
+		if err := writeCsvRecord(csvWriter, record, nulls); err != nil {
+			return err
+		}
+	}
+
+	return csvWriter.Flush()
+}
+
+// writeCsvRecord writes the record with the same quoting rules as encoding/csv, except that
+// empty fields which are not null are quoted. The encoding/csv writer never quotes empty fields.
+func writeCsvRecord(writer *bufio.Writer, record []string, nulls []bool) error {
+	for i, field := range record {
+		if i > 0 {
+			writer.WriteByte(',')
+		}
+
+		switch {
+		case len(field) == 0:
+			if !nulls[i] {
+				writer.WriteString(`""`)
+			}
+		case csvFieldNeedsQuotes(field):
+			writer.WriteByte('"')
+			writer.WriteString(strings.ReplaceAll(field, `"`, `""`))
+			writer.WriteByte('"')
+		default:
+			writer.WriteString(field)
+		}
+	}
+
+	// Write errors are sticky, so any prior error is returned here
+	_, err := writer.WriteString("\n")
+	return err
+}
+
+func csvFieldNeedsQuotes(field string) bool {
+	if strings.ContainsAny(field, "\",\r\n") {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(field)
+	return unicode.IsSpace(r)
+}
+
+// ParseCsv creates a new DataTable with the specified tableName from the CSV in the specified
+// reader and adds it to the DataSet, replacing any existing table with the same name. The first
+// record must be a header with the column names. Header fields with a data type suffix, e.g.,
+// "SignalID:Guid", use the type parsed by ParseDataType; otherwise, the column type is inferred
+// as the most specific of Boolean, Int32, Int64, Double, Guid or DateTime that can represent all
+// non-empty values in the column, falling back to String. Empty fields are loaded as null values,
+// except for quoted empty fields, i.e., "", in String columns which are loaded as empty strings.
+//
+//gocyclo:ignore
+func (ds *DataSet) ParseCsv(tableName string, reader io.Reader) (*DataTable, error) {
+	records, emptyStrings, err := readCsvRecords(reader)
+
+	if err != nil {
+		return nil, errors.New("failed to parse CSV for table \"" + tableName + "\": " + err.Error())
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("failed to parse CSV for table \"" + tableName + "\": no header record found")
+	}
+
+	header := records[0]
+	records = records[1:]
+	emptyStrings = emptyStrings[1:]
+
+	dataTable := ds.CreateTable(tableName)
+	dataTable.InitColumns(len(header))
+
+	for i, field := range header {
+		columnName := strings.TrimSpace(field)
+		dataType := DataType.String
+		typed := false
+
+		if separator := strings.LastIndex(columnName, ":"); separator > 0 {
+			if parsedType, ok := ParseDataType(columnName[separator+1:]); ok {
+				columnName = columnName[:separator]
+				dataType = parsedType
+				typed = true
+			}
+		}
+
+		if !typed {
+			dataType = inferCsvDataType(records, i)
+		}
+
+		dataTable.AddColumn(dataTable.CreateColumn(columnName, dataType, ""))
+	}
+
+	dataTable.InitRows(len(records))
+
+	for r, record := range records {
+		dataRow := dataTable.CreateRow()
+
+		for i, field := range record {
+			column := dataTable.Column(i)
+
+			if column == nil {
+				continue
+			}
+
+			if len(field) == 0 {
+				if column.Type() == DataType.String && emptyStrings[r] != nil && emptyStrings[r][i] {
+					dataRow.SetValue(i, "")
+				}
+
+				continue
+			}
+
+			value, err := parseDataValue(field, column.Type())
+
+			if err != nil {
+				return nil, errors.New("failed to parse CSV value for column \"" + column.Name() + "\" on line " + strconv.Itoa(r+2) + " as \"" + column.Type().String() + "\": " + err.Error())
+			}
+
+			dataRow.SetValue(i, value)
+		}
+
+		dataTable.AddRow(dataRow)
+	}
+
+	ds.AddTable(dataTable)
+
+	return dataTable, nil
+}
+
+// readCsvRecords reads all CSV records from the reader. Since encoding/csv does not distinguish
+// quoted empty fields from empty fields, the returned emptyStrings flags quoted empty fields per
+// record, using the start position of each empty field. Records without any are left nil.
+func readCsvRecords(reader io.Reader) (records [][]string, emptyStrings [][]bool, err error) {
+	data, err := io.ReadAll(reader)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Track byte offset of the start of each line to map field positions to the source data
+	lineOffsets := []int{0}
+
+	for i, b := range data {
+		if b == '\n' {
+			lineOffsets = append(lineOffsets, i+1)
+		}
+	}
+
+	csvReader := csv.NewReader(bytes.NewReader(data))
+
+	for {
+		record, err := csvReader.Read()
+
+		if err == io.EOF {
+			return records, emptyStrings, nil
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var quoted []bool
+
+		for i, field := range record {
+			if len(field) > 0 {
+				continue
+			}
+
+			line, column := csvReader.FieldPos(i)
+
+			// Position of a quoted field is the position of its opening quote
+			if offset := lineOffsets[line-1] + column - 1; offset < len(data) && data[offset] == '"' {
+				if quoted == nil {
+					quoted = make([]bool, len(record))
+				}
+
+				quoted[i] = true
+			}
+		}
+
+		records = append(records, record)
+		emptyStrings = append(emptyStrings, quoted)
+	}
+}
+
+func inferCsvDataType(records [][]string, columnIndex int) DataTypeEnum {
+	fields := make([]string, 0, len(records))
+
+	for _, record := range records {
+		if columnIndex < len(record) && len(record[columnIndex]) > 0 {
+			fields = append(fields, record[columnIndex])
+		}
+	}
+
+	if len(fields) == 0 {
+		return DataType.String
+	}
+
+	for _, dataType := range csvInferenceTypes {
+		matched := true
+
+		for _, field := range fields {
+			if !csvFieldIsType(field, dataType) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return dataType
+		}
+	}
+
+	return DataType.String
+}
+
+func csvFieldIsType(field string, dataType DataTypeEnum) bool {
+	// Only accept literal true and false for booleans, not 0, 1, T, F, etc.
+	if dataType == DataType.Boolean {
+		return strings.EqualFold(field, "true") || strings.EqualFold(field, "false")
+	}
+
+	_, err := parseDataValue(field, dataType)
+	return err == nil
+}
@@ -23,7 +23,18 @@
 
 package data
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
 
 // DataTypeEnum defines the type of the DataType enumeration.
 type DataTypeEnum int
@@ -160,3 +171,123 @@ func ParseXsdDataType(xsdTypeName, extDataType string) (DataTypeEnum, bool) {
 		return DataType.String, false
 	}
 }
+
+// ParseDataType gets the DataType from the provided typeName, e.g., "Guid" or "Int32", as
+// returned by DataTypeEnum.String(). Lookup is case-insensitive. Return tuple includes boolean
+// value that determines if parse was successful.
+func ParseDataType(typeName string) (DataTypeEnum, bool) {
+	typeName = strings.TrimSpace(typeName)
+
+	for dataType := DataType.String; dataType <= DataType.UInt64; dataType++ {
+		if strings.EqualFold(typeName, dataType.String()) {
+			return dataType, true
+		}
+	}
+
+	return DataType.String, false
+}
+
+// formatDataValue gets a lossless string representation of a DataColumn value for serialization.
+//gocyclo:ignore
+func formatDataValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case time.Time:
+		return typedValue.Format(time.RFC3339Nano)
+	case float32:
+		return strconv.FormatFloat(float64(typedValue), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'g', -1, 64)
+	case decimal.Decimal:
+		return typedValue.String()
+	case guid.Guid:
+		return typedValue.String()
+	case int8:
+		return strconv.FormatInt(int64(typedValue), 10)
+	case int16:
+		return strconv.FormatInt(int64(typedValue), 10)
+	case int32:
+		return strconv.FormatInt(int64(typedValue), 10)
+	case int64:
+		return strconv.FormatInt(typedValue, 10)
+	case uint8:
+		return strconv.FormatUint(uint64(typedValue), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(typedValue), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(typedValue), 10)
+	case uint64:
+		return strconv.FormatUint(typedValue, 10)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// parseDataValue parses a DataColumn value of the specified dataType from its string representation.
+//gocyclo:ignore
+func parseDataValue(value string, dataType DataTypeEnum) (interface{}, error) {
+	switch dataType {
+	case DataType.String:
+		return value, nil
+	case DataType.Boolean:
+		return strconv.ParseBool(value)
+	case DataType.DateTime:
+		if dt, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return dt, nil
+		}
+
+		if dt, err := time.Parse(DateTimeFormat, value); err == nil {
+			return dt, nil
+		}
+
+		return dateparse.ParseAny(value)
+	case DataType.Single:
+		f32, err := strconv.ParseFloat(value, 32)
+		return float32(f32), err
+	case DataType.Double:
+		return strconv.ParseFloat(value, 64)
+	case DataType.Decimal:
+		return decimal.NewFromString(value)
+	case DataType.Guid:
+		return guid.Parse(value)
+	case DataType.Int8:
+		i8, err := strconv.ParseInt(value, 10, 8)
+		return int8(i8), err
+	case DataType.Int16:
+		i16, err := strconv.ParseInt(value, 10, 16)
+		return int16(i16), err
+	case DataType.Int32:
+		i32, err := strconv.ParseInt(value, 10, 32)
+		return int32(i32), err
+	case DataType.Int64:
+		return strconv.ParseInt(value, 10, 64)
+	case DataType.UInt8:
+		ui8, err := strconv.ParseUint(value, 10, 8)
+		return uint8(ui8), err
+	case DataType.UInt16:
+		ui16, err := strconv.ParseUint(value, 10, 16)
+		return uint16(ui16), err
+	case DataType.UInt32:
+		ui32, err := strconv.ParseUint(value, 10, 32)
+		return uint32(ui32), err
+	case DataType.UInt64:
+		return strconv.ParseUint(value, 10, 64)
+	default:
+		return nil, errors.New("unexpected column data type encountered")
+	}
+}
+
+// isFiniteDataValue determines if a floating-point value can be represented as a JSON number.
+func isFiniteDataValue(value interface{}) bool {
+	switch typedValue := value.(type) {
+	case float32:
+		return !math.IsNaN(float64(typedValue)) && !math.IsInf(float64(typedValue), 0)
+	case float64:
+		return !math.IsNaN(typedValue) && !math.IsInf(typedValue, 0)
+	default:
+		return true
+	}
+}
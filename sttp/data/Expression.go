@@ -25,6 +25,10 @@ package data
 
 import (
 	"errors"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sttp/goapi/sttp/data/parser"
 )
 
 // Expression is the interface that can represent all expression types
@@ -33,6 +37,125 @@ type Expression interface {
 	Type() ExpressionTypeEnum
 }
 
+// ExpressionString gets the expression formatted as canonical filter expression syntax that can be
+// parsed back into an equivalent expression. Keywords are upper-case, literal values are formatted
+// with ValueExpression.Literal and nested operations are enclosed in parentheses where required
+// to preserve evaluation order. An empty string is returned if expression is nil.
+func ExpressionString(expression Expression) string {
+	if expression == nil {
+		return ""
+	}
+
+	if expression.Type() == ExpressionType.Value {
+		return expression.(*ValueExpression).Literal()
+	}
+
+	if stringer, ok := expression.(interface{ String() string }); ok {
+		return stringer.String()
+	}
+
+	return ""
+}
+
+// Precedence levels of formatted expressions, from highest to lowest, as defined by the
+// filter expression grammar. Note that all operators of a level have the same precedence.
+const (
+	primaryPrecedence = iota
+	unaryPrecedence
+	mathPrecedence
+	bitwisePrecedence
+	predicatePrecedence
+	notPrecedence
+	logicalPrecedence
+)
+
+// expressionPrecedence gets the grammar precedence level of the formatted expression.
+func expressionPrecedence(expression Expression) int {
+	switch expression.Type() {
+	case ExpressionType.Value:
+		// Negative numeric literals are parsed as unary expressions
+		if strings.HasPrefix(expression.(*ValueExpression).Literal(), "-") {
+			return unaryPrecedence
+		}
+
+		return primaryPrecedence
+	case ExpressionType.Unary:
+		// Not is formatted using the "NOT" keyword which applies to an entire predicate
+		if expression.(*UnaryExpression).UnaryType() == ExpressionUnaryType.Not {
+			return notPrecedence
+		}
+
+		return unaryPrecedence
+	case ExpressionType.Operator:
+		switch expression.(*OperatorExpression).OperatorType() {
+		case ExpressionOperatorType.Multiply, ExpressionOperatorType.Divide, ExpressionOperatorType.Modulus,
+			ExpressionOperatorType.Add, ExpressionOperatorType.Subtract, ExpressionOperatorType.Concatenate:
+			return mathPrecedence
+		case ExpressionOperatorType.BitShiftLeft, ExpressionOperatorType.BitShiftRight, ExpressionOperatorType.BitwiseAnd,
+			ExpressionOperatorType.BitwiseOr, ExpressionOperatorType.BitwiseXor:
+			return bitwisePrecedence
+		case ExpressionOperatorType.And, ExpressionOperatorType.Or:
+			return logicalPrecedence
+		default:
+			return predicatePrecedence
+		}
+	case ExpressionType.InList, ExpressionType.Between:
+		return predicatePrecedence
+	default:
+		return primaryPrecedence
+	}
+}
+
+// operandString gets the formatted operand expression, enclosed in parentheses when its
+// precedence is not higher than the specified precedence level.
+func operandString(operand Expression, precedence int) string {
+	if operand == nil {
+		return "NULL"
+	}
+
+	if expressionPrecedence(operand) < precedence {
+		return ExpressionString(operand)
+	}
+
+	return "(" + ExpressionString(operand) + ")"
+}
+
+// expressionListString gets the expressions formatted as a comma separated list.
+func expressionListString(expressions []Expression) string {
+	var list strings.Builder
+
+	for i, expression := range expressions {
+		if i > 0 {
+			list.WriteString(", ")
+		}
+
+		list.WriteString(ExpressionString(expression))
+	}
+
+	return list.String()
+}
+
+// IdentifierString gets the name formatted as a filter expression identifier, e.g., a table or
+// column name. Names that would not be parsed as a single identifier, such as names containing
+// spaces or names matching a keyword, are enclosed in square brackets.
+func IdentifierString(name string) string {
+	if len(name) > 0 && name[0] != '[' && name[0] != '`' {
+		lexer := parser.NewFilterExpressionSyntaxLexer(antlr.NewInputStream(name))
+		lexer.RemoveErrorListeners()
+
+		if token := lexer.NextToken(); token.GetTokenType() == parser.FilterExpressionSyntaxLexerIDENTIFIER &&
+			token.GetText() == name && lexer.NextToken().GetTokenType() == antlr.TokenEOF {
+			return name
+		}
+	}
+
+	if strings.Contains(name, "]") {
+		return "`" + name + "`"
+	}
+
+	return "[" + name + "]"
+}
+
 // GetValueExpression gets the expression cast to a ValueExpression.
 // An error will be returned if expression is nil or not ExpressionType.Value.
 func GetValueExpression(expression Expression) (*ValueExpression, error) {
@@ -116,3 +239,59 @@ func GetOperatorExpression(expression Expression) (*OperatorExpression, error) {
 
 	return expression.(*OperatorExpression), nil
 }
+
+// GetAggregateExpression gets the expression cast to a AggregateExpression.
+// An error will be returned if expression is nil or not ExpressionType.Aggregate.
+func GetAggregateExpression(expression Expression) (*AggregateExpression, error) {
+	if expression == nil {
+		return nil, errors.New("cannot get AggregateExpression, expression is nil")
+	}
+
+	if expression.Type() != ExpressionType.Aggregate {
+		return nil, errors.New("expression is not a AggregateExpression")
+	}
+
+	return expression.(*AggregateExpression), nil
+}
+
+// GetParameterExpression gets the expression cast to a ParameterExpression.
+// An error will be returned if expression is nil or not ExpressionType.Parameter.
+func GetParameterExpression(expression Expression) (*ParameterExpression, error) {
+	if expression == nil {
+		return nil, errors.New("cannot get ParameterExpression, expression is nil")
+	}
+
+	if expression.Type() != ExpressionType.Parameter {
+		return nil, errors.New("expression is not a ParameterExpression")
+	}
+
+	return expression.(*ParameterExpression), nil
+}
+
+// GetBetweenExpression gets the expression cast to a BetweenExpression.
+// An error will be returned if expression is nil or not ExpressionType.Between.
+func GetBetweenExpression(expression Expression) (*BetweenExpression, error) {
+	if expression == nil {
+		return nil, errors.New("cannot get BetweenExpression, expression is nil")
+	}
+
+	if expression.Type() != ExpressionType.Between {
+		return nil, errors.New("expression is not a BetweenExpression")
+	}
+
+	return expression.(*BetweenExpression), nil
+}
+
+// GetCaseExpression gets the expression cast to a CaseExpression.
+// An error will be returned if expression is nil or not ExpressionType.Case.
+func GetCaseExpression(expression Expression) (*CaseExpression, error) {
+	if expression == nil {
+		return nil, errors.New("cannot get CaseExpression, expression is nil")
+	}
+
+	if expression.Type() != ExpressionType.Case {
+		return nil, errors.New("expression is not a CaseExpression")
+	}
+
+	return expression.(*CaseExpression), nil
+}
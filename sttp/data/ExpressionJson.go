@@ -0,0 +1,729 @@
+//******************************************************************************************************
+//  ExpressionJson.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// JSON encoding of an expression represents each expression node as an object with a "type" property,
+// i.e., an ExpressionType name, and properties specific to the node type. Operator, function and value
+// type names match the String value of the associated enumeration, e.g., "AND", "IS NULL" or "Int32".
+// Values that have no lossless JSON representation, i.e., DateTime, Decimal, Guid and non-finite Double
+// values, are encoded as strings. Example for "SignalType = 'FREQ' AND Device.Acronym IN ('A', 'B')":
+//
+//	{
+//	  "type": "Operator", "operator": "AND",
+//	  "left": {
+//	    "type": "Operator", "operator": "=",
+//	    "left": {"type": "Column", "column": "SignalType"},
+//	    "right": {"type": "Value", "valueType": "String", "value": "FREQ"}
+//	  },
+//	  "right": {
+//	    "type": "InList",
+//	    "operand": {"type": "Column", "column": "Acronym", "relations": ["Device"]},
+//	    "arguments": [
+//	      {"type": "Value", "valueType": "String", "value": "A"},
+//	      {"type": "Value", "valueType": "String", "value": "B"}
+//	    ]
+//	  }
+//	}
+
+type jsonExpression struct {
+	Type       string            `json:"type"`
+	ValueType  string            `json:"valueType,omitempty"`
+	Value      json.RawMessage   `json:"value,omitempty"`
+	Operator   string            `json:"operator,omitempty"`
+	Function   string            `json:"function,omitempty"`
+	Column     string            `json:"column,omitempty"`
+	Relations  []string          `json:"relations,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Operand    *jsonExpression   `json:"operand,omitempty"`
+	Left       *jsonExpression   `json:"left,omitempty"`
+	Right      *jsonExpression   `json:"right,omitempty"`
+	Lower      *jsonExpression   `json:"lower,omitempty"`
+	Upper      *jsonExpression   `json:"upper,omitempty"`
+	Arguments  []*jsonExpression `json:"arguments,omitempty"`
+	When       []jsonCaseWhen    `json:"when,omitempty"`
+	Else       *jsonExpression   `json:"else,omitempty"`
+	Not        bool              `json:"not,omitempty"`
+	ExactMatch bool              `json:"exactMatch,omitempty"`
+}
+
+type jsonCaseWhen struct {
+	Condition *jsonExpression `json:"condition"`
+	Result    *jsonExpression `json:"result"`
+}
+
+type jsonExpressionTree struct {
+	Table   string            `json:"table,omitempty"`
+	Top     *int              `json:"top,omitempty"`
+	Select  []jsonSelectTerm  `json:"select,omitempty"`
+	Where   *jsonExpression   `json:"where,omitempty"`
+	GroupBy []string          `json:"groupBy,omitempty"`
+	OrderBy []jsonOrderByTerm `json:"orderBy,omitempty"`
+}
+
+type jsonSelectTerm struct {
+	Name       string          `json:"name"`
+	Expression *jsonExpression `json:"expression"`
+}
+
+type jsonOrderByTerm struct {
+	Column     string `json:"column"`
+	Descending bool   `json:"descending,omitempty"`
+	ExactMatch bool   `json:"exactMatch,omitempty"`
+}
+
+// MarshalExpressionJson encodes the expression, including all child expressions, as JSON.
+// An error will be returned if expression, or any child expression, is nil or unsupported.
+func MarshalExpressionJson(expression Expression) ([]byte, error) {
+	jexpr, err := expressionToJson(expression)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jexpr)
+}
+
+// ParseExpressionJson decodes an expression from JSON as encoded by MarshalExpressionJson. Column
+// references are resolved against dataTable, which can be nil when expression has no columns. An
+// error will be returned if the JSON is malformed or references an unknown column or function.
+func ParseExpressionJson(dataTable *DataTable, data []byte) (Expression, error) {
+	var jexpr jsonExpression
+
+	if err := json.Unmarshal(data, &jexpr); err != nil {
+		return nil, errors.New("failed to parse expression JSON: " + err.Error())
+	}
+
+	return newExpressionJsonDecoder(dataTable).decode(&jexpr)
+}
+
+// MarshalJSON encodes the ExpressionTree as JSON. Expression trees parsed from a "FILTER" or "SELECT"
+// statement include the table name and any statement clauses, the Root expression is encoded as "where".
+func (et *ExpressionTree) MarshalJSON() ([]byte, error) {
+	jet := jsonExpressionTree{
+		Table: et.TableName,
+	}
+
+	var err error
+
+	if et.TopLimit >= 0 {
+		topLimit := et.TopLimit
+		jet.Top = &topLimit
+	}
+
+	for _, selectTerm := range et.SelectTerms {
+		jst := jsonSelectTerm{
+			Name: selectTerm.Name,
+		}
+
+		if jst.Expression, err = expressionToJson(selectTerm.Expression); err != nil {
+			return nil, err
+		}
+
+		jet.Select = append(jet.Select, jst)
+	}
+
+	if et.Root != nil {
+		if jet.Where, err = expressionToJson(et.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, groupByColumn := range et.GroupByColumns {
+		jet.GroupBy = append(jet.GroupBy, groupByColumn.Name())
+	}
+
+	for _, orderByTerm := range et.OrderByTerms {
+		jet.OrderBy = append(jet.OrderBy, jsonOrderByTerm{
+			Column:     orderByTerm.Column.Name(),
+			Descending: !orderByTerm.Ascending,
+			ExactMatch: orderByTerm.ExactMatch,
+		})
+	}
+
+	return json.Marshal(jet)
+}
+
+// ParseExpressionTreeJson decodes an ExpressionTree from JSON as encoded by ExpressionTree.MarshalJSON.
+// Column references are resolved against the encoded table, or primaryTable when the JSON does not
+// define a table. An error will be returned if dataSet parameter is nil, the JSON is malformed or it
+// references an unknown table, column or function.
+//gocyclo:ignore
+func ParseExpressionTreeJson(dataSet *DataSet, primaryTable string, data []byte) (*ExpressionTree, error) {
+	if dataSet == nil {
+		return nil, errors.New("dataSet parameter is nil")
+	}
+
+	var jet jsonExpressionTree
+
+	if err := json.Unmarshal(data, &jet); err != nil {
+		return nil, errors.New("failed to parse expression tree JSON: " + err.Error())
+	}
+
+	tableName := jet.Table
+
+	if len(tableName) == 0 {
+		if len(jet.Select) > 0 || len(jet.GroupBy) > 0 || len(jet.OrderBy) > 0 || jet.Top != nil {
+			return nil, errors.New("failed to parse expression tree JSON: statement clauses require a table name")
+		}
+
+		tableName = primaryTable
+	}
+
+	var table *DataTable
+
+	if len(tableName) > 0 {
+		if table = dataSet.Table(tableName); table == nil {
+			return nil, errors.New("failed to parse expression tree JSON: failed to find table \"" + tableName + "\" in DataSet")
+		}
+	}
+
+	decoder := newExpressionJsonDecoder(table)
+	expressionTree := NewExpressionTree()
+	expressionTree.TableName = jet.Table
+	var err error
+
+	if jet.Top != nil {
+		expressionTree.TopLimit = *jet.Top
+	}
+
+	for i, jst := range jet.Select {
+		selectTerm := &SelectTerm{
+			Name: jst.Name,
+		}
+
+		if selectTerm.Expression, err = decoder.decode(jst.Expression); err != nil {
+			return nil, err
+		}
+
+		if len(selectTerm.Name) == 0 {
+			selectTerm.Name = "Column" + strconv.Itoa(i+1)
+		}
+
+		expressionTree.SelectTerms = append(expressionTree.SelectTerms, selectTerm)
+	}
+
+	if jet.Where == nil {
+		if len(jet.Table) > 0 && len(jet.Select) == 0 {
+			return nil, errors.New("failed to parse expression tree JSON: filter statement for table \"" + jet.Table + "\" requires a \"where\" expression")
+		}
+
+		// Root expression operates as the "WHERE" clause, selecting all rows when not specified
+		if len(jet.Select) > 0 {
+			expressionTree.Root = True
+		}
+	} else {
+		if expressionTree.Root, err = decoder.decode(jet.Where); err != nil {
+			return nil, err
+		}
+
+		if hasAggregate(expressionTree.Root) {
+			return nil, errors.New("failed to parse expression tree JSON: aggregate functions are not valid in \"where\" expression")
+		}
+	}
+
+	for _, columnName := range jet.GroupBy {
+		groupByColumn := table.ColumnByName(columnName)
+
+		if groupByColumn == nil {
+			return nil, errors.New("failed to parse expression tree JSON: failed to find group by column \"" + columnName + "\" for table \"" + table.Name() + "\"")
+		}
+
+		expressionTree.GroupByColumns = append(expressionTree.GroupByColumns, groupByColumn)
+	}
+
+	for _, jobt := range jet.OrderBy {
+		var orderByColumn *DataColumn
+
+		if len(expressionTree.SelectTerms) > 0 {
+			// Order by terms for select statements reference result columns
+			for _, selectTerm := range expressionTree.SelectTerms {
+				if strings.EqualFold(selectTerm.Name, jobt.Column) {
+					orderByColumn = newDataColumn(nil, jobt.Column, DataType.String, "")
+					break
+				}
+			}
+		} else {
+			orderByColumn = table.ColumnByName(jobt.Column)
+		}
+
+		if orderByColumn == nil {
+			return nil, errors.New("failed to parse expression tree JSON: failed to find order by column \"" + jobt.Column + "\"")
+		}
+
+		expressionTree.OrderByTerms = append(expressionTree.OrderByTerms, &OrderByTerm{
+			Column:     orderByColumn,
+			Ascending:  !jobt.Descending,
+			ExactMatch: jobt.ExactMatch,
+		})
+	}
+
+	return expressionTree, nil
+}
+
+//gocyclo:ignore
+func expressionToJson(expression Expression) (*jsonExpression, error) {
+	if expression == nil {
+		return nil, errors.New("cannot encode expression JSON, expression is nil")
+	}
+
+	jexpr := &jsonExpression{
+		Type: expression.Type().String(),
+	}
+
+	var err error
+
+	switch typedExpression := expression.(type) {
+	case *ValueExpression:
+		jexpr.ValueType = typedExpression.ValueType().String()
+		jexpr.Value, err = typedExpression.jsonValue()
+	case *UnaryExpression:
+		jexpr.Operator = typedExpression.UnaryType().String()
+		jexpr.Operand, err = expressionToJson(typedExpression.Value())
+	case *ColumnExpression:
+		if typedExpression.DataColumn() == nil {
+			return nil, errors.New("cannot encode column expression JSON, data column reference is not defined")
+		}
+
+		jexpr.Column = typedExpression.DataColumn().Name()
+
+		for _, relation := range typedExpression.Relations() {
+			jexpr.Relations = append(jexpr.Relations, relation.Name())
+		}
+	case *InListExpression:
+		jexpr.Not = typedExpression.HasNotKeyword()
+		jexpr.ExactMatch = typedExpression.ExtactMatch()
+
+		if jexpr.Operand, err = expressionToJson(typedExpression.Value()); err == nil {
+			jexpr.Arguments, err = expressionsToJson(typedExpression.Arguments())
+		}
+	case *FunctionExpression:
+		if typedExpression.UserFunction() == nil {
+			jexpr.Function = typedExpression.FunctionType().String()
+		} else {
+			jexpr.Function = typedExpression.UserFunction().Name()
+		}
+
+		jexpr.Arguments, err = expressionsToJson(typedExpression.Arguments())
+	case *OperatorExpression:
+		jexpr.Operator = typedExpression.OperatorType().String()
+
+		if jexpr.Left, err = expressionToJson(typedExpression.LeftValue()); err == nil && typedExpression.RightValue() != nil {
+			jexpr.Right, err = expressionToJson(typedExpression.RightValue())
+		}
+	case *AggregateExpression:
+		jexpr.Function = typedExpression.AggregateType().String()
+
+		if typedExpression.Value() != nil {
+			jexpr.Operand, err = expressionToJson(typedExpression.Value())
+		}
+	case *ParameterExpression:
+		jexpr.Name = typedExpression.Name()
+	case *BetweenExpression:
+		jexpr.Not = typedExpression.HasNotKeyword()
+
+		if jexpr.Operand, err = expressionToJson(typedExpression.Value()); err == nil {
+			if jexpr.Lower, err = expressionToJson(typedExpression.LowerBound()); err == nil {
+				jexpr.Upper, err = expressionToJson(typedExpression.UpperBound())
+			}
+		}
+	case *CaseExpression:
+		conditions := typedExpression.Conditions()
+		results := typedExpression.Results()
+
+		if len(conditions) != len(results) {
+			return nil, errors.New("cannot encode case expression JSON, each \"WHEN\" condition must have a result")
+		}
+
+		if typedExpression.Value() != nil {
+			if jexpr.Operand, err = expressionToJson(typedExpression.Value()); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := 0; i < len(conditions) && err == nil; i++ {
+			var when jsonCaseWhen
+
+			if when.Condition, err = expressionToJson(conditions[i]); err == nil {
+				when.Result, err = expressionToJson(results[i])
+			}
+
+			jexpr.When = append(jexpr.When, when)
+		}
+
+		if err == nil && typedExpression.ElseResult() != nil {
+			jexpr.Else, err = expressionToJson(typedExpression.ElseResult())
+		}
+	default:
+		return nil, errors.New("cannot encode expression JSON, unexpected expression type \"" + expression.Type().String() + "\"")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jexpr, nil
+}
+
+func expressionsToJson(expressions []Expression) ([]*jsonExpression, error) {
+	jexprs := make([]*jsonExpression, 0, len(expressions))
+
+	for _, expression := range expressions {
+		jexpr, err := expressionToJson(expression)
+
+		if err != nil {
+			return nil, err
+		}
+
+		jexprs = append(jexprs, jexpr)
+	}
+
+	return jexprs, nil
+}
+
+// jsonValue gets the ValueExpression value as a JSON value, or nil when value is null.
+func (ve *ValueExpression) jsonValue() (json.RawMessage, error) {
+	if ve.IsNull() {
+		return nil, nil
+	}
+
+	switch ve.valueType {
+	case ExpressionValueType.Boolean, ExpressionValueType.Int32, ExpressionValueType.Int64:
+		return json.RawMessage(formatDataValue(ve.value)), nil
+	case ExpressionValueType.Double:
+		if isFiniteDataValue(ve.value) {
+			return json.RawMessage(formatDataValue(ve.value)), nil
+		}
+
+		return json.Marshal(formatDataValue(ve.value))
+	case ExpressionValueType.Decimal, ExpressionValueType.String, ExpressionValueType.Guid, ExpressionValueType.DateTime:
+		return json.Marshal(formatDataValue(ve.value))
+	default:
+		return nil, errors.New("cannot encode value expression JSON, unexpected expression value type \"" + ve.valueType.String() + "\"")
+	}
+}
+
+type expressionJsonDecoder struct {
+	table     *DataTable
+	relations map[string]*DataRelation
+}
+
+func newExpressionJsonDecoder(table *DataTable) *expressionJsonDecoder {
+	return &expressionJsonDecoder{
+		table:     table,
+		relations: make(map[string]*DataRelation),
+	}
+}
+
+//gocyclo:ignore
+func (decoder *expressionJsonDecoder) decode(jexpr *jsonExpression) (Expression, error) {
+	if jexpr == nil {
+		return nil, errors.New("failed to parse expression JSON: expression is missing")
+	}
+
+	var operand, left, right Expression
+	var arguments []Expression
+	var err error
+
+	// Decode child expressions common to multiple expression types
+	if jexpr.Operand != nil {
+		if operand, err = decoder.decode(jexpr.Operand); err != nil {
+			return nil, err
+		}
+	}
+
+	if jexpr.Left != nil {
+		if left, err = decoder.decode(jexpr.Left); err != nil {
+			return nil, err
+		}
+	}
+
+	if jexpr.Right != nil {
+		if right, err = decoder.decode(jexpr.Right); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, jarg := range jexpr.Arguments {
+		var argument Expression
+
+		if argument, err = decoder.decode(jarg); err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	switch {
+	case strings.EqualFold(jexpr.Type, ExpressionType.Value.String()):
+		return decodeJsonValue(jexpr)
+	case strings.EqualFold(jexpr.Type, ExpressionType.Unary.String()):
+		for unaryType := ExpressionUnaryType.Plus; unaryType <= ExpressionUnaryType.Not; unaryType++ {
+			if jexpr.Operator == unaryType.String() && operand != nil {
+				return NewUnaryExpression(unaryType, operand), nil
+			}
+		}
+
+		return nil, errors.New("failed to parse expression JSON: unary expression with operator \"" + jexpr.Operator + "\" is malformed")
+	case strings.EqualFold(jexpr.Type, ExpressionType.Column.String()):
+		return decoder.decodeColumn(jexpr)
+	case strings.EqualFold(jexpr.Type, ExpressionType.InList.String()):
+		if operand == nil || len(arguments) == 0 {
+			return nil, errors.New("failed to parse expression JSON: in-list expression requires an operand and at least one argument")
+		}
+
+		return NewInListExpression(operand, arguments, jexpr.Not, jexpr.ExactMatch), nil
+	case strings.EqualFold(jexpr.Type, ExpressionType.Function.String()):
+		for functionType := ExpressionFunctionType.Abs; functionType < ExpressionFunctionType.UserDefined; functionType++ {
+			if strings.EqualFold(jexpr.Function, functionType.String()) {
+				return NewFunctionExpression(functionType, arguments), nil
+			}
+		}
+
+		userFunction := LookupFunction(jexpr.Function)
+
+		if userFunction == nil {
+			return nil, errors.New("failed to parse expression JSON: unknown function \"" + jexpr.Function + "\", no built-in or registered user-defined function exists with this name")
+		}
+
+		if len(arguments) != len(userFunction.ArgumentTypes()) {
+			return nil, errors.New("failed to parse expression JSON: \"" + userFunction.Name() + "\" function expects " + strconv.Itoa(len(userFunction.ArgumentTypes())) + " arguments, received " + strconv.Itoa(len(arguments)))
+		}
+
+		return NewUserFunctionExpression(userFunction, arguments), nil
+	case strings.EqualFold(jexpr.Type, ExpressionType.Operator.String()):
+		for operatorType := ExpressionOperatorType.Multiply; operatorType <= ExpressionOperatorType.Concatenate; operatorType++ {
+			if !strings.EqualFold(jexpr.Operator, operatorType.String()) {
+				continue
+			}
+
+			isNullOperator := operatorType == ExpressionOperatorType.IsNull || operatorType == ExpressionOperatorType.IsNotNull
+
+			if left == nil || (right == nil && !isNullOperator) {
+				return nil, errors.New("failed to parse expression JSON: \"" + operatorType.String() + "\" operator expression is missing an operand")
+			}
+
+			return NewOperatorExpression(operatorType, left, right), nil
+		}
+
+		return nil, errors.New("failed to parse expression JSON: unexpected operator \"" + jexpr.Operator + "\"")
+	case strings.EqualFold(jexpr.Type, ExpressionType.Aggregate.String()):
+		aggregateType, err := ParseExpressionAggregateType(jexpr.Function)
+
+		if err != nil {
+			return nil, errors.New("failed to parse expression JSON: " + err.Error())
+		}
+
+		if operand == nil && aggregateType != ExpressionAggregateType.Count {
+			return nil, errors.New("failed to parse expression JSON: \"" + aggregateType.String() + "\" aggregate function requires an operand")
+		}
+
+		if operand != nil && hasAggregate(operand) {
+			return nil, errors.New("failed to parse expression JSON: aggregate functions cannot be nested")
+		}
+
+		return NewAggregateExpression(aggregateType, operand), nil
+	case strings.EqualFold(jexpr.Type, ExpressionType.Parameter.String()):
+		if len(jexpr.Name) == 0 {
+			return nil, errors.New("failed to parse expression JSON: parameter expression requires a name")
+		}
+
+		return NewParameterExpression(strings.TrimPrefix(jexpr.Name, "@")), nil
+	case strings.EqualFold(jexpr.Type, ExpressionType.Between.String()):
+		var lowerBound, upperBound Expression
+
+		if operand == nil || jexpr.Lower == nil || jexpr.Upper == nil {
+			return nil, errors.New("failed to parse expression JSON: between expression requires an operand, lower and upper bound")
+		}
+
+		if lowerBound, err = decoder.decode(jexpr.Lower); err != nil {
+			return nil, err
+		}
+
+		if upperBound, err = decoder.decode(jexpr.Upper); err != nil {
+			return nil, err
+		}
+
+		return NewBetweenExpression(operand, lowerBound, upperBound, jexpr.Not), nil
+	case strings.EqualFold(jexpr.Type, ExpressionType.Case.String()):
+		return decoder.decodeCase(jexpr, operand)
+	default:
+		return nil, errors.New("failed to parse expression JSON: unexpected expression type \"" + jexpr.Type + "\"")
+	}
+}
+
+func (decoder *expressionJsonDecoder) decodeColumn(jexpr *jsonExpression) (Expression, error) {
+	table := decoder.table
+
+	if table == nil {
+		return nil, errors.New("failed to parse expression JSON: cannot resolve column \"" + jexpr.Column + "\", no table defined")
+	}
+
+	relations := make([]*DataRelation, 0, len(jexpr.Relations))
+
+	// Each relation name is a relation from the current table to a related table
+	for _, relationName := range jexpr.Relations {
+		relationKey := relationMapKey(table.Name(), relationName)
+		relation, ok := decoder.relations[relationKey]
+
+		if !ok {
+			if relation = table.Parent().Relation(table.Name(), relationName); relation == nil {
+				return nil, errors.New("failed to parse expression JSON: failed to find relation \"" + relationName + "\" for table \"" + table.Name() + "\"")
+			}
+
+			// Cache relation so parent row index is shared by all related columns in expression
+			decoder.relations[relationKey] = relation
+		}
+
+		relations = append(relations, relation)
+		table = relation.ParentTable()
+	}
+
+	dataColumn := table.ColumnByName(jexpr.Column)
+
+	if dataColumn == nil {
+		return nil, errors.New("failed to parse expression JSON: failed to find column \"" + jexpr.Column + "\" in table \"" + table.Name() + "\"")
+	}
+
+	if len(relations) == 0 {
+		return NewColumnExpression(dataColumn), nil
+	}
+
+	return NewRelatedColumnExpression(dataColumn, relations), nil
+}
+
+func (decoder *expressionJsonDecoder) decodeCase(jexpr *jsonExpression, value Expression) (Expression, error) {
+	if len(jexpr.When) == 0 {
+		return nil, errors.New("failed to parse expression JSON: case expression requires at least one \"WHEN\" condition")
+	}
+
+	conditions := make([]Expression, 0, len(jexpr.When))
+	results := make([]Expression, 0, len(jexpr.When))
+	var elseResult Expression
+
+	for _, when := range jexpr.When {
+		condition, err := decoder.decode(when.Condition)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := decoder.decode(when.Result)
+
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, condition)
+		results = append(results, result)
+	}
+
+	if jexpr.Else != nil {
+		var err error
+
+		if elseResult, err = decoder.decode(jexpr.Else); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewCaseExpression(value, conditions, results, elseResult), nil
+}
+
+func decodeJsonValue(jexpr *jsonExpression) (Expression, error) {
+	var valueType ExpressionValueTypeEnum
+
+	for valueType = ExpressionValueType.Boolean; valueType <= ExpressionValueType.Undefined; valueType++ {
+		if strings.EqualFold(jexpr.ValueType, valueType.String()) {
+			break
+		}
+	}
+
+	if valueType > ExpressionValueType.Undefined {
+		return nil, errors.New("failed to parse expression JSON: unexpected value type \"" + jexpr.ValueType + "\"")
+	}
+
+	if len(jexpr.Value) == 0 || bytes.Equal(jexpr.Value, []byte("null")) {
+		return NullValue(valueType), nil
+	}
+
+	var dataType DataTypeEnum
+
+	switch valueType {
+	case ExpressionValueType.Boolean:
+		dataType = DataType.Boolean
+	case ExpressionValueType.Int32:
+		dataType = DataType.Int32
+	case ExpressionValueType.Int64:
+		dataType = DataType.Int64
+	case ExpressionValueType.Decimal:
+		dataType = DataType.Decimal
+	case ExpressionValueType.Double:
+		dataType = DataType.Double
+	case ExpressionValueType.String:
+		dataType = DataType.String
+	case ExpressionValueType.Guid:
+		dataType = DataType.Guid
+	case ExpressionValueType.DateTime:
+		dataType = DataType.DateTime
+	default:
+		return nil, errors.New("failed to parse expression JSON: \"Undefined\" value type must have a null value")
+	}
+
+	var field interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(jexpr.Value))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&field); err != nil {
+		return nil, errors.New("failed to parse expression JSON value: " + err.Error())
+	}
+
+	var text string
+
+	switch typedField := field.(type) {
+	case bool:
+		text = formatDataValue(typedField)
+	case json.Number:
+		text = typedField.String()
+	case string:
+		text = typedField
+	default:
+		return nil, errors.New("failed to parse expression JSON value: unsupported JSON value type")
+	}
+
+	value, err := parseDataValue(text, dataType)
+
+	if err != nil {
+		return nil, errors.New("failed to parse expression JSON value as \"" + valueType.String() + "\": " + strings.TrimPrefix(err.Error(), "strconv."))
+	}
+
+	return newValueExpression(valueType, value), nil
+}
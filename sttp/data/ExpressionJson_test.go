@@ -0,0 +1,148 @@
+//******************************************************************************************************
+//  ExpressionJson_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func TestExpressionTreeJson(t *testing.T) {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("TestExpressionTreeJson: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("TestExpressionTreeJson: error loading DataSet from XML document: " + err.Error())
+	}
+
+	for _, filterExpression := range []string{
+		"FILTER TOP 5 MeasurementDetail WHERE SignalAcronym = 'FREQ' AND NOT (Internal) ORDER BY BINARY PointTag DESC",
+		"FILTER MeasurementDetail WHERE (NOT (PhasorSourceIndex)) < -100 AND NOT (PhasorSourceIndex < -100) AND NOT (NOT (Enabled))",
+		"FILTER MeasurementDetail WHERE (PhasorSourceIndex NOT BETWEEN -1 AND 3 OR Description LIKE '%o''s%') AND PointTag IS NOT NULL",
+		"FILTER MeasurementDetail WHERE SignalAcronym IN BINARY ('FREQ', 'DFDT') AND Device.CompanyAcronym = @company",
+		"FILTER MeasurementDetail WHERE CASE PhasorSourceIndex WHEN 1 THEN 1.5 WHEN 2 THEN 2.5E+00 END > Len(PointTag .. 'x')",
+		"FILTER MeasurementDetail WHERE UpdatedOn < #2020-01-01T00:00:00Z# AND SignalID <> {00000000-0000-0000-0000-000000000001} AND Enabled",
+		"SELECT DeviceAcronym AS Device, COUNT(*) AS Total, AVG(PhasorSourceIndex) FROM MeasurementDetail WHERE Enabled GROUP BY DeviceAcronym ORDER BY Total DESC",
+		"IsNull(PhasorSourceIndex, 0) + 1 = 2 OR DeviceAcronym IS NULL",
+	} {
+		expressionTrees, err := GenerateExpressionTrees(dataSet, "MeasurementDetail", filterExpression, true)
+
+		if err != nil || len(expressionTrees) != 1 {
+			t.Fatal("TestExpressionTreeJson: failed to parse \"" + filterExpression + "\"")
+		}
+
+		data, err := json.Marshal(expressionTrees[0])
+
+		if err != nil {
+			t.Fatal("TestExpressionTreeJson: error encoding \"" + filterExpression + "\": " + err.Error())
+		}
+
+		expressionTree, err := ParseExpressionTreeJson(dataSet, "MeasurementDetail", data)
+
+		if err != nil {
+			t.Fatal("TestExpressionTreeJson: error decoding \"" + string(data) + "\": " + err.Error())
+		}
+
+		if expressionTree.String() != filterExpression {
+			t.Fatal("TestExpressionTreeJson: expected \"" + filterExpression + "\", received: \"" + expressionTree.String() + "\"")
+		}
+	}
+
+	data := []byte(`{"table": "PhasorDetail", "where": {"type": "Between", "operand": {"type": "Column", "column": "SourceIndex"},
+		"lower": {"type": "Value", "valueType": "Int32", "value": 2}, "upper": {"type": "Value", "valueType": "Int32", "value": 4}}}`)
+
+	expressionTree, err := ParseExpressionTreeJson(dataSet, "", data)
+
+	if err != nil {
+		t.Fatal("TestExpressionTreeJson: error decoding expression tree: " + err.Error())
+	}
+
+	rows, err := expressionTree.Select(dataSet.Table("PhasorDetail"))
+
+	if err != nil {
+		t.Fatal("TestExpressionTreeJson: error selecting rows: " + err.Error())
+	}
+
+	if len(rows) != 3 {
+		t.Fatal("TestExpressionTreeJson: expected 3 rows, received: " + strconv.Itoa(len(rows)))
+	}
+
+	for _, data := range []string{
+		`{"where": {"type": "Column", "column": "Unknown"}}`,
+		`{"where": {"type": "Column", "column": "Acronym", "relations": ["Unknown"]}}`,
+		`{"where": {"type": "Function", "function": "Unknown"}}`,
+		`{"where": {"type": "Operator", "operator": "AND", "left": {"type": "Value", "valueType": "Boolean", "value": true}}}`,
+		`{"where": {"type": "Value", "valueType": "Int32", "value": "x"}}`,
+		`{"where": {"type": "Aggregate", "function": "Count"}}`,
+		`{"where": {"type": "Unknown"}}`,
+		`{"table": "MeasurementDetail"}`,
+		`{"top": 1, "where": {"type": "Value", "valueType": "Boolean", "value": true}}`,
+	} {
+		if _, err := ParseExpressionTreeJson(dataSet, "MeasurementDetail", []byte(data)); err == nil {
+			t.Fatal("TestExpressionTreeJson: expected error decoding \"" + data + "\"")
+		}
+	}
+}
+
+func TestExpressionJsonValues(t *testing.T) {
+	for _, value := range []*ValueExpression{
+		NewValueExpression(ExpressionValueType.Double, math.NaN()),
+		NewValueExpression(ExpressionValueType.Double, 0.1),
+		NewValueExpression(ExpressionValueType.Int64, int64(math.MaxInt64)),
+		NewValueExpression(ExpressionValueType.String, "\"quoted\""),
+		NullValue(ExpressionValueType.Guid),
+		NullValue(ExpressionValueType.Undefined),
+	} {
+		data, err := MarshalExpressionJson(value)
+
+		if err != nil {
+			t.Fatal("TestExpressionJsonValues: error encoding value: " + err.Error())
+		}
+
+		expression, err := ParseExpressionJson(nil, data)
+
+		if err != nil {
+			t.Fatal("TestExpressionJsonValues: error decoding \"" + string(data) + "\": " + err.Error())
+		}
+
+		result := expression.(*ValueExpression)
+
+		if result.ValueType() != value.ValueType() || result.IsNull() != value.IsNull() || result.Literal() != value.Literal() {
+			t.Fatal("TestExpressionJsonValues: expected \"" + value.Literal() + "\" for \"" + string(data) + "\", received: \"" + result.Literal() + "\"")
+		}
+	}
+
+	if _, err := MarshalExpressionJson(NewUnaryExpression(ExpressionUnaryType.Minus, nil)); err == nil {
+		t.Fatal("TestExpressionJsonValues: expected error encoding nil operand")
+	}
+}
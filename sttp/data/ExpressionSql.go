@@ -0,0 +1,1253 @@
+//******************************************************************************************************
+//  ExpressionSql.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SqlDialectEnum defines the type of the SqlDialect enumeration.
+type SqlDialectEnum int
+
+// SqlDialect is an enumeration of the SQL dialects supported when translating filter expressions to SQL.
+var SqlDialect = struct {
+	// SQLite defines the SQLite dialect, parameters are numbered as "?1", "?2", etc.
+	SQLite SqlDialectEnum
+	// PostgreSQL defines the PostgreSQL dialect, parameters are numbered as "$1", "$2", etc.
+	PostgreSQL SqlDialectEnum
+	// SqlServer defines the Microsoft SQL Server dialect, parameters are named as "@p1", "@p2", etc.
+	SqlServer SqlDialectEnum
+}{
+	SQLite:     0,
+	PostgreSQL: 1,
+	SqlServer:  2,
+}
+
+// String gets the SqlDialect enumeration value as a string.
+func (sde SqlDialectEnum) String() string {
+	switch sde {
+	case SqlDialect.SQLite:
+		return "SQLite"
+	case SqlDialect.PostgreSQL:
+		return "PostgreSQL"
+	case SqlDialect.SqlServer:
+		return "SqlServer"
+	default:
+		return "0x" + strconv.FormatInt(int64(sde), 16)
+	}
+}
+
+// ParseSqlDialect gets the SqlDialect parsed from the specified name. Case insensitive.
+func ParseSqlDialect(name string) (SqlDialectEnum, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+
+	switch name {
+	case "SQLITE":
+		return SqlDialect.SQLite, nil
+	case "POSTGRESQL", "POSTGRES":
+		return SqlDialect.PostgreSQL, nil
+	case "SQLSERVER", "MSSQL":
+		return SqlDialect.SqlServer, nil
+	default:
+		return SqlDialect.SQLite, fmt.Errorf("specified SQL dialect \"%s\" is unrecognized", name)
+	}
+}
+
+// SqlWhereClause represents a filter expression translated to a parameterized SQL condition.
+type SqlWhereClause struct {
+	// Condition defines the SQL condition, without the "WHERE" keyword, e.g., [SignalType] = @p1.
+	Condition string
+
+	// Parameters defines the values of the numbered parameter placeholders used in Condition, in order.
+	// Values are a bool, int32, int64, decimal.Decimal, float64, string, time.Time or nil for Null.
+	// Guid values are a string without braces.
+	Parameters []interface{}
+}
+
+// TranslateSqlWhere translates the expression to a parameterized SQL condition for the specified dialect.
+// Literal values are never embedded in the SQL text, each is passed as a parameter. Expressions with named
+// parameters must be translated using the ExpressionTree TranslateSqlWhere function. An error, naming every
+// function or operation without an SQL equivalent, will be returned if the expression cannot be translated.
+func TranslateSqlWhere(expression Expression, dialect SqlDialectEnum) (*SqlWhereClause, error) {
+	expressionTree := NewExpressionTree()
+	expressionTree.Root = expression
+
+	return expressionTree.TranslateSqlWhere(dialect)
+}
+
+// TranslateSqlWhere translates the Root expression of the ExpressionTree to a parameterized SQL condition for
+// the specified dialect. Parameter expressions are translated using the values assigned with SetParameters.
+// String comparisons, "LIKE" and "IN" expressions keep the case-insensitive filter expression semantics,
+// exact match operators are case-sensitive; for SQL Server, the database collation is expected to be case
+// insensitive. Related column paths, e.g., Device.Acronym, are translated to correlated sub-queries that
+// reference the table being filtered by its name. "TOP" and "ORDER BY" clauses are not translated. An error,
+// naming every function or operation without an SQL equivalent, will be returned if the expression cannot
+// be translated.
+func (et *ExpressionTree) TranslateSqlWhere(dialect SqlDialectEnum) (*SqlWhereClause, error) {
+	if dialect < SqlDialect.SQLite || dialect > SqlDialect.SqlServer {
+		return nil, errors.New("unexpected SQL dialect \"" + dialect.String() + "\"")
+	}
+
+	if et.Root == nil {
+		return nil, errors.New("cannot translate expression tree to SQL, root expression is nil")
+	}
+
+	translator := &sqlTranslator{
+		dialect:          dialect,
+		expressionTree:   et,
+		expressionTypes:  staticValueTypes(et.Root, et.parameters),
+		parameters:       make([]interface{}, 0),
+		parameterIndexes: make(map[string]int),
+	}
+
+	condition := translator.predicate(et.Root)
+
+	if len(translator.untranslatable) > 0 {
+		return nil, errors.New("cannot translate filter expression to " + dialect.String() + " SQL, no equivalent for " + strings.Join(translator.untranslatable, ", "))
+	}
+
+	return &SqlWhereClause{
+		Condition:  condition,
+		Parameters: translator.parameters,
+	}, nil
+}
+
+// sqlTranslator produces SQL text for expressions. Sub-expressions that cannot be translated are recorded
+// and translated as NULL so that all untranslatable expressions can be reported together.
+type sqlTranslator struct {
+	dialect          SqlDialectEnum
+	expressionTree   *ExpressionTree
+	expressionTypes  map[Expression]ExpressionValueTypeEnum
+	parameters       []interface{}
+	parameterIndexes map[string]int
+	untranslatable   []string
+	aliasCount       int
+}
+
+func (st *sqlTranslator) fail(description string) string {
+	for _, existing := range st.untranslatable {
+		if existing == description {
+			return "NULL"
+		}
+	}
+
+	st.untranslatable = append(st.untranslatable, description)
+	return "NULL"
+}
+
+// predicate translates an expression used as a condition. SQL Server has no boolean values, so any
+// expression that is not already an SQL predicate is compared to 1.
+func (st *sqlTranslator) predicate(expression Expression) string {
+	if st.isPredicate(expression) || st.dialect != SqlDialect.SqlServer {
+		return st.translate(expression)
+	}
+
+	return sqlOperand(st.translate(expression)) + " = 1"
+}
+
+// value translates an expression used as a value. SQL Server predicates are converted to 1, 0 or Null.
+func (st *sqlTranslator) value(expression Expression) string {
+	if st.dialect != SqlDialect.SqlServer || !st.isPredicate(expression) {
+		return st.translate(expression)
+	}
+
+	predicate := st.translate(expression)
+	return "CASE WHEN " + predicate + " THEN 1 WHEN NOT (" + predicate + ") THEN 0 END"
+}
+
+func (st *sqlTranslator) operand(expression Expression) string {
+	return sqlOperand(st.value(expression))
+}
+
+func (st *sqlTranslator) predicateOperand(expression Expression) string {
+	return sqlOperand(st.predicate(expression))
+}
+
+// sqlOperand parenthesizes SQL text that is not a single term, e.g., a column, parameter or function call.
+func sqlOperand(text string) string {
+	depth := 0
+	var delimiter rune
+
+	for _, char := range text {
+		switch {
+		case delimiter != 0:
+			if char == delimiter {
+				delimiter = 0
+			}
+		case char == '\'' || char == '"':
+			delimiter = char
+		case char == '[':
+			delimiter = ']'
+		case char == '(':
+			depth++
+		case char == ')':
+			depth--
+		case char == ' ' && depth == 0:
+			return "(" + text + ")"
+		}
+	}
+
+	// Avoid "--" which starts an SQL comment
+	if strings.HasPrefix(text, "-") {
+		return "(" + text + ")"
+	}
+
+	return text
+}
+
+//gocyclo:ignore
+func (st *sqlTranslator) isPredicate(expression Expression) bool {
+	switch expression.Type() {
+	case ExpressionType.Operator:
+		operatorType := expression.(*OperatorExpression).OperatorType()
+		return operatorType >= ExpressionOperatorType.LessThan && operatorType <= ExpressionOperatorType.Or
+	case ExpressionType.Unary:
+		unaryExpression := expression.(*UnaryExpression)
+		return unaryExpression.UnaryType() == ExpressionUnaryType.Not && st.expressionTypes[unaryExpression.Value()] == ExpressionValueType.Boolean
+	case ExpressionType.InList, ExpressionType.Between:
+		return true
+	case ExpressionType.Function:
+		switch expression.(*FunctionExpression).FunctionType() {
+		case ExpressionFunctionType.Contains, ExpressionFunctionType.StartsWith, ExpressionFunctionType.EndsWith, ExpressionFunctionType.RegExMatch:
+			return true
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+func (st *sqlTranslator) translate(expression Expression) string {
+	switch expression.Type() {
+	case ExpressionType.Value:
+		return st.literal(expression.(*ValueExpression))
+	case ExpressionType.Parameter:
+		return st.parameter(expression.(*ParameterExpression))
+	case ExpressionType.Column:
+		return st.column(expression.(*ColumnExpression))
+	case ExpressionType.Unary:
+		return st.unary(expression.(*UnaryExpression))
+	case ExpressionType.Operator:
+		return st.operator(expression.(*OperatorExpression))
+	case ExpressionType.InList:
+		return st.inList(expression.(*InListExpression))
+	case ExpressionType.Between:
+		return st.between(expression.(*BetweenExpression))
+	case ExpressionType.Case:
+		return st.caseExpression(expression.(*CaseExpression))
+	case ExpressionType.Function:
+		return st.function(expression.(*FunctionExpression))
+	case ExpressionType.Aggregate:
+		return st.fail("\"" + expression.(*AggregateExpression).AggregateType().String() + "\" aggregate function")
+	default:
+		return st.fail("\"" + expression.Type().String() + "\" expression")
+	}
+}
+
+// Values
+
+func (st *sqlTranslator) placeholder(index int) string {
+	switch st.dialect {
+	case SqlDialect.PostgreSQL:
+		return "$" + strconv.Itoa(index)
+	case SqlDialect.SqlServer:
+		return "@p" + strconv.Itoa(index)
+	default:
+		return "?" + strconv.Itoa(index)
+	}
+}
+
+func (st *sqlTranslator) addParameter(value interface{}) string {
+	st.parameters = append(st.parameters, value)
+	return st.placeholder(len(st.parameters))
+}
+
+func (st *sqlTranslator) literal(value *ValueExpression) string {
+	if value.IsNull() {
+		return "NULL"
+	}
+
+	return st.addParameter(sqlParameterValue(value))
+}
+
+func (st *sqlTranslator) parameter(parameter *ParameterExpression) string {
+	key := strings.ToUpper(parameter.Name())
+
+	// Named parameters referenced more than once share a placeholder
+	if index, ok := st.parameterIndexes[key]; ok {
+		return st.placeholder(index)
+	}
+
+	value, ok := st.expressionTree.parameters[key]
+
+	if !ok {
+		return st.fail("parameter \"@" + parameter.Name() + "\" with no value")
+	}
+
+	placeholder := st.addParameter(sqlParameterValue(value))
+	st.parameterIndexes[key] = len(st.parameters)
+
+	return placeholder
+}
+
+func sqlParameterValue(value *ValueExpression) interface{} {
+	if value.IsNull() {
+		return nil
+	}
+
+	switch value.ValueType() {
+	case ExpressionValueType.Boolean:
+		return value.booleanValue()
+	case ExpressionValueType.Int32:
+		return value.int32Value()
+	case ExpressionValueType.Int64:
+		return value.int64Value()
+	case ExpressionValueType.Decimal:
+		return value.decimalValue()
+	case ExpressionValueType.Double:
+		return value.doubleValue()
+	case ExpressionValueType.Guid:
+		return strings.Trim(value.guidValue().String(), "{}")
+	case ExpressionValueType.DateTime:
+		return value.dateTimeValue()
+	default:
+		return value.stringValue()
+	}
+}
+
+// constant gets the value of a literal or bound parameter expression.
+func (st *sqlTranslator) constant(expression Expression) (*ValueExpression, bool) {
+	return st.expressionTree.constantValue(expression)
+}
+
+// Columns
+
+func (st *sqlTranslator) identifier(name string) string {
+	if st.dialect == SqlDialect.SqlServer {
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	}
+
+	return "\"" + strings.ReplaceAll(name, "\"", "\"\"") + "\""
+}
+
+// column translates a column reference. Related columns are translated to correlated sub-queries
+// that select the parent table column matching the child table column, one per relation in the path.
+func (st *sqlTranslator) column(columnExpression *ColumnExpression) string {
+	relations := columnExpression.Relations()
+
+	if len(relations) == 0 {
+		return st.identifier(columnExpression.DataColumn().Name())
+	}
+
+	reference := st.identifier(relations[0].ChildTable().Name()) + "." + st.identifier(relations[0].ChildColumn().Name())
+
+	for i, relation := range relations {
+		st.aliasCount++
+		alias := "r" + strconv.Itoa(st.aliasCount)
+		selectColumn := columnExpression.DataColumn()
+
+		if i < len(relations)-1 {
+			selectColumn = relations[i+1].ChildColumn()
+		}
+
+		var query strings.Builder
+
+		query.WriteString("(SELECT ")
+
+		if st.dialect == SqlDialect.SqlServer {
+			query.WriteString("TOP 1 ")
+		}
+
+		query.WriteString(alias + "." + st.identifier(selectColumn.Name()))
+		query.WriteString(" FROM " + st.identifier(relation.ParentTable().Name()) + " " + alias)
+		query.WriteString(" WHERE " + alias + "." + st.identifier(relation.ParentColumn().Name()) + " = " + reference)
+
+		if st.dialect != SqlDialect.SqlServer {
+			query.WriteString(" LIMIT 1")
+		}
+
+		query.WriteRune(')')
+		reference = query.String()
+	}
+
+	return reference
+}
+
+// Operators
+
+func (st *sqlTranslator) unary(unaryExpression *UnaryExpression) string {
+	switch unaryExpression.UnaryType() {
+	case ExpressionUnaryType.Minus:
+		return "-" + st.operand(unaryExpression.Value())
+	case ExpressionUnaryType.Plus:
+		return st.value(unaryExpression.Value())
+	default:
+		if st.expressionTypes[unaryExpression.Value()] == ExpressionValueType.Boolean {
+			return "NOT " + st.predicateOperand(unaryExpression.Value())
+		}
+
+		return "~" + st.operand(unaryExpression.Value())
+	}
+}
+
+// isStringComparison determines if the operands are compared as strings.
+func (st *sqlTranslator) isStringComparison(leftValue, rightValue Expression) bool {
+	valueType, err := ExpressionOperatorType.Equal.deriveOperationValueType(st.expressionTypes[leftValue], st.expressionTypes[rightValue])
+	return err == nil && valueType == ExpressionValueType.String
+}
+
+// compare translates a comparison, keeping case-insensitive string comparison semantics unless exactMatch is set.
+func (st *sqlTranslator) compare(left string, operator string, right string, stringComparison, exactMatch bool) string {
+	if stringComparison {
+		switch {
+		case st.dialect == SqlDialect.SQLite && !exactMatch:
+			return left + " " + operator + " " + right + " COLLATE NOCASE"
+		case st.dialect == SqlDialect.PostgreSQL && !exactMatch:
+			return "LOWER(" + left + ") " + operator + " LOWER(" + right + ")"
+		case st.dialect == SqlDialect.SqlServer && exactMatch:
+			return left + " " + operator + " " + right + " COLLATE Latin1_General_CS_AS"
+		}
+	}
+
+	return left + " " + operator + " " + right
+}
+
+//gocyclo:ignore
+func (st *sqlTranslator) operator(operatorExpression *OperatorExpression) string {
+	operatorType := operatorExpression.OperatorType()
+	leftValue, rightValue := operatorExpression.LeftValue(), operatorExpression.RightValue()
+
+	switch operatorType {
+	case ExpressionOperatorType.And, ExpressionOperatorType.Or:
+		return st.predicateOperand(leftValue) + " " + operatorType.String() + " " + st.predicateOperand(rightValue)
+	case ExpressionOperatorType.IsNull:
+		return st.operand(leftValue) + " IS NULL"
+	case ExpressionOperatorType.IsNotNull:
+		return st.operand(leftValue) + " IS NOT NULL"
+	case ExpressionOperatorType.Like, ExpressionOperatorType.LikeExactMatch, ExpressionOperatorType.NotLike, ExpressionOperatorType.NotLikeExactMatch:
+		return st.like(operatorExpression)
+	}
+
+	left, right := st.operand(leftValue), st.operand(rightValue)
+
+	switch operatorType {
+	case ExpressionOperatorType.Add:
+		if st.expressionTypes[operatorExpression] == ExpressionValueType.String {
+			return st.concatenate(left, right)
+		}
+
+		return left + " + " + right
+	case ExpressionOperatorType.Concatenate:
+		return st.concatenate(left, right)
+	case ExpressionOperatorType.BitShiftLeft, ExpressionOperatorType.BitShiftRight:
+		if st.dialect == SqlDialect.SqlServer {
+			if operatorType == ExpressionOperatorType.BitShiftLeft {
+				return "LEFT_SHIFT(" + left + ", " + right + ")"
+			}
+
+			return "RIGHT_SHIFT(" + left + ", " + right + ")"
+		}
+
+		return left + " " + operatorType.String() + " " + right
+	case ExpressionOperatorType.BitwiseXor:
+		switch st.dialect {
+		case SqlDialect.SQLite:
+			return "(" + left + " | " + right + ") - (" + left + " & " + right + ")"
+		case SqlDialect.PostgreSQL:
+			return left + " # " + right
+		default:
+			return left + " ^ " + right
+		}
+	case ExpressionOperatorType.Equal, ExpressionOperatorType.EqualExactMatch:
+		return st.compare(left, "=", right, st.isStringComparison(leftValue, rightValue), operatorType == ExpressionOperatorType.EqualExactMatch)
+	case ExpressionOperatorType.NotEqual, ExpressionOperatorType.NotEqualExactMatch:
+		return st.compare(left, "<>", right, st.isStringComparison(leftValue, rightValue), operatorType == ExpressionOperatorType.NotEqualExactMatch)
+	case ExpressionOperatorType.LessThan, ExpressionOperatorType.LessThanOrEqual, ExpressionOperatorType.GreaterThan, ExpressionOperatorType.GreaterThanOrEqual:
+		return st.compare(left, operatorType.String(), right, st.isStringComparison(leftValue, rightValue), false)
+	default:
+		// Arithmetic and remaining bitwise operators share filter expression symbols
+		return left + " " + operatorType.String() + " " + right
+	}
+}
+
+func (st *sqlTranslator) concatenate(left, right string) string {
+	if st.dialect == SqlDialect.SqlServer {
+		return left + " + " + right
+	}
+
+	return left + " || " + right
+}
+
+// like translates a "LIKE" expression. Filter expression patterns only allow "*" or "%" wildcards at the start
+// and end of the pattern, so patterns must be constant to be converted to an escaped SQL pattern.
+//gocyclo:ignore
+func (st *sqlTranslator) like(operatorExpression *OperatorExpression) string {
+	operatorType := operatorExpression.OperatorType()
+	exactMatch := operatorType == ExpressionOperatorType.LikeExactMatch || operatorType == ExpressionOperatorType.NotLikeExactMatch
+	notLike := operatorType == ExpressionOperatorType.NotLike || operatorType == ExpressionOperatorType.NotLikeExactMatch
+
+	patternValue, ok := st.constant(operatorExpression.RightValue())
+
+	if !ok || patternValue.ValueType() != ExpressionValueType.String || patternValue.IsNull() {
+		return st.fail("\"LIKE\" expression with a pattern that is not a string literal")
+	}
+
+	pattern := strings.ReplaceAll(patternValue.stringValue(), "%", "*")
+	startsWithWildcard := strings.HasPrefix(pattern, "*")
+	endsWithWildcard := strings.HasSuffix(pattern, "*")
+	pattern = strings.TrimPrefix(pattern, "*")
+	pattern = strings.TrimSuffix(pattern, "*")
+
+	if strings.ContainsRune(pattern, '*') {
+		return st.fail("\"LIKE\" expression with pattern \"" + patternValue.stringValue() + "\"")
+	}
+
+	wildcard, escape := "%", ""
+	useGlob := st.dialect == SqlDialect.SQLite && exactMatch
+
+	if useGlob {
+		// SQLite GLOB is case-sensitive, special characters are escaped as character classes
+		wildcard = "*"
+		pattern = strings.NewReplacer("[", "[[]", "*", "[*]", "?", "[?]").Replace(pattern)
+	} else {
+		replacer := strings.NewReplacer("\\", "\\\\", "_", "\\_")
+
+		if st.dialect == SqlDialect.SqlServer {
+			replacer = strings.NewReplacer("\\", "\\\\", "_", "\\_", "[", "\\[")
+		}
+
+		pattern = replacer.Replace(pattern)
+		escape = " ESCAPE '\\'"
+	}
+
+	if startsWithWildcard {
+		pattern = wildcard + pattern
+	}
+
+	if endsWithWildcard && pattern != wildcard {
+		pattern += wildcard
+	}
+
+	left := st.operand(operatorExpression.LeftValue())
+	right := st.addParameter(pattern)
+	keyword := "LIKE"
+
+	switch {
+	case useGlob:
+		keyword = "GLOB"
+	case st.dialect == SqlDialect.PostgreSQL && !exactMatch:
+		keyword = "ILIKE"
+	case st.dialect == SqlDialect.SqlServer && exactMatch:
+		left += " COLLATE Latin1_General_CS_AS"
+	}
+
+	if notLike {
+		keyword = "NOT " + keyword
+	}
+
+	return left + " " + keyword + " " + right + escape
+}
+
+func (st *sqlTranslator) inList(inListExpression *InListExpression) string {
+	value := inListExpression.Value()
+	stringComparison := false
+	arguments := make([]string, len(inListExpression.Arguments()))
+
+	for i, argument := range inListExpression.Arguments() {
+		stringComparison = stringComparison || st.isStringComparison(value, argument)
+		arguments[i] = st.value(argument)
+	}
+
+	left := st.operand(value)
+
+	if stringComparison {
+		switch {
+		case st.dialect == SqlDialect.SQLite && !inListExpression.ExtactMatch():
+			left += " COLLATE NOCASE"
+		case st.dialect == SqlDialect.PostgreSQL && !inListExpression.ExtactMatch():
+			left = "LOWER(" + left + ")"
+
+			for i := range arguments {
+				arguments[i] = "LOWER(" + arguments[i] + ")"
+			}
+		case st.dialect == SqlDialect.SqlServer && inListExpression.ExtactMatch():
+			left += " COLLATE Latin1_General_CS_AS"
+		}
+	}
+
+	keyword := " IN ("
+
+	if inListExpression.HasNotKeyword() {
+		keyword = " NOT IN ("
+	}
+
+	return left + keyword + strings.Join(arguments, ", ") + ")"
+}
+
+func (st *sqlTranslator) between(betweenExpression *BetweenExpression) string {
+	value := betweenExpression.Value()
+	left := st.operand(value)
+	lower, upper := st.operand(betweenExpression.LowerBound()), st.operand(betweenExpression.UpperBound())
+
+	if st.isStringComparison(value, betweenExpression.LowerBound()) || st.isStringComparison(value, betweenExpression.UpperBound()) {
+		switch st.dialect {
+		case SqlDialect.SQLite:
+			left += " COLLATE NOCASE"
+		case SqlDialect.PostgreSQL:
+			left, lower, upper = "LOWER("+left+")", "LOWER("+lower+")", "LOWER("+upper+")"
+		}
+	}
+
+	keyword := " BETWEEN "
+
+	if betweenExpression.HasNotKeyword() {
+		keyword = " NOT BETWEEN "
+	}
+
+	return left + keyword + lower + " AND " + upper
+}
+
+func (st *sqlTranslator) caseExpression(caseExpression *CaseExpression) string {
+	var image strings.Builder
+
+	image.WriteString("CASE")
+
+	if caseExpression.Value() != nil {
+		image.WriteString(" " + st.operand(caseExpression.Value()))
+	}
+
+	for i, condition := range caseExpression.Conditions() {
+		if caseExpression.Value() == nil {
+			image.WriteString(" WHEN " + st.predicate(condition))
+		} else {
+			image.WriteString(" WHEN " + st.value(condition))
+		}
+
+		image.WriteString(" THEN " + st.value(caseExpression.Results()[i]))
+	}
+
+	if caseExpression.ElseResult() != nil {
+		image.WriteString(" ELSE " + st.value(caseExpression.ElseResult()))
+	}
+
+	image.WriteString(" END")
+
+	return image.String()
+}
+
+// Functions
+
+func (st *sqlTranslator) unsupportedFunction(functionExpression *FunctionExpression) string {
+	if functionExpression.FunctionType() == ExpressionFunctionType.UserDefined {
+		return st.fail("\"" + functionExpression.UserFunction().Name() + "\" user-defined function")
+	}
+
+	return st.fail("\"" + functionExpression.FunctionType().String() + "\" function")
+}
+
+// ignoreCase gets the value of the optional, constant, ignore case argument at the specified index.
+func (st *sqlTranslator) ignoreCase(functionExpression *FunctionExpression, index int) (bool, bool) {
+	arguments := functionExpression.Arguments()
+
+	if len(arguments) <= index {
+		return false, true
+	}
+
+	value, ok := st.constant(arguments[index])
+
+	if !ok {
+		return false, false
+	}
+
+	if value, err := value.Convert(ExpressionValueType.Boolean); err == nil {
+		return !value.IsNull() && value.booleanValue(), true
+	}
+
+	return false, false
+}
+
+// stringArguments translates the source and test arguments of a string function, applying case rules for
+// the optional ignore case argument at the specified index. Filter expression string functions are case
+// sensitive by default, so a case-sensitive collation is used for SQL Server.
+func (st *sqlTranslator) stringArguments(functionExpression *FunctionExpression, ignoreCaseIndex int) (string, string, bool) {
+	ignoreCase, ok := st.ignoreCase(functionExpression, ignoreCaseIndex)
+
+	if !ok {
+		st.fail("\"" + functionExpression.FunctionType().String() + "\" function with an ignore case value that is not a literal")
+		return "NULL", "NULL", false
+	}
+
+	arguments := functionExpression.Arguments()
+	source, test := st.value(arguments[0]), st.value(arguments[1])
+
+	if ignoreCase {
+		return "LOWER(" + source + ")", "LOWER(" + test + ")", true
+	}
+
+	if st.dialect == SqlDialect.SqlServer {
+		source += " COLLATE Latin1_General_CS_AS"
+	}
+
+	return source, test, true
+}
+
+func (st *sqlTranslator) length(text string) string {
+	if st.dialect == SqlDialect.SqlServer {
+		return "LEN(" + text + ")"
+	}
+
+	return "LENGTH(" + text + ")"
+}
+
+// position gets the 1-based position of test in source, or 0 when not found.
+func (st *sqlTranslator) position(source, test string) string {
+	switch st.dialect {
+	case SqlDialect.PostgreSQL:
+		return "STRPOS(" + source + ", " + test + ")"
+	case SqlDialect.SqlServer:
+		return "CHARINDEX(" + test + ", " + source + ")"
+	default:
+		return "INSTR(" + source + ", " + test + ")"
+	}
+}
+
+func (st *sqlTranslator) valueList(arguments []Expression) string {
+	values := make([]string, len(arguments))
+
+	for i, argument := range arguments {
+		values[i] = st.value(argument)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+//gocyclo:ignore
+func (st *sqlTranslator) function(functionExpression *FunctionExpression) string {
+	arguments := functionExpression.Arguments()
+	functionType := functionExpression.FunctionType()
+
+	if signature, ok := functionSignatures[functionType]; ok {
+		if len(arguments) < signature.minArguments || signature.maxArguments > -1 && len(arguments) > signature.maxArguments {
+			return st.fail("\"" + functionType.String() + "\" function with " + strconv.Itoa(len(arguments)) + " arguments")
+		}
+	}
+
+	argument := func(index int) string {
+		return st.value(arguments[index])
+	}
+
+	switch functionType {
+	case ExpressionFunctionType.Abs:
+		return "ABS(" + argument(0) + ")"
+	case ExpressionFunctionType.Ceiling:
+		if st.dialect == SqlDialect.PostgreSQL {
+			return "CEIL(" + argument(0) + ")"
+		}
+
+		return "CEILING(" + argument(0) + ")"
+	case ExpressionFunctionType.Floor:
+		return "FLOOR(" + argument(0) + ")"
+	case ExpressionFunctionType.Round:
+		if st.dialect == SqlDialect.SqlServer {
+			return "ROUND(" + argument(0) + ", 0)"
+		}
+
+		return "ROUND(" + argument(0) + ")"
+	case ExpressionFunctionType.Sqrt:
+		return "SQRT(" + argument(0) + ")"
+	case ExpressionFunctionType.Power:
+		return "POWER(" + argument(0) + ", " + argument(1) + ")"
+	case ExpressionFunctionType.Coalesce, ExpressionFunctionType.IsNull:
+		return "COALESCE(" + st.valueList(arguments) + ")"
+	case ExpressionFunctionType.MaxOf:
+		if st.dialect == SqlDialect.SQLite {
+			return "MAX(" + st.valueList(arguments) + ")"
+		}
+
+		return "GREATEST(" + st.valueList(arguments) + ")"
+	case ExpressionFunctionType.MinOf:
+		if st.dialect == SqlDialect.SQLite {
+			return "MIN(" + st.valueList(arguments) + ")"
+		}
+
+		return "LEAST(" + st.valueList(arguments) + ")"
+	case ExpressionFunctionType.Convert:
+		return st.convert(functionExpression)
+	case ExpressionFunctionType.IIf:
+		return "CASE WHEN " + st.predicate(arguments[0]) + " THEN " + argument(1) + " ELSE " + argument(2) + " END"
+	case ExpressionFunctionType.Contains:
+		source, test, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		return st.position(source, test) + " > 0"
+	case ExpressionFunctionType.StartsWith, ExpressionFunctionType.EndsWith:
+		source, test, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		var prefix string
+
+		switch {
+		case st.dialect != SqlDialect.SQLite && functionType == ExpressionFunctionType.StartsWith:
+			prefix = "LEFT(" + source + ", " + st.length(test) + ")"
+		case st.dialect != SqlDialect.SQLite:
+			prefix = "RIGHT(" + source + ", " + st.length(test) + ")"
+		case functionType == ExpressionFunctionType.StartsWith:
+			prefix = "SUBSTR(" + source + ", 1, " + st.length(test) + ")"
+		default:
+			prefix = "SUBSTR(" + source + ", -" + st.length(test) + ")"
+		}
+
+		return prefix + " = " + test
+	case ExpressionFunctionType.IndexOf:
+		source, test, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		return st.position(source, test) + " - 1"
+	case ExpressionFunctionType.LastIndexOf:
+		if st.dialect == SqlDialect.SQLite {
+			return st.unsupportedFunction(functionExpression)
+		}
+
+		source, test, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		return "CASE WHEN " + st.position(source, test) + " = 0 THEN -1 ELSE " + st.length(source) + " - " +
+			st.position("REVERSE("+source+")", "REVERSE("+test+")") + " - " + st.length(test) + " + 1 END"
+	case ExpressionFunctionType.StrCount:
+		source, test, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		return "(" + st.length(source) + " - " + st.length("REPLACE("+source+", "+test+", '')") + ") / " + st.length(test)
+	case ExpressionFunctionType.StrCmp:
+		left, right, ok := st.stringArguments(functionExpression, 2)
+
+		if !ok {
+			return "NULL"
+		}
+
+		return "CASE WHEN " + left + " < " + right + " THEN -1 WHEN " + left + " > " + right + " THEN 1 ELSE 0 END"
+	case ExpressionFunctionType.Replace:
+		ignoreCase, ok := st.ignoreCase(functionExpression, 3)
+
+		if !ok || ignoreCase && st.dialect != SqlDialect.SqlServer {
+			return st.unsupportedFunction(functionExpression)
+		}
+
+		source := argument(0)
+
+		if st.dialect == SqlDialect.SqlServer {
+			if ignoreCase {
+				source += " COLLATE Latin1_General_CI_AS"
+			} else {
+				source += " COLLATE Latin1_General_CS_AS"
+			}
+		}
+
+		return "REPLACE(" + source + ", " + argument(1) + ", " + argument(2) + ")"
+	case ExpressionFunctionType.SubStr:
+		index := sqlOperand(argument(1)) + " + 1"
+
+		if st.dialect == SqlDialect.SqlServer {
+			source := argument(0)
+			length := st.length(source)
+
+			if len(arguments) > 2 {
+				length = argument(2)
+			}
+
+			return "SUBSTRING(" + source + ", " + index + ", " + length + ")"
+		}
+
+		if len(arguments) > 2 {
+			return "SUBSTR(" + argument(0) + ", " + index + ", " + argument(2) + ")"
+		}
+
+		return "SUBSTR(" + argument(0) + ", " + index + ")"
+	case ExpressionFunctionType.Split:
+		if ignoreCase, ok := st.ignoreCase(functionExpression, 3); st.dialect != SqlDialect.PostgreSQL || !ok || ignoreCase {
+			return st.unsupportedFunction(functionExpression)
+		}
+
+		return "SPLIT_PART(" + argument(0) + ", " + argument(1) + ", " + sqlOperand(argument(2)) + " + 1)"
+	case ExpressionFunctionType.Len:
+		return st.length(argument(0))
+	case ExpressionFunctionType.Lower:
+		return "LOWER(" + argument(0) + ")"
+	case ExpressionFunctionType.Upper:
+		return "UPPER(" + argument(0) + ")"
+	case ExpressionFunctionType.Trim:
+		if st.dialect == SqlDialect.SqlServer {
+			return "LTRIM(RTRIM(" + argument(0) + "))"
+		}
+
+		return "TRIM(" + argument(0) + ")"
+	case ExpressionFunctionType.TrimLeft:
+		return "LTRIM(" + argument(0) + ")"
+	case ExpressionFunctionType.TrimRight:
+		return "RTRIM(" + argument(0) + ")"
+	case ExpressionFunctionType.Reverse:
+		if st.dialect != SqlDialect.SQLite {
+			return "REVERSE(" + argument(0) + ")"
+		}
+	case ExpressionFunctionType.RegExMatch:
+		if st.dialect == SqlDialect.PostgreSQL {
+			return sqlOperand(argument(1)) + " ~ " + sqlOperand(argument(0))
+		}
+	case ExpressionFunctionType.Now:
+		switch st.dialect {
+		case SqlDialect.PostgreSQL:
+			return "LOCALTIMESTAMP"
+		case SqlDialect.SqlServer:
+			return "SYSDATETIME()"
+		default:
+			return "STRFTIME('%Y-%m-%d %H:%M:%f', 'now', 'localtime')"
+		}
+	case ExpressionFunctionType.UtcNow:
+		switch st.dialect {
+		case SqlDialect.PostgreSQL:
+			return "(NOW() AT TIME ZONE 'UTC')"
+		case SqlDialect.SqlServer:
+			return "SYSUTCDATETIME()"
+		default:
+			return "STRFTIME('%Y-%m-%d %H:%M:%f', 'now')"
+		}
+	case ExpressionFunctionType.DateAdd:
+		return st.dateAdd(functionExpression)
+	case ExpressionFunctionType.DateDiff:
+		return st.dateDiff(functionExpression)
+	case ExpressionFunctionType.DatePart:
+		return st.datePart(functionExpression)
+	}
+
+	return st.unsupportedFunction(functionExpression)
+}
+
+// sqlTypeNames maps value types to the dialect type used when translating "Convert" functions.
+var sqlTypeNames = map[SqlDialectEnum]map[ExpressionValueTypeEnum]string{
+	SqlDialect.SQLite: {
+		ExpressionValueType.Boolean:  "INTEGER",
+		ExpressionValueType.Int32:    "INTEGER",
+		ExpressionValueType.Int64:    "INTEGER",
+		ExpressionValueType.Decimal:  "NUMERIC",
+		ExpressionValueType.Double:   "REAL",
+		ExpressionValueType.String:   "TEXT",
+		ExpressionValueType.Guid:     "TEXT",
+		ExpressionValueType.DateTime: "TEXT",
+	},
+	SqlDialect.PostgreSQL: {
+		ExpressionValueType.Boolean:  "BOOLEAN",
+		ExpressionValueType.Int32:    "INTEGER",
+		ExpressionValueType.Int64:    "BIGINT",
+		ExpressionValueType.Decimal:  "NUMERIC",
+		ExpressionValueType.Double:   "DOUBLE PRECISION",
+		ExpressionValueType.String:   "TEXT",
+		ExpressionValueType.Guid:     "UUID",
+		ExpressionValueType.DateTime: "TIMESTAMP",
+	},
+	SqlDialect.SqlServer: {
+		ExpressionValueType.Boolean:  "BIT",
+		ExpressionValueType.Int32:    "INT",
+		ExpressionValueType.Int64:    "BIGINT",
+		ExpressionValueType.Decimal:  "DECIMAL(38, 10)",
+		ExpressionValueType.Double:   "FLOAT",
+		ExpressionValueType.String:   "NVARCHAR(MAX)",
+		ExpressionValueType.Guid:     "UNIQUEIDENTIFIER",
+		ExpressionValueType.DateTime: "DATETIME2",
+	},
+}
+
+func (st *sqlTranslator) convert(functionExpression *FunctionExpression) string {
+	arguments := functionExpression.Arguments()
+	targetType, ok := st.constant(arguments[1])
+
+	if !ok {
+		return st.fail("\"Convert\" function with a target type that is not a literal")
+	}
+
+	// Converting a Null value to the target type derives the result type
+	result, err := evaluateConstantExpression(NewFunctionExpression(ExpressionFunctionType.Convert, []Expression{NullValue(ExpressionValueType.Undefined), targetType}))
+
+	if err != nil {
+		return st.fail("\"Convert\" function target type \"" + targetType.String() + "\"")
+	}
+
+	return "CAST(" + st.value(arguments[0]) + " AS " + sqlTypeNames[st.dialect][result.ValueType()] + ")"
+}
+
+// timeInterval gets the value of the constant time interval argument at the specified index.
+func (st *sqlTranslator) timeInterval(functionExpression *FunctionExpression, index int) (TimeIntervalEnum, bool) {
+	value, ok := st.constant(functionExpression.Arguments()[index])
+
+	if !ok || value.ValueType() != ExpressionValueType.String || value.IsNull() {
+		st.fail("\"" + functionExpression.FunctionType().String() + "\" function with an interval that is not a literal")
+		return TimeInterval.Year, false
+	}
+
+	interval, err := ParseTimeInterval(value.stringValue())
+
+	if err != nil {
+		st.fail("\"" + functionExpression.FunctionType().String() + "\" function interval \"" + value.stringValue() + "\"")
+		return TimeInterval.Year, false
+	}
+
+	return interval, true
+}
+
+// intervalMilliseconds gets the number of milliseconds in fixed length time intervals.
+func intervalMilliseconds(interval TimeIntervalEnum) string {
+	switch interval {
+	case TimeInterval.Week:
+		return "604800000"
+	case TimeInterval.Hour:
+		return "3600000"
+	case TimeInterval.Minute:
+		return "60000"
+	case TimeInterval.Second:
+		return "1000"
+	case TimeInterval.Millisecond:
+		return "1"
+	default:
+		return "86400000"
+	}
+}
+
+// sqlServerDatePart gets the SQL Server date part name for the time interval.
+func sqlServerDatePart(interval TimeIntervalEnum) string {
+	switch interval {
+	case TimeInterval.Year:
+		return "year"
+	case TimeInterval.Month:
+		return "month"
+	case TimeInterval.DayOfYear:
+		return "dayofyear"
+	case TimeInterval.Week:
+		return "week"
+	case TimeInterval.Hour:
+		return "hour"
+	case TimeInterval.Minute:
+		return "minute"
+	case TimeInterval.Second:
+		return "second"
+	case TimeInterval.Millisecond:
+		return "millisecond"
+	default:
+		return "day"
+	}
+}
+
+//gocyclo:ignore
+func (st *sqlTranslator) dateAdd(functionExpression *FunctionExpression) string {
+	interval, ok := st.timeInterval(functionExpression, 2)
+
+	if !ok {
+		return "NULL"
+	}
+
+	arguments := functionExpression.Arguments()
+	source, value := st.value(arguments[0]), sqlOperand(st.value(arguments[1]))
+
+	switch st.dialect {
+	case SqlDialect.SqlServer:
+		datePart := sqlServerDatePart(interval)
+
+		if datePart == "dayofyear" {
+			datePart = "day"
+		}
+
+		return "DATEADD(" + datePart + ", " + value + ", " + source + ")"
+	case SqlDialect.PostgreSQL:
+		unit := "1 day"
+
+		switch interval {
+		case TimeInterval.Year:
+			unit = "1 year"
+		case TimeInterval.Month:
+			unit = "1 month"
+		case TimeInterval.Week:
+			unit = "1 week"
+		case TimeInterval.Hour:
+			unit = "1 hour"
+		case TimeInterval.Minute:
+			unit = "1 minute"
+		case TimeInterval.Second:
+			unit = "1 second"
+		case TimeInterval.Millisecond:
+			unit = "1 millisecond"
+		}
+
+		return sqlOperand(source) + " + " + value + " * INTERVAL '" + unit + "'"
+	default:
+		modifier := value + " || ' days'"
+
+		switch interval {
+		case TimeInterval.Year:
+			modifier = value + " || ' years'"
+		case TimeInterval.Month:
+			modifier = value + " || ' months'"
+		case TimeInterval.Week:
+			modifier = "(" + value + " * 7) || ' days'"
+		case TimeInterval.Hour:
+			modifier = value + " || ' hours'"
+		case TimeInterval.Minute:
+			modifier = value + " || ' minutes'"
+		case TimeInterval.Second:
+			modifier = value + " || ' seconds'"
+		case TimeInterval.Millisecond:
+			modifier = "(" + value + " / 1000.0) || ' seconds'"
+		}
+
+		return "STRFTIME('%Y-%m-%d %H:%M:%f', " + source + ", " + modifier + ")"
+	}
+}
+
+// dateDiff translates whole elapsed intervals from the first to the second date, matching filter expression
+// semantics where years and months are calendar differences and other intervals are truncated elapsed time.
+//gocyclo:ignore
+func (st *sqlTranslator) dateDiff(functionExpression *FunctionExpression) string {
+	interval, ok := st.timeInterval(functionExpression, 2)
+
+	if !ok {
+		return "NULL"
+	}
+
+	arguments := functionExpression.Arguments()
+	left, right := st.value(arguments[0]), st.value(arguments[1])
+
+	switch st.dialect {
+	case SqlDialect.SqlServer:
+		if interval == TimeInterval.Year || interval == TimeInterval.Month {
+			return "DATEDIFF(" + sqlServerDatePart(interval) + ", " + left + ", " + right + ")"
+		}
+
+		return "CAST(DATEDIFF_BIG(millisecond, " + left + ", " + right + ") / " + intervalMilliseconds(interval) + " AS INT)"
+	case SqlDialect.PostgreSQL:
+		years := "EXTRACT(YEAR FROM " + right + ") - EXTRACT(YEAR FROM " + left + ")"
+
+		switch interval {
+		case TimeInterval.Year:
+			return "CAST(" + years + " AS INTEGER)"
+		case TimeInterval.Month:
+			return "CAST((" + years + ") * 12 + EXTRACT(MONTH FROM " + right + ") - EXTRACT(MONTH FROM " + left + ") AS INTEGER)"
+		default:
+			return "CAST(TRUNC(EXTRACT(EPOCH FROM " + sqlOperand(right) + " - " + sqlOperand(left) + ") * 1000 / " + intervalMilliseconds(interval) + ") AS INTEGER)"
+		}
+	default:
+		years := "CAST(STRFTIME('%Y', " + right + ") AS INTEGER) - CAST(STRFTIME('%Y', " + left + ") AS INTEGER)"
+
+		switch interval {
+		case TimeInterval.Year:
+			return years
+		case TimeInterval.Month:
+			return "(" + years + ") * 12 + CAST(STRFTIME('%m', " + right + ") AS INTEGER) - CAST(STRFTIME('%m', " + left + ") AS INTEGER)"
+		default:
+			return "CAST((JULIANDAY(" + right + ") - JULIANDAY(" + left + ")) * 86400000 / " + intervalMilliseconds(interval) + " AS INTEGER)"
+		}
+	}
+}
+
+// datePart translates a date part, where the week day is 1 for Sunday and the week is the ISO 8601 week.
+//gocyclo:ignore
+func (st *sqlTranslator) datePart(functionExpression *FunctionExpression) string {
+	interval, ok := st.timeInterval(functionExpression, 1)
+
+	if !ok {
+		return "NULL"
+	}
+
+	source := st.value(functionExpression.Arguments()[0])
+
+	switch st.dialect {
+	case SqlDialect.SqlServer:
+		switch interval {
+		case TimeInterval.WeekDay:
+			// Independent of DATEFIRST setting, 1900-01-07 is a Sunday
+			return "(DATEDIFF(day, '19000107', " + source + ") % 7 + 7) % 7 + 1"
+		case TimeInterval.Week:
+			return "DATEPART(iso_week, " + source + ")"
+		default:
+			return "DATEPART(" + sqlServerDatePart(interval) + ", " + source + ")"
+		}
+	case SqlDialect.PostgreSQL:
+		switch interval {
+		case TimeInterval.WeekDay:
+			return "CAST(EXTRACT(DOW FROM " + source + ") AS INTEGER) + 1"
+		case TimeInterval.Millisecond:
+			return "CAST(FLOOR(EXTRACT(MILLISECONDS FROM " + source + ")) AS INTEGER) % 1000"
+		case TimeInterval.Second:
+			return "CAST(FLOOR(EXTRACT(SECOND FROM " + source + ")) AS INTEGER)"
+		}
+
+		field := map[TimeIntervalEnum]string{
+			TimeInterval.Year:      "YEAR",
+			TimeInterval.Month:     "MONTH",
+			TimeInterval.DayOfYear: "DOY",
+			TimeInterval.Day:       "DAY",
+			TimeInterval.Week:      "WEEK",
+			TimeInterval.Hour:      "HOUR",
+			TimeInterval.Minute:    "MINUTE",
+		}[interval]
+
+		return "CAST(EXTRACT(" + field + " FROM " + source + ") AS INTEGER)"
+	default:
+		switch interval {
+		case TimeInterval.Week:
+			return st.unsupportedFunction(functionExpression)
+		case TimeInterval.WeekDay:
+			return "CAST(STRFTIME('%w', " + source + ") AS INTEGER) + 1"
+		case TimeInterval.Millisecond:
+			return "CAST(STRFTIME('%f', " + source + ") * 1000 AS INTEGER) % 1000"
+		}
+
+		format := map[TimeIntervalEnum]string{
+			TimeInterval.Year:      "%Y",
+			TimeInterval.Month:     "%m",
+			TimeInterval.DayOfYear: "%j",
+			TimeInterval.Day:       "%d",
+			TimeInterval.Hour:      "%H",
+			TimeInterval.Minute:    "%M",
+			TimeInterval.Second:    "%S",
+		}[interval]
+
+		return "CAST(STRFTIME('" + format + "', " + source + ") AS INTEGER)"
+	}
+}
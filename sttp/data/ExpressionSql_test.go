@@ -0,0 +1,285 @@
+//******************************************************************************************************
+//  ExpressionSql_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func translateSqlWhere(t *testing.T, dataTable *DataTable, filterExpression string, dialect SqlDialectEnum) *SqlWhereClause {
+	expressionTree, err := GenerateExpressionTree(dataTable, filterExpression, true)
+
+	if err != nil {
+		t.Fatal("TestTranslateSqlWhere: failed to parse \"" + filterExpression + "\": " + err.Error())
+	}
+
+	whereClause, err := expressionTree.TranslateSqlWhere(dialect)
+
+	if err != nil {
+		t.Fatal("TestTranslateSqlWhere: failed to translate \"" + filterExpression + "\" to " + dialect.String() + ": " + err.Error())
+	}
+
+	return whereClause
+}
+
+func TestTranslateSqlWhere(t *testing.T) {
+	_, dataTable := createIndexedDataSet()
+
+	tests := []struct {
+		filterExpression string
+		sqlite           string
+		postgreSql       string
+		sqlServer        string
+		parameters       string
+	}{
+		{
+			"SignalType = 'FREQ' AND Value > 10.5",
+			`("SignalType" = ?1 COLLATE NOCASE) AND ("Value" > ?2)`,
+			`(LOWER("SignalType") = LOWER($1)) AND ("Value" > $2)`,
+			`([SignalType] = @p1) AND ([Value] > @p2)`,
+			"[FREQ 10.5]",
+		},
+		{
+			"SignalType === 'FREQ' OR PointTag LIKE 'GPA_TEST-1*'",
+			`("SignalType" = ?1) OR ("PointTag" LIKE ?2 ESCAPE '\')`,
+			`("SignalType" = $1) OR ("PointTag" ILIKE $2 ESCAPE '\')`,
+			`([SignalType] = @p1 COLLATE Latin1_General_CS_AS) OR ([PointTag] LIKE @p2 ESCAPE '\')`,
+			`[FREQ GPA\_TEST-1%]`,
+		},
+		{
+			"SignalType IN ('FREQ', 'dfdt') AND ID NOT BETWEEN 1 AND 10",
+			`("SignalType" COLLATE NOCASE IN (?1, ?2)) AND ("ID" NOT BETWEEN ?3 AND ?4)`,
+			`(LOWER("SignalType") IN (LOWER($1), LOWER($2))) AND ("ID" NOT BETWEEN $3 AND $4)`,
+			`([SignalType] IN (@p1, @p2)) AND ([ID] NOT BETWEEN @p3 AND @p4)`,
+			"[FREQ dfdt 1 10]",
+		},
+		{
+			"SignalID = {b1f0c5b3-3f0d-4ae1-a6f2-6d5d1f4f2f3a} OR SignalType IS NULL",
+			`("SignalID" = ?1) OR ("SignalType" IS NULL)`,
+			`("SignalID" = $1) OR ("SignalType" IS NULL)`,
+			`([SignalID] = @p1) OR ([SignalType] IS NULL)`,
+			"[b1f0c5b3-3f0d-4ae1-a6f2-6d5d1f4f2f3a]",
+		},
+		{
+			"IIf(Value > 1, ID, -ID) % 2 = 0",
+			`((CASE WHEN "Value" > ?1 THEN "ID" ELSE -"ID" END) % ?2) = ?3`,
+			`((CASE WHEN "Value" > $1 THEN "ID" ELSE -"ID" END) % $2) = $3`,
+			`((CASE WHEN [Value] > @p1 THEN [ID] ELSE -[ID] END) % @p2) = @p3`,
+			"[1 2 0]",
+		},
+		{
+			"(ID ^ 3) << 1 > 4",
+			`((("ID" | ?1) - ("ID" & ?1)) << ?2) > ?3`,
+			`(("ID" # $1) << $2) > $3`,
+			`LEFT_SHIFT(([ID] ^ @p1), @p2) > @p3`,
+			"[3 1 4]",
+		},
+		{
+			"Contains(PointTag, 'test', true) AND NOT StartsWith(PointTag, 'GPA')",
+			`(INSTR(LOWER("PointTag"), LOWER(?1)) > 0) AND (NOT (SUBSTR("PointTag", 1, LENGTH(?2)) = ?2))`,
+			`(STRPOS(LOWER("PointTag"), LOWER($1)) > 0) AND (NOT (LEFT("PointTag", LENGTH($2)) = $2))`,
+			`(CHARINDEX(LOWER(@p1), LOWER([PointTag])) > 0) AND (NOT (LEFT([PointTag] COLLATE Latin1_General_CS_AS, LEN(@p2)) = @p2))`,
+			"[test GPA]",
+		},
+		{
+			"SubStr(PointTag, 4) = 'TEST-1'",
+			`SUBSTR("PointTag", ?1 + 1) = ?2 COLLATE NOCASE`,
+			`LOWER(SUBSTR("PointTag", $1 + 1)) = LOWER($2)`,
+			`SUBSTRING([PointTag], @p1 + 1, LEN([PointTag])) = @p2`,
+			"[4 TEST-1]",
+		},
+		{
+			"IsNull(SignalType, 'NONE') = 'NONE' AND Len(Trim(PointTag)) > 0",
+			`(COALESCE("SignalType", ?1) = ?2 COLLATE NOCASE) AND (LENGTH(TRIM("PointTag")) > ?3)`,
+			`(LOWER(COALESCE("SignalType", $1)) = LOWER($2)) AND (LENGTH(TRIM("PointTag")) > $3)`,
+			`(COALESCE([SignalType], @p1) = @p2) AND (LEN(LTRIM(RTRIM([PointTag]))) > @p3)`,
+			"[NONE NONE 0]",
+		},
+		{
+			"Convert(ID, 'System.String') .. SignalType = '1FREQ'",
+			`(CAST("ID" AS TEXT) || "SignalType") = ?1 COLLATE NOCASE`,
+			`LOWER((CAST("ID" AS TEXT) || "SignalType")) = LOWER($1)`,
+			`(CAST([ID] AS NVARCHAR(MAX)) + [SignalType]) = @p1`,
+			"[1FREQ]",
+		},
+		{
+			"DateDiff(DateAdd(UtcNow(), -1, 'Day'), UtcNow(), 'Hour') = 24",
+			`CAST((JULIANDAY(STRFTIME('%Y-%m-%d %H:%M:%f', 'now')) - JULIANDAY(STRFTIME('%Y-%m-%d %H:%M:%f', STRFTIME('%Y-%m-%d %H:%M:%f', 'now'), (-?1) || ' days'))) * 86400000 / 3600000 AS INTEGER) = ?2`,
+			`CAST(TRUNC(EXTRACT(EPOCH FROM (NOW() AT TIME ZONE 'UTC') - ((NOW() AT TIME ZONE 'UTC') + (-$1) * INTERVAL '1 day')) * 1000 / 3600000) AS INTEGER) = $2`,
+			`CAST(DATEDIFF_BIG(millisecond, DATEADD(day, (-@p1), SYSUTCDATETIME()), SYSUTCDATETIME()) / 3600000 AS INT) = @p2`,
+			"[1 24]",
+		},
+		{
+			"DatePart(UtcNow(), 'WeekDay') = 1",
+			`(CAST(STRFTIME('%w', STRFTIME('%Y-%m-%d %H:%M:%f', 'now')) AS INTEGER) + 1) = ?1`,
+			`(CAST(EXTRACT(DOW FROM (NOW() AT TIME ZONE 'UTC')) AS INTEGER) + 1) = $1`,
+			`((DATEDIFF(day, '19000107', SYSUTCDATETIME()) % 7 + 7) % 7 + 1) = @p1`,
+			"[1]",
+		},
+	}
+
+	for _, test := range tests {
+		filterExpression := "FILTER ActiveMeasurements WHERE " + test.filterExpression
+
+		for dialect, expected := range map[SqlDialectEnum]string{SqlDialect.SQLite: test.sqlite, SqlDialect.PostgreSQL: test.postgreSql, SqlDialect.SqlServer: test.sqlServer} {
+			whereClause := translateSqlWhere(t, dataTable, filterExpression, dialect)
+
+			if whereClause.Condition != expected {
+				t.Fatal("TestTranslateSqlWhere: unexpected " + dialect.String() + " condition for \"" + test.filterExpression + "\": " + whereClause.Condition)
+			}
+
+			if parameters := fmt.Sprint(whereClause.Parameters); parameters != test.parameters {
+				t.Fatal("TestTranslateSqlWhere: unexpected " + dialect.String() + " parameters for \"" + test.filterExpression + "\": " + parameters)
+			}
+		}
+	}
+
+	// SQLite exact match patterns use case-sensitive GLOB matching
+	whereClause := translateSqlWhere(t, dataTable, "FILTER ActiveMeasurements WHERE PointTag NOT LIKE BINARY '%-1_%'", SqlDialect.SQLite)
+
+	if whereClause.Condition != `"PointTag" NOT GLOB ?1` || fmt.Sprint(whereClause.Parameters) != "[*-1_*]" {
+		t.Fatal("TestTranslateSqlWhere: unexpected SQLite exact match LIKE condition: " + whereClause.Condition)
+	}
+
+	whereClause = translateSqlWhere(t, dataTable, "FILTER ActiveMeasurements WHERE PointTag NOT LIKE BINARY '%-1_%'", SqlDialect.SqlServer)
+
+	if whereClause.Condition != `[PointTag] COLLATE Latin1_General_CS_AS NOT LIKE @p1 ESCAPE '\'` || fmt.Sprint(whereClause.Parameters) != `[%-1\_%]` {
+		t.Fatal("TestTranslateSqlWhere: unexpected SQL Server exact match LIKE condition: " + whereClause.Condition)
+	}
+}
+
+func TestTranslateSqlWhereRelations(t *testing.T) {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: error loading DataSet from XML document: " + err.Error())
+	}
+
+	expressionTrees, err := GenerateExpressionTrees(dataSet, "MeasurementDetail", "FILTER MeasurementDetail WHERE NOT Enabled AND SignalAcronym = @type AND Device.CompanyAcronym LIKE @pattern", true)
+
+	if err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to parse filter expression: " + err.Error())
+	}
+
+	expressionTree := expressionTrees[0]
+
+	if _, err = expressionTree.TranslateSqlWhere(SqlDialect.SQLite); err == nil || !strings.Contains(err.Error(), "\"@type\"") {
+		t.Fatal("TestTranslateSqlWhereRelations: expected unbound parameter error")
+	}
+
+	if err = expressionTree.SetParameters(map[string]interface{}{"type": "FREQ", "pattern": "T*"}); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to set parameters: " + err.Error())
+	}
+
+	whereClause, err := expressionTree.TranslateSqlWhere(SqlDialect.SqlServer)
+
+	if err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to translate filter expression: " + err.Error())
+	}
+
+	expected := `((NOT ([Enabled] = 1)) AND ([SignalAcronym] = @p1)) AND ((SELECT TOP 1 r1.[CompanyAcronym] FROM [DeviceDetail] r1 WHERE r1.[Acronym] = [MeasurementDetail].[DeviceAcronym]) LIKE @p2 ESCAPE '\')`
+
+	if whereClause.Condition != expected {
+		t.Fatal("TestTranslateSqlWhereRelations: unexpected condition: " + whereClause.Condition)
+	}
+
+	if parameters := fmt.Sprint(whereClause.Parameters); parameters != "[FREQ T%]" {
+		t.Fatal("TestTranslateSqlWhereRelations: unexpected parameters: " + parameters)
+	}
+
+	// Named parameters referenced more than once share a placeholder
+	if expressionTrees, err = GenerateExpressionTrees(dataSet, "MeasurementDetail", "FILTER MeasurementDetail WHERE SignalAcronym = @type OR PointTag = @TYPE", true); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to parse filter expression: " + err.Error())
+	}
+
+	if err = expressionTrees[0].SetParameters(map[string]interface{}{"type": "IPHM"}); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to set parameters: " + err.Error())
+	}
+
+	if whereClause, err = expressionTrees[0].TranslateSqlWhere(SqlDialect.PostgreSQL); err != nil {
+		t.Fatal("TestTranslateSqlWhereRelations: failed to translate filter expression: " + err.Error())
+	}
+
+	if whereClause.Condition != `(LOWER("SignalAcronym") = LOWER($1)) OR (LOWER("PointTag") = LOWER($1))` || len(whereClause.Parameters) != 1 {
+		t.Fatal("TestTranslateSqlWhereRelations: unexpected shared parameter condition: " + whereClause.Condition)
+	}
+}
+
+func TestTranslateSqlWhereErrors(t *testing.T) {
+	_, dataTable := createIndexedDataSet()
+
+	expressionTree, err := GenerateExpressionTree(dataTable, "FILTER ActiveMeasurements WHERE Split(PointTag, '-', 1) = '1' AND NthIndexOf(PointTag, '-', 1) > 0 AND RegExVal('\\d+', PointTag) = '1' AND IsNumeric(ID)", true)
+
+	if err != nil {
+		t.Fatal("TestTranslateSqlWhereErrors: failed to parse filter expression: " + err.Error())
+	}
+
+	// All untranslatable functions are reported together
+	_, err = expressionTree.TranslateSqlWhere(SqlDialect.SqlServer)
+
+	if err == nil || err.Error() != `cannot translate filter expression to SqlServer SQL, no equivalent for "Split" function, "NthIndexOf" function, "RegExVal" function, "IsNumeric" function` {
+		t.Fatal("TestTranslateSqlWhereErrors: unexpected error for untranslatable functions")
+	}
+
+	// PostgreSQL has an equivalent for Split
+	if _, err = expressionTree.TranslateSqlWhere(SqlDialect.PostgreSQL); err == nil || strings.Contains(err.Error(), "Split") {
+		t.Fatal("TestTranslateSqlWhereErrors: unexpected error for PostgreSQL Split function")
+	}
+
+	for _, filterExpression := range []string{
+		"SignalType LIKE 'F*Q'",
+		"SignalType LIKE PointTag",
+		"DatePart(UtcNow(), SignalType) = 1",
+		"LastIndexOf(PointTag, '-') = 8",
+		"SignalType = @type",
+	} {
+		expressionTree, err := GenerateExpressionTree(dataTable, "FILTER ActiveMeasurements WHERE "+filterExpression, true)
+
+		if err != nil {
+			t.Fatal("TestTranslateSqlWhereErrors: failed to parse \"" + filterExpression + "\": " + err.Error())
+		}
+
+		if _, err = expressionTree.TranslateSqlWhere(SqlDialect.SQLite); err == nil {
+			t.Fatal("TestTranslateSqlWhereErrors: expected error translating \"" + filterExpression + "\"")
+		}
+	}
+
+	if _, err = ParseSqlDialect("Oracle"); err == nil {
+		t.Fatal("TestTranslateSqlWhereErrors: expected error parsing unknown SQL dialect")
+	}
+
+	if dialect, err := ParseSqlDialect("postgresql"); err != nil || dialect != SqlDialect.PostgreSQL {
+		t.Fatal("TestTranslateSqlWhereErrors: failed to parse SQL dialect")
+	}
+}
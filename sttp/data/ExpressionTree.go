@@ -25,6 +25,7 @@ package data
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"regexp"
 	"sort"
@@ -43,17 +44,26 @@ var intSize = int(unsafe.Sizeof(uint(0)) * 8)
 
 // ExpressionTree represents a tree of expressions for evaluation.
 type ExpressionTree struct {
-	currentRow *DataRow
+	currentRow   *DataRow
+	currentGroup []*DataRow
+	parameters   map[string]*ValueExpression
 
-	// TableName represents the associated table name parsed from "FILTER" statement, if any.
+	// TableName represents the associated table name parsed from "FILTER" or "SELECT" statement, if any.
 	TableName string
 
 	// TopLimit represents the parsed value associated with the "TOP" keyword, if any.
 	TopLimit int
 
 	// OrderByTerms represents the order by elements parsed from the "ORDER BY" keyword, if any.
+	// For "SELECT" statements, term columns reference result columns by name.
 	OrderByTerms []*OrderByTerm
 
+	// SelectTerms represents the result columns parsed from a "SELECT" statement, if any.
+	SelectTerms []*SelectTerm
+
+	// GroupByColumns represents the columns parsed from the "GROUP BY" keyword, if any.
+	GroupByColumns []*DataColumn
+
 	// Root is the starting Expression for evaluation of the expression tree, or nil if there
 	// is not one. This is the root expression of the ExpressionTree. Value is automatically
 	// managed by FilterExpressionParser.
@@ -67,13 +77,102 @@ func NewExpressionTree() *ExpressionTree {
 	}
 }
 
+// String gets the ExpressionTree formatted as canonical filter expression syntax that can be parsed back
+// into an equivalent ExpressionTree, e.g., to normalize a user entered filter expression before storing it.
+// Expression trees parsed from a "FILTER" or "SELECT" statement are formatted as a statement, otherwise
+// only the Root expression is formatted, see ExpressionString.
+func (et *ExpressionTree) String() string {
+	if len(et.TableName) == 0 {
+		return ExpressionString(et.Root)
+	}
+
+	var image strings.Builder
+
+	if len(et.SelectTerms) > 0 {
+		image.WriteString("SELECT ")
+		et.writeTopLimit(&image)
+
+		for i, selectTerm := range et.SelectTerms {
+			if i > 0 {
+				image.WriteString(", ")
+			}
+
+			image.WriteString(ExpressionString(selectTerm.Expression))
+
+			// Alias is only needed when name differs from the one assigned by default
+			defaultName := "Column" + strconv.Itoa(i+1)
+
+			if columnExpression, ok := selectTerm.Expression.(*ColumnExpression); ok && columnExpression.DataColumn() != nil {
+				defaultName = columnExpression.DataColumn().Name()
+			}
+
+			if selectTerm.Name != defaultName {
+				image.WriteString(" AS " + IdentifierString(selectTerm.Name))
+			}
+		}
+
+		image.WriteString(" FROM " + IdentifierString(et.TableName))
+
+		// Select statements without a "WHERE" clause select all rows
+		if et.Root != nil && et.Root != True {
+			image.WriteString(" WHERE " + ExpressionString(et.Root))
+		}
+
+		for i, groupByColumn := range et.GroupByColumns {
+			if i == 0 {
+				image.WriteString(" GROUP BY ")
+			} else {
+				image.WriteString(", ")
+			}
+
+			image.WriteString(IdentifierString(groupByColumn.Name()))
+		}
+	} else {
+		image.WriteString("FILTER ")
+		et.writeTopLimit(&image)
+		image.WriteString(IdentifierString(et.TableName) + " WHERE ")
+
+		if et.Root == nil {
+			image.WriteString(True.Literal())
+		} else {
+			image.WriteString(ExpressionString(et.Root))
+		}
+	}
+
+	for i, orderByTerm := range et.OrderByTerms {
+		if i == 0 {
+			image.WriteString(" ORDER BY ")
+		} else {
+			image.WriteString(", ")
+		}
+
+		if orderByTerm.ExactMatch {
+			image.WriteString("BINARY ")
+		}
+
+		image.WriteString(IdentifierString(orderByTerm.Column.Name()))
+
+		if !orderByTerm.Ascending {
+			image.WriteString(" DESC")
+		}
+	}
+
+	return image.String()
+}
+
+func (et *ExpressionTree) writeTopLimit(image *strings.Builder) {
+	if et.TopLimit >= 0 {
+		image.WriteString("TOP " + strconv.Itoa(et.TopLimit) + " ")
+	}
+}
+
 // Select returns the rows matching the the ExpressionTree. The expression tree result type is expected
 // to be a Boolean for this filtering operation. This works like the "WHERE" clause of a SQL expression.
 // Any "TOP" limit and "ORDER BY" sorting clauses found in filter expressions will be respected. An
 // error will be returned if the table parameter is nil, the expression tree does not yield a boolean
 // value or any row expresssion evaluation fails.
 func (et *ExpressionTree) Select(table *DataTable) ([]*DataRow, error) {
-	return et.SelectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+	return et.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
 		// Final expression should have a boolean data type (operates as a WHERE clause)
 		if resultExpression.ValueType() != ExpressionValueType.Boolean {
 			return false, errors.New("cannot execute select operation, final expression tree evaluation did not result in a boolean value, result data type is \"" + resultExpression.ValueType().String() + "\"")
@@ -81,31 +180,58 @@ func (et *ExpressionTree) Select(table *DataTable) ([]*DataRow, error) {
 
 		// If final result is Null, i.e., has no value due to Null propagation, treat result as False
 		return resultExpression.booleanValue(), nil
-	}, true, true)
+	}, true, true, true)
 }
 
 // SelectWhere returns each table row evaluated from the ExpressionTree that matches the specified predicate expression.
 // The applyLimit and applySort flags determine if any encountered "TOP" limit and "ORDER BY" sorting clauses will be respected.
 // An error will be returned if the table parameter is nil or any row expresssion evaluation fails.
-//gocyclo: ignore
 func (et *ExpressionTree) SelectWhere(table *DataTable, predicate func(*ValueExpression) (bool, error), applyLimit bool, applySort bool) ([]*DataRow, error) {
+	return et.selectWhere(table, predicate, applyLimit, applySort, false)
+}
+
+// selectWhere returns each table row evaluated from the ExpressionTree that matches the specified predicate expression.
+// When useIndexes is true, any table indexes that apply to the expression tree are used to skip the evaluation of rows
+// that cannot match, as such the predicate must only match rows where the expression tree evaluates to True.
+//gocyclo:ignore
+func (et *ExpressionTree) selectWhere(table *DataTable, predicate func(*ValueExpression) (bool, error), applyLimit bool, applySort bool, useIndexes bool) ([]*DataRow, error) {
 	if table == nil {
 		return nil, errors.New("cannot execute select operation, table parameter is nil")
 	}
 
 	matchedRows := make([]*DataRow, 0)
+	var rowIndexes []int
+	var indexed bool
 	var row *DataRow
 	var resultExpression *ValueExpression
 	var result bool
 	var err error
 
+	if useIndexes {
+		if rowIndexes, indexed, err = et.indexedRows(table, et.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	rowCount := table.RowCount()
+
+	if indexed {
+		rowCount = len(rowIndexes)
+	}
+
 	// Find rows matching expression tree
-	for i := 0; i < table.RowCount(); i++ {
+	for i := 0; i < rowCount; i++ {
 		if applyLimit && et.TopLimit > -1 && len(matchedRows) >= et.TopLimit {
 			break
 		}
 
-		if row = table.Row(i); row == nil {
+		rowIndex := i
+
+		if indexed {
+			rowIndex = rowIndexes[i]
+		}
+
+		if row = table.Row(rowIndex); row == nil {
 			continue
 		}
 
@@ -159,6 +285,223 @@ func (et *ExpressionTree) SelectWhere(table *DataTable, predicate func(*ValueExp
 	return matchedRows, nil
 }
 
+// SelectTable returns a new DataTable with the result columns of a "SELECT" statement evaluated from the ExpressionTree.
+// Rows matching the Root expression, which works like a "WHERE" clause, are collected into groups based on any
+// GroupByColumns and each SelectTerm is evaluated once per group, where aggregate functions operate over the rows
+// in the group. Without a "GROUP BY" clause, all rows are a single group when any SelectTerm has an aggregate
+// function; otherwise, each row is its own group. Any "TOP" limit and "ORDER BY" sorting clauses are applied to the
+// result rows. An error will be returned if the table parameter is nil, no SelectTerms are defined, result column
+// names are duplicated or any expresssion evaluation fails.
+//gocyclo:ignore
+func (et *ExpressionTree) SelectTable(table *DataTable) (*DataTable, error) {
+	if table == nil {
+		return nil, errors.New("cannot execute select operation, table parameter is nil")
+	}
+
+	if len(et.SelectTerms) == 0 {
+		return nil, errors.New("cannot execute select operation, no select terms are defined")
+	}
+
+	matchedRows, err := et.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+		// Final expression should have a boolean data type (operates as a WHERE clause)
+		if resultExpression.ValueType() != ExpressionValueType.Boolean {
+			return false, errors.New("cannot execute select operation, final expression tree evaluation did not result in a boolean value, result data type is \"" + resultExpression.ValueType().String() + "\"")
+		}
+
+		// If final result is Null, i.e., has no value due to Null propagation, treat result as False
+		return resultExpression.booleanValue(), nil
+	}, false, false, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := et.groupRows(matchedRows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Evaluate select terms for each group
+	results := make([][]*ValueExpression, len(groups))
+
+	defer func() {
+		et.currentRow = nil
+		et.currentGroup = nil
+	}()
+
+	for i, group := range groups {
+		values := make([]*ValueExpression, len(et.SelectTerms))
+		et.currentGroup = group
+
+		if len(group) > 0 {
+			et.currentRow = group[0]
+		} else {
+			// Empty groups evaluate against a row of Null values so result value types can be derived
+			et.currentRow = table.CreateRow()
+		}
+
+		for j, selectTerm := range et.SelectTerms {
+			if values[j], err = et.evaluate(selectTerm.Expression); err != nil {
+				return nil, errors.New("failed while evaluating select term \"" + selectTerm.Name + "\": " + err.Error())
+			}
+		}
+
+		results[i] = values
+	}
+
+	// Create result table with column types derived from evaluated values
+	dataSet := NewDataSet()
+	result := dataSet.CreateTable(table.Name())
+	result.InitColumns(len(et.SelectTerms))
+
+	for j, selectTerm := range et.SelectTerms {
+		if result.ColumnByName(selectTerm.Name) != nil {
+			return nil, errors.New("cannot execute select operation, result column name \"" + selectTerm.Name + "\" is duplicated")
+		}
+
+		valueType := ExpressionValueType.Undefined
+
+		for _, values := range results {
+			if valueType = values[j].ValueType(); valueType != ExpressionValueType.Undefined {
+				break
+			}
+		}
+
+		result.AddColumn(result.CreateColumn(selectTerm.Name, valueTypeDataType(valueType), ""))
+	}
+
+	dataSet.AddTable(result)
+	result.InitRows(len(results))
+
+	for _, values := range results {
+		row := result.CreateRow()
+
+		for j, value := range values {
+			targetValueType := dataTypeValueType(result.Column(j).Type())
+
+			if value.ValueType() != targetValueType {
+				if value, err = value.Convert(targetValueType); err != nil {
+					return nil, errors.New("failed while converting select term \"" + et.SelectTerms[j].Name + "\" value: " + err.Error())
+				}
+			}
+
+			if err = row.SetValue(j, value.Value()); err != nil {
+				return nil, err
+			}
+		}
+
+		result.AddRow(row)
+	}
+
+	rows := result.Rows()
+
+	if len(rows) > 0 && len(et.OrderByTerms) > 0 {
+		orderByIndexes := make([]int, len(et.OrderByTerms))
+
+		for i, orderByTerm := range et.OrderByTerms {
+			if orderByIndexes[i] = result.ColumnIndex(orderByTerm.Column.Name()); orderByIndexes[i] < 0 {
+				return nil, errors.New("cannot execute select operation, failed to find order by result column \"" + orderByTerm.Column.Name() + "\"")
+			}
+		}
+
+		sort.SliceStable(rows, func(i, j int) bool {
+			leftResultRow := rows[i]
+			rightResultRow := rows[j]
+
+			for k, orderByTerm := range et.OrderByTerms {
+				var leftRow, rightRow *DataRow
+
+				if orderByTerm.Ascending {
+					leftRow = leftResultRow
+					rightRow = rightResultRow
+				} else {
+					leftRow = rightResultRow
+					rightRow = leftResultRow
+				}
+
+				result, _ := CompareDataRowColumns(leftRow, rightRow, orderByIndexes[k], orderByTerm.ExactMatch)
+
+				if result < 0 {
+					return true
+				}
+
+				if result > 0 {
+					return false
+				}
+
+				// Last compare result was equal, continue sort based on next order-by term
+			}
+
+			return false
+		})
+	}
+
+	if et.TopLimit > -1 && len(rows) > et.TopLimit {
+		result.InitRows(et.TopLimit)
+
+		for i := 0; i < et.TopLimit; i++ {
+			result.AddRow(rows[i])
+		}
+	}
+
+	return result, nil
+}
+
+// groupRows collects rows into groups based on the values of the GroupByColumns, in order of first appearance.
+func (et *ExpressionTree) groupRows(rows []*DataRow) ([][]*DataRow, error) {
+	if len(et.GroupByColumns) == 0 {
+		for _, selectTerm := range et.SelectTerms {
+			if hasAggregate(selectTerm.Expression) {
+				return [][]*DataRow{rows}, nil
+			}
+		}
+
+		groups := make([][]*DataRow, len(rows))
+
+		for i, row := range rows {
+			groups[i] = []*DataRow{row}
+		}
+
+		return groups, nil
+	}
+
+	groups := make([][]*DataRow, 0)
+	groupIndexes := make(map[string]int)
+	var key strings.Builder
+
+	for _, row := range rows {
+		key.Reset()
+
+		for _, column := range et.GroupByColumns {
+			value, err := row.Value(column.Index())
+
+			if err != nil {
+				return nil, errors.New("failed while getting group by column \"" + column.Name() + "\" value: " + err.Error())
+			}
+
+			// Distinguish null values from empty values in group key
+			if value == nil {
+				key.WriteString("\x00")
+			} else {
+				key.WriteString("\x01")
+				key.WriteString(fmt.Sprint(value))
+			}
+
+			key.WriteString("\x1F")
+		}
+
+		if index, ok := groupIndexes[key.String()]; ok {
+			groups[index] = append(groups[index], row)
+		} else {
+			groupIndexes[key.String()] = len(groups)
+			groups = append(groups, []*DataRow{row})
+		}
+	}
+
+	return groups, nil
+}
+
 // Evaluate traverses the the ExpressionTree for the provided dataRow to produce a ValueExpression.
 // Root expression should be assigned before calling Evaluate. The dataRow parameter can be nil if
 // there are no columns referenced in expression tree. An error will be returned if the expresssion
@@ -197,6 +540,25 @@ func (et *ExpressionTree) evaluateAs(expression Expression, targetValueType Expr
 		return et.evaluateFunction(expression)
 	case ExpressionType.Operator:
 		return et.evaluateOperator(expression)
+	case ExpressionType.Aggregate:
+		return et.evaluateAggregate(expression)
+	case ExpressionType.Between:
+		return et.evaluateBetween(expression)
+	case ExpressionType.Case:
+		return et.evaluateCase(expression, targetValueType)
+	case ExpressionType.Parameter:
+		valueExpression, err := et.evaluateParameter(expression)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Change Undefined NULL values to Nullable of target type
+		if valueExpression.ValueType() == ExpressionValueType.Undefined {
+			return NullValue(targetValueType), nil
+		}
+
+		return valueExpression, nil
 	default:
 		return nil, errors.New("unexpected expression type encountered")
 	}
@@ -257,70 +619,90 @@ func (et *ExpressionTree) evaluateColumn(expression Expression) (*ValueExpressio
 		return nil, errors.New("failed while evaluating column expression, data column reference is not defined")
 	}
 
+	row := et.currentRow
+
+	// Navigate any relations to find the row of the related table that contains the column
+	for _, relation := range columnExpression.Relations() {
+		if row, err = relation.ParentRow(row); err != nil {
+			return nil, errors.New("failed while evaluating column expression, " + err.Error())
+		}
+
+		if row == nil {
+			return NullValue(dataTypeValueType(column.Type())), nil
+		}
+	}
+
+	return dataRowColumnValue(row, column)
+}
+
+// dataRowColumnValue gets the value of the column for the row as a ValueExpression.
+//gocyclo:ignore
+func dataRowColumnValue(row *DataRow, column *DataColumn) (*ValueExpression, error) {
 	columnIndex := column.Index()
 	var valueType ExpressionValueTypeEnum
 	var value interface{}
 	var isNull bool
+	var err error
 
 	// Map column DataType to ExpressionType, storing equivalent literal value (can be nil)
 	switch column.Type() {
 	case DataType.String:
 		valueType = ExpressionValueType.String
-		value, isNull, err = et.currentRow.StringValue(columnIndex)
+		value, isNull, err = row.StringValue(columnIndex)
 	case DataType.Boolean:
 		valueType = ExpressionValueType.Boolean
-		value, isNull, err = et.currentRow.BooleanValue(columnIndex)
+		value, isNull, err = row.BooleanValue(columnIndex)
 	case DataType.DateTime:
 		valueType = ExpressionValueType.DateTime
-		value, isNull, err = et.currentRow.DateTimeValue(columnIndex)
+		value, isNull, err = row.DateTimeValue(columnIndex)
 	case DataType.Single:
 		var f32 float32
 		valueType = ExpressionValueType.Double
-		f32, isNull, err = et.currentRow.SingleValue(columnIndex)
+		f32, isNull, err = row.SingleValue(columnIndex)
 		value = float64(f32)
 	case DataType.Double:
 		valueType = ExpressionValueType.Double
-		value, isNull, err = et.currentRow.DoubleValue(columnIndex)
+		value, isNull, err = row.DoubleValue(columnIndex)
 	case DataType.Decimal:
 		valueType = ExpressionValueType.Decimal
-		value, isNull, err = et.currentRow.DecimalValue(columnIndex)
+		value, isNull, err = row.DecimalValue(columnIndex)
 	case DataType.Guid:
 		valueType = ExpressionValueType.Guid
-		value, isNull, err = et.currentRow.GuidValue(columnIndex)
+		value, isNull, err = row.GuidValue(columnIndex)
 	case DataType.Int8:
 		var i8 int8
 		valueType = ExpressionValueType.Int32
-		i8, isNull, err = et.currentRow.Int8Value(columnIndex)
+		i8, isNull, err = row.Int8Value(columnIndex)
 		value = int32(i8)
 	case DataType.Int16:
 		var i16 int16
 		valueType = ExpressionValueType.Int32
-		i16, isNull, err = et.currentRow.Int16Value(columnIndex)
+		i16, isNull, err = row.Int16Value(columnIndex)
 		value = int32(i16)
 	case DataType.Int32:
 		valueType = ExpressionValueType.Int32
-		value, isNull, err = et.currentRow.Int32Value(columnIndex)
+		value, isNull, err = row.Int32Value(columnIndex)
 	case DataType.Int64:
 		valueType = ExpressionValueType.Int64
-		value, isNull, err = et.currentRow.Int64Value(columnIndex)
+		value, isNull, err = row.Int64Value(columnIndex)
 	case DataType.UInt8:
 		var ui8 uint8
 		valueType = ExpressionValueType.Int32
-		ui8, isNull, err = et.currentRow.UInt8Value(columnIndex)
+		ui8, isNull, err = row.UInt8Value(columnIndex)
 		value = int32(ui8)
 	case DataType.UInt16:
 		var ui16 uint16
 		valueType = ExpressionValueType.Int32
-		ui16, isNull, err = et.currentRow.UInt16Value(columnIndex)
+		ui16, isNull, err = row.UInt16Value(columnIndex)
 		value = int32(ui16)
 	case DataType.UInt32:
 		var ui32 uint32
 		valueType = ExpressionValueType.Int64
-		ui32, isNull, err = et.currentRow.UInt32Value(columnIndex)
+		ui32, isNull, err = row.UInt32Value(columnIndex)
 		value = int64(ui32)
 	case DataType.UInt64:
 		var ui64 uint64
-		ui64, isNull, err = et.currentRow.UInt64Value(columnIndex)
+		ui64, isNull, err = row.UInt64Value(columnIndex)
 
 		if ui64 > math.MaxInt64 {
 			valueType = ExpressionValueType.Double
@@ -355,7 +737,7 @@ func (et *ExpressionTree) evaluateInList(expression Expression) (*ValueExpressio
 
 	// If in list test value is Null, result is Null
 	if inListValue.IsNull() {
-		return NullValue(inListValue.ValueType()), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	hasNotKeyWord := inListExpression.HasNotKeyword()
@@ -482,6 +864,8 @@ func (et *ExpressionTree) evaluateFunction(expression Expression) (*ValueExpress
 		return et.evaluateUpper(arguments)
 	case ExpressionFunctionType.UtcNow:
 		return et.evaluateUtcNow(arguments)
+	case ExpressionFunctionType.UserDefined:
+		return et.evaluateUserFunction(functionExpression)
 	default:
 		return nil, errors.New("unexpected function type encountered")
 	}
@@ -1349,6 +1733,8 @@ func (et *ExpressionTree) evaluateOperator(expression Expression) (*ValueExpress
 		return et.andOp(leftValue, rightValue)
 	case ExpressionOperatorType.Or:
 		return et.orOp(leftValue, rightValue)
+	case ExpressionOperatorType.Concatenate:
+		return et.concatenateOp(leftValue, rightValue)
 	default:
 		return nil, errors.New("unexpected operator type encountered")
 	}
@@ -2976,7 +3362,7 @@ func (et *ExpressionTree) bitwiseXorOp(leftValue, rightValue *ValueExpression, v
 func (et *ExpressionTree) lessThanOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3010,7 +3396,7 @@ func (et *ExpressionTree) lessThanOp(leftValue, rightValue *ValueExpression, val
 func (et *ExpressionTree) lessThanOrEqualOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3046,7 +3432,7 @@ func (et *ExpressionTree) lessThanOrEqualOp(leftValue, rightValue *ValueExpressi
 func (et *ExpressionTree) greaterThanOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3080,7 +3466,7 @@ func (et *ExpressionTree) greaterThanOp(leftValue, rightValue *ValueExpression,
 func (et *ExpressionTree) greaterThanOrEqualOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3116,7 +3502,7 @@ func (et *ExpressionTree) greaterThanOrEqualOp(leftValue, rightValue *ValueExpre
 func (et *ExpressionTree) equalOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum, exactMatch bool) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3153,7 +3539,7 @@ func (et *ExpressionTree) equalOp(leftValue, rightValue *ValueExpression, valueT
 func (et *ExpressionTree) notEqualOp(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum, exactMatch bool) (*ValueExpression, error) {
 	// If left or right value is Null, result is Null
 	if leftValue.IsNull() || rightValue.IsNull() {
-		return NullValue(valueType), nil
+		return NullValue(ExpressionValueType.Boolean), nil
 	}
 
 	if err := convertOperands(&leftValue, &rightValue, valueType); err != nil {
@@ -3318,6 +3704,19 @@ func (et *ExpressionTree) orOp(leftValue, rightValue *ValueExpression) (*ValueEx
 	return newValueExpression(ExpressionValueType.Boolean, leftValue.booleanValue() || rightValue.booleanValue()), nil
 }
 
+func (et *ExpressionTree) concatenateOp(leftValue, rightValue *ValueExpression) (*ValueExpression, error) {
+	// If left or right value is Null, result is Null
+	if leftValue.IsNull() || rightValue.IsNull() {
+		return NullValue(ExpressionValueType.String), nil
+	}
+
+	if err := convertOperands(&leftValue, &rightValue, ExpressionValueType.String); err != nil {
+		return nil, errors.New("concatenation \"..\" operator " + err.Error())
+	}
+
+	return newValueExpression(ExpressionValueType.String, leftValue.stringValue()+rightValue.stringValue()), nil
+}
+
 // https://play.golang.org/p/-zlKH7mfboa
 func findNthIndex(source, test string, index int) int {
 	var result int
@@ -2627,6 +2627,278 @@ func TestMiscExpressions(t *testing.T) {
 	}
 }
 
+func TestSqlOperatorExpressions(t *testing.T) {
+	var doc xml.XmlDocument
+	err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml")
+
+	if err != nil {
+		t.Fatal("TestSqlOperatorExpressions: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+	err = dataSet.ParseXmlDocument(&doc)
+
+	if err != nil {
+		t.Fatal("TestSqlOperatorExpressions: error loading DataSet from XML document: " + err.Error())
+	}
+
+	dataRows, err := dataSet.Table("PhasorDetail").Select("SourceIndex BETWEEN 2 AND 4", "SourceIndex", -1)
+
+	if err != nil {
+		t.Fatal("TestSqlOperatorExpressions: error during table Select: " + err.Error())
+	}
+
+	if len(dataRows) != 3 {
+		t.Fatal("TestSqlOperatorExpressions: expected 3 results, received: " + strconv.Itoa(len(dataRows)))
+	}
+
+	if dataRows, err = dataSet.Table("PhasorDetail").Select("SourceIndex NOT BETWEEN 2 AND 4 AND Type = 'V'", "", -1); err != nil {
+		t.Fatal("TestSqlOperatorExpressions: error during table Select: " + err.Error())
+	}
+
+	if len(dataRows) != 1 {
+		t.Fatal("TestSqlOperatorExpressions: expected 1 result, received: " + strconv.Itoa(len(dataRows)))
+	}
+
+	if dataRows, err = dataSet.Table("PhasorDetail").Select("CASE Type WHEN 'V' THEN 'Voltage' WHEN 'I' THEN 'Current' END = 'Current'", "", -1); err != nil {
+		t.Fatal("TestSqlOperatorExpressions: error during table Select: " + err.Error())
+	}
+
+	if len(dataRows) != 3 {
+		t.Fatal("TestSqlOperatorExpressions: expected 3 results, received: " + strconv.Itoa(len(dataRows)))
+	}
+
+	dataRow := dataSet.Table("PhasorDetail").Row(0)
+
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"DeviceAcronym .. '-' .. Type .. SourceIndex", "SHELBY-V1"},
+		{"'Phasor ' .. ID .. ' of ' .. 5", "Phasor 1 of 5"},
+		{"1 .. 2", "12"},
+		{"CASE WHEN SourceIndex > 1 THEN 'High' WHEN SourceIndex = 1 THEN 'One' ELSE 'Low' END", "One"},
+		{"CASE SourceIndex WHEN 0 THEN 'Zero' WHEN 1.0 THEN 'One' END", "One"},
+		{"CASE WHEN Phase IS NULL THEN 'Unknown' ELSE Phase END .. Type", "+V"},
+		{"Len(CASE Type WHEN 'I' THEN 'Current' ELSE 'Voltage' END)", "7"},
+		{"CASE WHEN 2.5 BETWEEN 2 AND SourceIndex * 3 THEN 'Yes' ELSE 'No' END", "Yes"},
+		{"'b' BETWEEN 'A' AND 'C' AND 5 NOT BETWEEN 6 AND 10", "true"},
+	}
+
+	for _, test := range tests {
+		result, err := EvaluateDataRowExpression(dataRow, test.expression, false)
+
+		if err != nil {
+			t.Fatal("TestSqlOperatorExpressions: error evaluating \"" + test.expression + "\": " + err.Error())
+		}
+
+		if result.String() != test.expected {
+			t.Fatal("TestSqlOperatorExpressions: expected \"" + test.expected + "\" for \"" + test.expression + "\", received: \"" + result.String() + "\"")
+		}
+	}
+
+	// Null propagation
+	for _, expression := range []string{
+		"NULL .. 'a'",
+		"'a' .. Convert(NULL, 'System.String')",
+		"NULL BETWEEN 1 AND 2",
+		"1 BETWEEN NULL AND 2",
+		"1 NOT BETWEEN 0 AND NULL",
+		"CASE SourceIndex WHEN 0 THEN 'Zero' END",
+		"CASE NULL WHEN NULL THEN 'Null' END",
+		"CASE WHEN NULL THEN 'Null' END",
+	} {
+		result, err := EvaluateDataRowExpression(dataRow, expression, false)
+
+		if err != nil {
+			t.Fatal("TestSqlOperatorExpressions: error evaluating \"" + expression + "\": " + err.Error())
+		}
+
+		if !result.IsNull() {
+			t.Fatal("TestSqlOperatorExpressions: expected Null for \"" + expression + "\", received: \"" + result.String() + "\"")
+		}
+	}
+
+	for _, expression := range []string{
+		"CASE WHEN SourceIndex THEN 'One' END",
+		"UpdatedOn BETWEEN 1 AND 2",
+	} {
+		if _, err := EvaluateDataRowExpression(dataRow, expression, false); err == nil {
+			t.Fatal("TestSqlOperatorExpressions: expected error evaluating \"" + expression + "\"")
+		}
+	}
+}
+
+func TestParserIdentifiersLiteralsAndOrdering(t *testing.T) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Measurement")
+	idField := createDataColumn(dataTable, "ID", DataType.Int32)
+	pointTagField := createDataColumn(dataTable, "Point Tag", DataType.String)
+
+	for i, pointTag := range []string{"abc", "ABD", "Abe", "o's"} {
+		row := dataTable.CreateRow()
+		row.SetValue(idField, int32(i+1))
+		row.SetValue(pointTagField, pointTag)
+		dataTable.AddRow(row)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	tests := []struct {
+		filterExpression string
+		expected         []int32
+	}{
+		// Delimited identifiers resolve tables and columns, ordering is case-insensitive by default
+		{"FILTER [Measurement] WHERE [Point Tag] <> 'o''s' ORDER BY `Point Tag`", []int32{1, 2, 3}},
+		{"FILTER Measurement WHERE ID < 4 ORDER BY [Point Tag] DESC", []int32{3, 2, 1}},
+
+		// BINARY modifier orders using an exact match, i.e., case-sensitive, comparison
+		{"FILTER Measurement WHERE ID < 4 ORDER BY BINARY [Point Tag]", []int32{2, 3, 1}},
+
+		// Doubled quotes in string literals are unescaped
+		{"FILTER Measurement WHERE [Point Tag] = 'O''S'", []int32{4}},
+		{"FILTER Measurement WHERE [Point Tag] === 'o''s' OR Len('''') = 2", []int32{4}},
+	}
+
+	for _, test := range tests {
+		rows, err := SelectDataRows(dataSet, test.filterExpression, "Measurement", nil, true)
+
+		if err != nil {
+			t.Fatal("TestParserIdentifiersLiteralsAndOrdering: error executing SelectDataRows for \"" + test.filterExpression + "\": " + err.Error())
+		}
+
+		if len(rows) != len(test.expected) {
+			t.Fatal("TestParserIdentifiersLiteralsAndOrdering: expected " + strconv.Itoa(len(test.expected)) + " rows for \"" + test.filterExpression + "\", received: " + strconv.Itoa(len(rows)))
+		}
+
+		for i, row := range rows {
+			if id, _, _ := row.Int32Value(idField); id != test.expected[i] {
+				t.Fatal("TestParserIdentifiersLiteralsAndOrdering: unexpected row order for \"" + test.filterExpression + "\"")
+			}
+		}
+	}
+
+	result, err := EvaluateDataRowExpression(dataTable.Row(0), "Len('''') .. 'it''s'", false)
+
+	if err != nil {
+		t.Fatal("TestParserIdentifiersLiteralsAndOrdering: error evaluating expression: " + err.Error())
+	}
+
+	if result.String() != "1it's" {
+		t.Fatal("TestParserIdentifiersLiteralsAndOrdering: expected \"1it's\", received: \"" + result.String() + "\"")
+	}
+}
+
+func TestExpressionTreeString(t *testing.T) {
+	var doc xml.XmlDocument
+	err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml")
+
+	if err != nil {
+		t.Fatal("TestExpressionTreeString: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+	err = dataSet.ParseXmlDocument(&doc)
+
+	if err != nil {
+		t.Fatal("TestExpressionTreeString: error loading DataSet from XML document: " + err.Error())
+	}
+
+	tests := []struct {
+		filterExpression string
+		expected         string
+	}{
+		{"filter TOP 5 MeasurementDetail where signalacronym='FREQ' and not internal order by pointtag desc", "FILTER TOP 5 MeasurementDetail WHERE SignalAcronym = 'FREQ' AND NOT (Internal) ORDER BY PointTag DESC"},
+		{"FILTER MeasurementDetail WHERE PhasorSourceIndex between 1 and 3 || Description LIKE '%o''s%' && PointTag IS NOT NULL", "FILTER MeasurementDetail WHERE (PhasorSourceIndex BETWEEN 1 AND 3 OR Description LIKE '%o''s%') AND PointTag IS NOT NULL"},
+		{"FILTER MeasurementDetail WHERE Len(PointTag) + 1 * 2 > 10 AND 1 - (2 - 3) <> 2 - 3 - 4", "FILTER MeasurementDetail WHERE (Len(PointTag) + 1) * 2 > 10 AND 1 - (2 - 3) <> 2 - 3 - 4"},
+		{"FILTER MeasurementDetail WHERE SignalAcronym IN ('FREQ', 'DFDT') AND NOT ID NOT IN BINARY ('x') ORDER BY BINARY [ID], SignalAcronym ASC", "FILTER MeasurementDetail WHERE SignalAcronym IN ('FREQ', 'DFDT') AND NOT (ID NOT IN BINARY ('x')) ORDER BY BINARY ID, SignalAcronym"},
+		{"FILTER MeasurementDetail WHERE ~PhasorSourceIndex < -100 AND !(PhasorSourceIndex < -100) AND NOT NOT Enabled", "FILTER MeasurementDetail WHERE (NOT (PhasorSourceIndex)) < -100 AND NOT (PhasorSourceIndex < -100) AND NOT (NOT (Enabled))"},
+		{"FILTER MeasurementDetail WHERE -~PhasorSourceIndex BETWEEN ~2 AND 3 OR NOT Enabled = False", "FILTER MeasurementDetail WHERE -(NOT (PhasorSourceIndex)) BETWEEN (NOT (2)) AND 3 OR NOT (Enabled = FALSE)"},
+		{"FILTER MeasurementDetail WHERE -PhasorSourceIndex < -1.5 OR PhasorSourceIndex * -2 >= 1e3 OR PhasorSourceIndex = 2.0", "FILTER MeasurementDetail WHERE -PhasorSourceIndex < -1.5 OR PhasorSourceIndex * -2 >= 1E+03 OR PhasorSourceIndex = 2.0"},
+		{"FILTER MeasurementDetail WHERE CASE WHEN Enabled THEN 'On' ELSE 'Off' END .. [Description] = 'On' OR Device.CompanyAcronym === 'TVA'", "FILTER MeasurementDetail WHERE CASE WHEN Enabled THEN 'On' ELSE 'Off' END .. Description = 'On' OR Device.CompanyAcronym === 'TVA'"},
+		{"FILTER MeasurementDetail WHERE UpdatedOn > #2020-01-01# AND SignalID <> '00000000-0000-0000-0000-000000000001' AND Enabled = true", "FILTER MeasurementDetail WHERE UpdatedOn > #2020-01-01T00:00:00Z# AND SignalID <> {00000000-0000-0000-0000-000000000001} AND Enabled = TRUE"},
+		{"FILTER MeasurementDetail WHERE PhasorSourceIndex & 3 << 1 = 2 AND (PhasorSourceIndex IS NULL) = False", "FILTER MeasurementDetail WHERE (PhasorSourceIndex & 3) << 1 = 2 AND (PhasorSourceIndex IS NULL) = FALSE"},
+		{"select DeviceAcronym, count(*) as Total, Max(PhasorSourceIndex) FROM MeasurementDetail GROUP BY DeviceAcronym ORDER BY Total DESC", "SELECT DeviceAcronym, COUNT(*) AS Total, MAX(PhasorSourceIndex) FROM MeasurementDetail GROUP BY DeviceAcronym ORDER BY Total DESC"},
+		{"SELECT TOP 2 PointTag AS [Point Tag] FROM MeasurementDetail WHERE Coalesce(@name, 'x') = SignalAcronym", "SELECT TOP 2 PointTag AS [Point Tag] FROM MeasurementDetail WHERE Coalesce(@name, 'x') = SignalAcronym"},
+		{"Enabled AND (DeviceAcronym = 'SHELBY' OR IsNull(PhasorSourceIndex, 0) != 0)", "Enabled AND (DeviceAcronym = 'SHELBY' OR IsNull(PhasorSourceIndex, 0) <> 0)"},
+	}
+
+	for _, test := range tests {
+		expressionTrees, err := GenerateExpressionTrees(dataSet, "MeasurementDetail", test.filterExpression, true)
+
+		if err != nil || len(expressionTrees) != 1 {
+			t.Fatal("TestExpressionTreeString: failed to parse \"" + test.filterExpression + "\"")
+		}
+
+		canonical := expressionTrees[0].String()
+
+		if canonical != test.expected {
+			t.Fatal("TestExpressionTreeString: expected \"" + test.expected + "\", received: \"" + canonical + "\"")
+		}
+
+		// Canonical filter expressions must parse back into an equivalent expression tree
+		reparsedTrees, err := GenerateExpressionTrees(dataSet, "MeasurementDetail", canonical, true)
+
+		if err != nil || len(reparsedTrees) != 1 {
+			t.Fatal("TestExpressionTreeString: failed to parse canonical expression \"" + canonical + "\"")
+		}
+
+		if reparsedTrees[0].String() != canonical {
+			t.Fatal("TestExpressionTreeString: expected reparsed expression \"" + canonical + "\", received: \"" + reparsedTrees[0].String() + "\"")
+		}
+
+		if !strings.HasPrefix(canonical, "FILTER") {
+			continue
+		}
+
+		expected, err := SelectDataRows(dataSet, test.filterExpression, "MeasurementDetail", nil, true)
+
+		if err != nil {
+			t.Fatal("TestExpressionTreeString: error executing SelectDataRows: " + err.Error())
+		}
+
+		rows, err := SelectDataRows(dataSet, canonical, "MeasurementDetail", nil, true)
+
+		if err != nil {
+			t.Fatal("TestExpressionTreeString: error executing SelectDataRows: " + err.Error())
+		}
+
+		if len(rows) != len(expected) {
+			t.Fatal("TestExpressionTreeString: expected " + strconv.Itoa(len(expected)) + " rows for \"" + canonical + "\", received: " + strconv.Itoa(len(rows)))
+		}
+
+		for i := range rows {
+			if rows[i] != expected[i] {
+				t.Fatal("TestExpressionTreeString: unexpected row order for \"" + canonical + "\"")
+			}
+		}
+	}
+
+	for name, expected := range map[string]string{"ID": "ID", "Point Tag": "[Point Tag]", "End": "[End]", "true": "[true]", "2nd": "[2nd]", "a]b": "`a]b`", "[ID]": "`[ID]`"} {
+		if identifier := IdentifierString(name); identifier != expected {
+			t.Fatal("TestExpressionTreeString: expected identifier \"" + expected + "\", received: \"" + identifier + "\"")
+		}
+	}
+
+	for _, value := range []*ValueExpression{
+		NewValueExpression(ExpressionValueType.String, "It's"),
+		NewValueExpression(ExpressionValueType.Double, math.Inf(-1)),
+		NewValueExpression(ExpressionValueType.Decimal, decimal.NewFromInt(5)),
+		NewValueExpression(ExpressionValueType.Int64, int64(math.MaxInt64)),
+		NewValueExpression(ExpressionValueType.DateTime, time.Date(2026, 10, 18, 1, 2, 3, 4000, time.UTC)),
+	} {
+		result, err := EvaluateExpression(value.Literal(), true)
+
+		if err != nil {
+			t.Fatal("TestExpressionTreeString: error evaluating literal \"" + value.Literal() + "\": " + err.Error())
+		}
+
+		if result.ValueType() != value.ValueType() || result.String() != value.String() {
+			t.Fatal("TestExpressionTreeString: expected " + value.ValueType().String() + " value \"" + value.String() + "\" for literal \"" + value.Literal() + "\", received: " + result.ValueType().String() + " value \"" + result.String() + "\"")
+		}
+	}
+}
+
 func TestFilterExpressionStatementCount(t *testing.T) {
 	dataSet, _, _, statID, freqID := createDataSet()
 
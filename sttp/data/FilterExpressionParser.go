@@ -59,6 +59,8 @@ type FilterExpressionParser struct {
 	activeExpressionTree *ExpressionTree
 	expressionTrees      []*ExpressionTree
 	expressions          map[antlr.ParserRuleContext]Expression
+	expressionContexts   map[Expression]antlr.ParserRuleContext
+	relations            map[string]*DataRelation
 
 	// DataSet defines the source metadata used for parsing the filter expression.
 	DataSet *DataSet
@@ -92,6 +94,7 @@ func NewFilterExpressionParser(filterExpression string, suppressConsoleErrorOutp
 	fep.errorListener = NewCallbackErrorListener()
 	fep.expressionTrees = make([]*ExpressionTree, 0)
 	fep.expressions = make(map[antlr.ParserRuleContext]Expression)
+	fep.relations = make(map[string]*DataRelation)
 	fep.TableIDFields = make(map[string]*TableIDFields)
 	fep.TrackFilteredRows = true
 
@@ -239,8 +242,11 @@ func (fep *FilterExpressionParser) Evaluate(applyLimit bool, applySort bool) err
 			return err
 		}
 
+		// Limit and sort clauses of a "SELECT" statement apply to its result table, not source rows, see SelectDataTable
+		isSelectStatement := len(expressionTree.SelectTerms) > 0
+
 		// Select all matching boolean results from expression tree evaluated for each table row
-		matchedRows, err := expressionTree.SelectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+		matchedRows, err := expressionTree.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
 			resultType := resultExpression.ValueType()
 
 			if resultType == ExpressionValueType.Boolean {
@@ -249,7 +255,7 @@ func (fep *FilterExpressionParser) Evaluate(applyLimit bool, applySort bool) err
 
 			// Filtered results will already have any matched literals
 			return false, nil
-		}, applyLimit, applySort)
+		}, applyLimit && !isSelectStatement, applySort && !isSelectStatement, true)
 
 		if err != nil {
 			return err
@@ -365,9 +371,23 @@ func (fep *FilterExpressionParser) mapMatchedFieldRow(primaryTable *DataTable, c
 
 	matchValue = strings.ToUpper(matchValue)
 	columnIndex := column.Index()
+	rowCount := primaryTable.RowCount()
+
+	// Use any index defined for the column to find candidate rows
+	rowIndexes, indexed := columnEqualRows(primaryTable, column, newValueExpression(ExpressionValueType.String, matchValue))
+
+	if indexed {
+		rowCount = len(rowIndexes)
+	}
+
+	for i := 0; i < rowCount; i++ {
+		rowIndex := i
+
+		if indexed {
+			rowIndex = rowIndexes[i]
+		}
 
-	for i := 0; i < primaryTable.RowCount(); i++ {
-		row := primaryTable.Row(i)
+		row := primaryTable.Row(rowIndex)
 
 		if row == nil {
 			continue
@@ -392,6 +412,13 @@ func (fep *FilterExpressionParser) addExpr(context antlr.ParserRuleContext, expr
 	// Track expression in parser rule context map
 	fep.expressions[context] = expression
 
+	// Track innermost source context of expression when validating, see Validate
+	if fep.expressionContexts != nil {
+		if _, ok := fep.expressionContexts[expression]; !ok {
+			fep.expressionContexts[expression] = context
+		}
+	}
+
 	// Update active expression tree root
 	fep.activeExpressionTree.Root = expression
 }
@@ -400,6 +427,7 @@ func (fep *FilterExpressionParser) addExpr(context antlr.ParserRuleContext, expr
    filterExpressionStatement
     : identifierStatement
     | filterStatement
+    | selectStatement
     | expression
     ;
 */
@@ -435,7 +463,7 @@ func (fep *FilterExpressionParser) EnterFilterExpressionStatement(context *parse
 
 // EnterFilterStatement is called when production filterStatement is entered.
 func (fep *FilterExpressionParser) EnterFilterStatement(context *parser.FilterStatementContext) {
-	tableName := context.TableName().GetText()
+	tableName := parseIdentifier(context.TableName().GetText())
 
 	table, err := fep.Table(tableName)
 
@@ -462,7 +490,7 @@ func (fep *FilterExpressionParser) EnterFilterStatement(context *parser.FilterSt
 
 		for i := 0; i < len(orderingTerms); i++ {
 			orderingTermContext := orderingTerms[i].(*parser.OrderingTermContext)
-			orderByColumnName := orderingTermContext.OrderByColumnName().GetText()
+			orderByColumnName := parseIdentifier(orderingTermContext.OrderByColumnName().GetText())
 			orderByColumn := table.ColumnByName(orderByColumnName)
 
 			if orderByColumn == nil {
@@ -472,10 +500,135 @@ func (fep *FilterExpressionParser) EnterFilterStatement(context *parser.FilterSt
 			fep.activeExpressionTree.OrderByTerms = append(fep.activeExpressionTree.OrderByTerms, &OrderByTerm{
 				Column:     orderByColumn,
 				Ascending:  orderingTermContext.K_DESC() == nil,
-				ExactMatch: orderingTermContext.ExactMatchModifier() == nil,
+				ExactMatch: orderingTermContext.ExactMatchModifier() != nil,
+			})
+		}
+	}
+}
+
+/*
+   selectStatement
+    : K_SELECT ( K_TOP topLimit )? selectTerm ( ',' selectTerm )* K_FROM tableName ( K_WHERE expression )? ( K_GROUP K_BY columnName ( ',' columnName )* )? ( K_ORDER K_BY orderingTerm ( ',' orderingTerm )* )?
+    ;
+
+   selectTerm
+    : expression ( K_AS columnAlias )?
+    ;
+
+   columnAlias
+    : IDENTIFIER
+    ;
+*/
+
+// EnterSelectStatement is called when production selectStatement is entered.
+func (fep *FilterExpressionParser) EnterSelectStatement(context *parser.SelectStatementContext) {
+	tableName := parseIdentifier(context.TableName().GetText())
+
+	if _, err := fep.Table(tableName); err != nil {
+		panic("cannot parse select statement, " + err.Error())
+	}
+
+	fep.activeExpressionTree = NewExpressionTree()
+	fep.activeExpressionTree.TableName = tableName
+	fep.expressionTrees = append(fep.expressionTrees, fep.activeExpressionTree)
+
+	if context.K_TOP() != nil {
+		topLimit, err := strconv.Atoi(context.TopLimit().GetText())
+
+		if err == nil {
+			fep.activeExpressionTree.TopLimit = topLimit
+		} else {
+			fep.activeExpressionTree.TopLimit = -1
+		}
+	}
+}
+
+// ExitSelectStatement is called when production selectStatement is exited.
+//gocyclo:ignore
+func (fep *FilterExpressionParser) ExitSelectStatement(context *parser.SelectStatementContext) {
+	var value Expression
+	expressionTree := fep.activeExpressionTree
+	table, _ := fep.Table(expressionTree.TableName)
+	selectTerms := context.AllSelectTerm()
+
+	for i := 0; i < len(selectTerms); i++ {
+		selectTermContext := selectTerms[i].(*parser.SelectTermContext)
+
+		if !fep.tryGetExpr(selectTermContext.Expression(), &value) {
+			panic("failed to find select term expression \"" + selectTermContext.GetText() + "\"")
+		}
+
+		var name string
+
+		if columnAlias := selectTermContext.ColumnAlias(); columnAlias != nil {
+			name = parseIdentifier(columnAlias.GetText())
+		} else if value.Type() == ExpressionType.Column {
+			name = value.(*ColumnExpression).DataColumn().Name()
+		} else {
+			name = "Column" + strconv.Itoa(i+1)
+		}
+
+		expressionTree.SelectTerms = append(expressionTree.SelectTerms, &SelectTerm{
+			Name:       name,
+			Expression: value,
+		})
+	}
+
+	if context.K_GROUP() != nil {
+		for _, columnNameContext := range context.AllColumnName() {
+			columnName := parseIdentifier(columnNameContext.GetText())
+			groupByColumn := table.ColumnByName(columnName)
+
+			if groupByColumn == nil {
+				panic("cannot parse select statement, failed to find group by field \"" + columnName + "\" for table \"" + table.Name() + "\"")
+			}
+
+			expressionTree.GroupByColumns = append(expressionTree.GroupByColumns, groupByColumn)
+		}
+	}
+
+	if context.K_ORDER() != nil {
+		orderingTerms := context.AllOrderingTerm()
+
+		for i := 0; i < len(orderingTerms); i++ {
+			orderingTermContext := orderingTerms[i].(*parser.OrderingTermContext)
+			orderByColumnName := parseIdentifier(orderingTermContext.OrderByColumnName().GetText())
+			found := false
+
+			// Order by terms for select statements reference result columns
+			for _, selectTerm := range expressionTree.SelectTerms {
+				if strings.EqualFold(selectTerm.Name, orderByColumnName) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				panic("cannot parse select statement, failed to find order by field \"" + orderByColumnName + "\" in select result columns")
+			}
+
+			expressionTree.OrderByTerms = append(expressionTree.OrderByTerms, &OrderByTerm{
+				Column:     newDataColumn(nil, orderByColumnName, DataType.String, ""),
+				Ascending:  orderingTermContext.K_DESC() == nil,
+				ExactMatch: orderingTermContext.ExactMatchModifier() != nil,
 			})
 		}
 	}
+
+	// Root expression operates as the "WHERE" clause, selecting all rows when not specified
+	if whereExpression := context.Expression(); whereExpression != nil {
+		if !fep.tryGetExpr(whereExpression, &value) {
+			panic("failed to find select statement where expression \"" + whereExpression.GetText() + "\"")
+		}
+
+		if hasAggregate(value) {
+			panic("cannot parse select statement, aggregate functions are not valid in where expression \"" + whereExpression.GetText() + "\"")
+		}
+
+		expressionTree.Root = value
+	} else {
+		expressionTree.Root = True
+	}
 }
 
 /*
@@ -544,9 +697,24 @@ func (fep *FilterExpressionParser) ExitIdentifierStatement(context *parser.Ident
 	signalIDColumnIndex := signalIDColumn.Index()
 
 	if fep.TrackFilteredRows && !signalID.IsZero() {
+		rowCount := primaryTable.RowCount()
+
+		// Use any index defined for the signal ID column to find candidate rows
+		rowIndexes, indexed := columnEqualRows(primaryTable, signalIDColumn, newValueExpression(ExpressionValueType.Guid, signalID))
+
+		if indexed {
+			rowCount = len(rowIndexes)
+		}
+
 		// Map matching row for manually specified Guid
-		for i := 0; i < primaryTable.RowCount(); i++ {
-			row := primaryTable.Row(i)
+		for i := 0; i < rowCount; i++ {
+			rowIndex := i
+
+			if indexed {
+				rowIndex = rowIndexes[i]
+			}
+
+			row := primaryTable.Row(rowIndex)
 
 			if row == nil {
 				continue
@@ -681,7 +849,8 @@ func (fep *FilterExpressionParser) ExitExpression(context *parser.ExpressionCont
 
 /*
    predicateExpression
-    : predicateExpression notOperator? K_IN exactMatchModifier? '(' expressionList ')'
+    : predicateExpression notOperator? K_BETWEEN valueExpression K_AND valueExpression
+    | predicateExpression notOperator? K_IN exactMatchModifier? '(' expressionList ')'
     | predicateExpression K_IS notOperator? K_NULL
     | predicateExpression comparisonOperator predicateExpression
     | predicateExpression notOperator? K_LIKE exactMatchModifier? predicateExpression
@@ -695,20 +864,47 @@ func (fep *FilterExpressionParser) ExitPredicateExpression(context *parser.Predi
 	var value Expression
 
 	// Check for value expressions (see explicit visit function)
-	valueExpression := context.ValueExpression()
+	valueExpressions := context.AllValueExpression()
 
-	if valueExpression != nil {
-		if fep.tryGetExpr(valueExpression, &value) {
+	if len(valueExpressions) == 1 {
+		if fep.tryGetExpr(valueExpressions[0], &value) {
 			fep.addExpr(context, value)
 			return
 		}
 
-		panic("failed to find value expression \"" + valueExpression.GetText() + "\"")
+		panic("failed to find value expression \"" + valueExpressions[0].GetText() + "\"")
 	}
 
 	notOperator := context.NotOperator()
 	exactMatchModifier := context.ExactMatchModifier()
 
+	// Check for BETWEEN expressions
+	if context.K_BETWEEN() != nil {
+		predicates := context.AllPredicateExpression()
+
+		// BETWEEN expression expects one predicate and two bound value expressions
+		if len(predicates) != 1 || len(valueExpressions) != 2 {
+			panic("\"BETWEEN\" expression is malformed: \"" + context.GetText() + "\"")
+		}
+
+		if !fep.tryGetExpr(predicates[0], &value) {
+			panic("failed to find \"BETWEEN\" predicate expression \"" + predicates[0].GetText() + "\"")
+		}
+
+		var lowerBound, upperBound Expression
+
+		if !fep.tryGetExpr(valueExpressions[0], &lowerBound) {
+			panic("failed to find \"BETWEEN\" lower bound expression \"" + valueExpressions[0].GetText() + "\"")
+		}
+
+		if !fep.tryGetExpr(valueExpressions[1], &upperBound) {
+			panic("failed to find \"BETWEEN\" upper bound expression \"" + valueExpressions[1].GetText() + "\"")
+		}
+
+		fep.addExpr(context, NewBetweenExpression(value, lowerBound, upperBound, notOperator != nil))
+		return
+	}
+
 	// Check for IN expressions
 	if context.K_IN() != nil {
 		predicates := context.AllPredicateExpression()
@@ -851,6 +1047,7 @@ func (fep *FilterExpressionParser) ExitPredicateExpression(context *parser.Predi
     : literalValue
     | columnName
     | functionExpression
+    | caseExpression
     | unaryOperator valueExpression
     | '(' expression ')'
     | valueExpression mathOperator valueExpression
@@ -899,6 +1096,18 @@ func (fep *FilterExpressionParser) ExitValueExpression(context *parser.ValueExpr
 		panic("failed to find function expression \"" + functionExpression.GetText() + "\"")
 	}
 
+	// Check for case expressions (see explicit visit function)
+	caseExpression := context.CaseExpression()
+
+	if caseExpression != nil {
+		if fep.tryGetExpr(caseExpression, &value) {
+			fep.addExpr(context, value)
+			return
+		}
+
+		panic("failed to find case expression \"" + caseExpression.GetText() + "\"")
+	}
+
 	// Check for unary operators
 	unaryOperator := context.UnaryOperator()
 
@@ -986,6 +1195,8 @@ func (fep *FilterExpressionParser) ExitValueExpression(context *parser.ValueExpr
 			operatorType = ExpressionOperatorType.Add
 		case "-":
 			operatorType = ExpressionOperatorType.Subtract
+		case "..":
+			operatorType = ExpressionOperatorType.Concatenate
 		default:
 			panic("unexpected math operator \"" + operatorSymbol + "\"")
 		}
@@ -1038,6 +1249,7 @@ func (fep *FilterExpressionParser) ExitValueExpression(context *parser.ValueExpr
     | GUID_LITERAL
     | BOOLEAN_LITERAL
     | K_NULL
+    | PARAMETER
     ;
 */
 
@@ -1046,6 +1258,12 @@ func (fep *FilterExpressionParser) ExitValueExpression(context *parser.ValueExpr
 func (fep *FilterExpressionParser) ExitLiteralValue(context *parser.LiteralValueContext) {
 	var result *ValueExpression
 
+	// Parameter values are bound at evaluation time, see ExpressionTree.SetParameters
+	if parameter := context.PARAMETER(); parameter != nil {
+		fep.addExpr(context, NewParameterExpression(parameter.GetText()[1:]))
+		return
+	}
+
 	// Literal numeric values will not be negative, unary operators will handle negative values
 	if integerLiteral := context.INTEGER_LITERAL(); integerLiteral != nil {
 		literal := integerLiteral.GetText()
@@ -1118,6 +1336,11 @@ func parseNumericLiteral(literal string) *ValueExpression {
 /*
    columnName
     : IDENTIFIER
+    | COLUMN_PATH
+    ;
+
+   COLUMN_PATH
+    : IDENTIFIER '.' IDENTIFIER ( '.' IDENTIFIER )*
     ;
 */
 
@@ -1139,7 +1362,12 @@ func (fep *FilterExpressionParser) ExitColumnName(context *parser.ColumnNameCont
 		panic("cannot parse column name in filter expression, " + err.Error())
 	}
 
-	columnName := context.IDENTIFIER().GetText()
+	if context.COLUMN_PATH() != nil {
+		fep.exitColumnPath(context, table)
+		return
+	}
+
+	columnName := parseIdentifier(context.IDENTIFIER().GetText())
 	dataColumn := table.ColumnByName(columnName)
 
 	if dataColumn == nil {
@@ -1149,9 +1377,42 @@ func (fep *FilterExpressionParser) ExitColumnName(context *parser.ColumnNameCont
 	fep.addExpr(context, NewColumnExpression(dataColumn))
 }
 
+func (fep *FilterExpressionParser) exitColumnPath(context *parser.ColumnNameContext, table *DataTable) {
+	columnPath := context.COLUMN_PATH().GetText()
+	pathElements := parseColumnPath(columnPath)
+	relations := make([]*DataRelation, 0, len(pathElements)-1)
+
+	// Each path element before column name is a relation name from the current table to a related table
+	for _, relationName := range pathElements[:len(pathElements)-1] {
+		relationKey := relationMapKey(table.Name(), relationName)
+		relation, ok := fep.relations[relationKey]
+
+		if !ok {
+			if relation = fep.DataSet.Relation(table.Name(), relationName); relation == nil {
+				panic("cannot parse column path \"" + columnPath + "\" in filter expression, failed to find relation \"" + relationName + "\" for table \"" + table.Name() + "\"")
+			}
+
+			// Cache relation so parent row index is shared by all column paths in expression
+			fep.relations[relationKey] = relation
+		}
+
+		relations = append(relations, relation)
+		table = relation.ParentTable()
+	}
+
+	columnName := pathElements[len(pathElements)-1]
+	dataColumn := table.ColumnByName(columnName)
+
+	if dataColumn == nil {
+		panic("cannot parse column path \"" + columnPath + "\" in filter expression, failed to find column \"" + columnName + "\" in related table \"" + table.Name() + "\"")
+	}
+
+	fep.addExpr(context, NewRelatedColumnExpression(dataColumn, relations))
+}
+
 /*
    functionExpression
-    : functionName '(' expressionList? ')'
+    : functionName '(' ( expressionList | '*' )? ')'
     ;
 */
 
@@ -1160,6 +1421,7 @@ func (fep *FilterExpressionParser) ExitColumnName(context *parser.ColumnNameCont
 func (fep *FilterExpressionParser) ExitFunctionExpression(context *parser.FunctionExpressionContext) {
 	functionNameContext := context.FunctionName().(*parser.FunctionNameContext)
 	var functionType ExpressionFunctionTypeEnum
+	var userFunction *UserFunction
 
 	switch {
 	case functionNameContext.K_ABS() != nil:
@@ -1244,10 +1506,26 @@ func (fep *FilterExpressionParser) ExitFunctionExpression(context *parser.Functi
 		functionType = ExpressionFunctionType.Upper
 	case functionNameContext.K_UTCNOW() != nil:
 		functionType = ExpressionFunctionType.UtcNow
+	case functionNameContext.IDENTIFIER() != nil:
+		if aggregateType, err := ParseExpressionAggregateType(functionNameContext.GetText()); err == nil {
+			fep.exitAggregateExpression(context, aggregateType)
+			return
+		}
+
+		functionType = ExpressionFunctionType.UserDefined
+		userFunction = LookupFunction(functionNameContext.GetText())
+
+		if userFunction == nil {
+			panic("unknown function \"" + functionNameContext.GetText() + "\", no built-in or registered user-defined function exists with this name")
+		}
 	default:
 		panic("unexpected function type \"" + functionNameContext.GetText() + "\"")
 	}
 
+	if context.GetWildcard() != nil {
+		panic("\"*\" argument is only valid for \"Count\" aggregate function, received \"" + functionNameContext.GetText() + "\"")
+	}
+
 	expressionList := context.ExpressionList()
 	var arguments []Expression
 
@@ -1270,9 +1548,103 @@ func (fep *FilterExpressionParser) ExitFunctionExpression(context *parser.Functi
 		arguments = make([]Expression, 0)
 	}
 
+	if userFunction != nil {
+		if len(arguments) != len(userFunction.ArgumentTypes()) {
+			panic("\"" + userFunction.Name() + "\" function expects " + strconv.Itoa(len(userFunction.ArgumentTypes())) + " arguments, received " + strconv.Itoa(len(arguments)))
+		}
+
+		fep.addExpr(context, NewUserFunctionExpression(userFunction, arguments))
+		return
+	}
+
 	fep.addExpr(context, NewFunctionExpression(functionType, arguments))
 }
 
+/*
+   caseExpression
+    : K_CASE expression? ( K_WHEN expression K_THEN expression )+ ( K_ELSE expression )? K_END
+    ;
+*/
+
+// ExitCaseExpression is called when production caseExpression is exited.
+func (fep *FilterExpressionParser) ExitCaseExpression(context *parser.CaseExpressionContext) {
+	expressions := context.AllExpression()
+	conditionCount := len(context.AllK_WHEN())
+	hasElse := context.K_ELSE() != nil
+	expectedCount := conditionCount * 2
+
+	if hasElse {
+		expectedCount++
+	}
+
+	// Simple case expressions have a value expression before the first "WHEN" condition
+	hasValue := len(expressions) == expectedCount+1
+
+	if conditionCount == 0 || (!hasValue && len(expressions) != expectedCount) {
+		panic("\"CASE\" expression is malformed: \"" + context.GetText() + "\"")
+	}
+
+	arguments := make([]Expression, len(expressions))
+
+	for i := 0; i < len(expressions); i++ {
+		if !fep.tryGetExpr(expressions[i], &arguments[i]) {
+			panic("failed to find argument expression " + strconv.Itoa(i) + " \"" + expressions[i].GetText() + "\" for \"CASE\" expression")
+		}
+	}
+
+	var value, elseResult Expression
+
+	if hasValue {
+		value = arguments[0]
+		arguments = arguments[1:]
+	}
+
+	if hasElse {
+		elseResult = arguments[len(arguments)-1]
+		arguments = arguments[:len(arguments)-1]
+	}
+
+	conditions := make([]Expression, 0, conditionCount)
+	results := make([]Expression, 0, conditionCount)
+
+	for i := 0; i < len(arguments); i += 2 {
+		conditions = append(conditions, arguments[i])
+		results = append(results, arguments[i+1])
+	}
+
+	fep.addExpr(context, NewCaseExpression(value, conditions, results, elseResult))
+}
+
+func (fep *FilterExpressionParser) exitAggregateExpression(context *parser.FunctionExpressionContext, aggregateType ExpressionAggregateTypeEnum) {
+	var value Expression
+
+	if context.GetWildcard() != nil || context.ExpressionList() == nil {
+		// COUNT(*) counts rows, other aggregate functions require a value
+		if aggregateType != ExpressionAggregateType.Count {
+			panic("\"" + aggregateType.String() + "\" aggregate function requires a value argument: \"" + context.GetText() + "\"")
+		}
+
+		fep.addExpr(context, NewAggregateExpression(aggregateType, nil))
+		return
+	}
+
+	expressions := context.ExpressionList().(*parser.ExpressionListContext).AllExpression()
+
+	if len(expressions) != 1 {
+		panic("\"" + aggregateType.String() + "\" aggregate function expects 1 argument, received " + strconv.Itoa(len(expressions)))
+	}
+
+	if !fep.tryGetExpr(expressions[0], &value) {
+		panic("failed to find argument expression \"" + expressions[0].GetText() + "\" for aggregate function \"" + aggregateType.String() + "\"")
+	}
+
+	if hasAggregate(value) {
+		panic("aggregate functions cannot be nested: \"" + context.GetText() + "\"")
+	}
+
+	fep.addExpr(context, NewAggregateExpression(aggregateType, value))
+}
+
 // GenerateExpressionTrees produces a set of expression trees for the provided filterExpression and dataSet.
 // One expression tree will be produced per filter expression statement encountered in the specified filterExpression.
 // If primaryTable parameter is not defined, then filter expression should not contain directly defined signal IDs.
@@ -1386,6 +1758,32 @@ func SelectDataRows(dataSet *DataSet, filterExpression string, primaryTable stri
 	return parser.FilteredRows(), nil
 }
 
+// SelectDataTable returns a new DataTable with the result columns of the provided "SELECT" statement evaluated against
+// the dataSet, e.g.: SELECT SignalType, COUNT(*) AS Total FROM ActiveMeasurements GROUP BY SignalType ORDER BY Total DESC
+// Supported aggregate functions are COUNT, SUM, AVG, MIN and MAX. If selectStatement contains multiple semi-colon separated
+// statements, only the first statement is evaluated. An error will be returned if dataSet parameter is nil, selectStatement
+// is empty, is not a "SELECT" statement, fails to parse or any expression evaluation fails.
+func SelectDataTable(dataSet *DataSet, selectStatement string, suppressConsoleErrorOutput bool) (*DataTable, error) {
+	expressionTrees, err := GenerateExpressionTrees(dataSet, "", selectStatement, suppressConsoleErrorOutput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expressionTrees) == 0 || len(expressionTrees[0].SelectTerms) == 0 {
+		return nil, errors.New("no select statement found in expression \"" + selectStatement + "\"")
+	}
+
+	expressionTree := expressionTrees[0]
+	table := dataSet.Table(expressionTree.TableName)
+
+	if table == nil {
+		return nil, errors.New("failed to find table \"" + expressionTree.TableName + "\" in DataSet")
+	}
+
+	return expressionTree.SelectTable(table)
+}
+
 // SelectDataRowsFromTable returns all rows matching the provided filterExpression and dataTable. Filter
 // expressions can contain multiple statements, separated by semi-colons, where each statement results in a
 // unique expression tree; this function returns the combined results of each encountered filter expression
@@ -1477,14 +1875,58 @@ func SelectSignalIDSetFromTable(dataTable *DataTable, filterExpression string, p
 	return SelectSignalIDSet(dataTable.Parent(), filterExpression, dataTable.Name(), tableIDFields, suppressConsoleErrorOutput)
 }
 
+// parseColumnPath splits a column path into its identifier elements, removing any
+// identifier delimiters, e.g., "Device.[Company Acronym]" becomes "Device" and
+// "Company Acronym".
+func parseColumnPath(columnPath string) []string {
+	pathElements := make([]string, 0, 2)
+	var element strings.Builder
+	var delimiter rune
+
+	for _, char := range columnPath {
+		switch {
+		case delimiter != 0:
+			if char == delimiter {
+				delimiter = 0
+			} else {
+				element.WriteRune(char)
+			}
+		case char == '`':
+			delimiter = '`'
+		case char == '[':
+			delimiter = ']'
+		case char == '.':
+			pathElements = append(pathElements, element.String())
+			element.Reset()
+		default:
+			element.WriteRune(char)
+		}
+	}
+
+	return append(pathElements, element.String())
+}
+
+// parseIdentifier removes any identifier delimiters, i.e., "`" or "[" and "]",
+// e.g., "[Company Acronym]" becomes "Company Acronym".
+func parseIdentifier(identifier string) string {
+	if len(identifier) > 1 {
+		if (identifier[0] == '`' && identifier[len(identifier)-1] == '`') || (identifier[0] == '[' && identifier[len(identifier)-1] == ']') {
+			return identifier[1 : len(identifier)-1]
+		}
+	}
+
+	return identifier
+}
+
 func parseStringLiteral(stringLiteral string) string {
 	// Remove any surrounding quotes from string, ANTLR grammar already
 	// ensures strings starting with quote also ends with one
 	if stringLiteral[0] == '\'' {
-		return stringLiteral[1 : len(stringLiteral)-1]
+		stringLiteral = stringLiteral[1 : len(stringLiteral)-1]
 	}
 
-	return stringLiteral
+	// Embedded quotes are escaped by doubling them
+	return strings.ReplaceAll(stringLiteral, "''", "'")
 }
 
 func parseGuidLiteral(guidLiteral string) guid.Guid {
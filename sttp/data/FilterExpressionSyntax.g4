@@ -41,6 +41,7 @@ filterExpressionStatementList
 filterExpressionStatement
  : identifierStatement
  | filterStatement
+ | selectStatement
  | expression
  ;
 
@@ -54,6 +55,14 @@ filterStatement
  : K_FILTER ( K_TOP topLimit )? tableName K_WHERE expression ( K_ORDER K_BY orderingTerm ( ',' orderingTerm )* )?
  ;
 
+selectStatement
+ : K_SELECT ( K_TOP topLimit )? selectTerm ( ',' selectTerm )* K_FROM tableName ( K_WHERE expression )? ( K_GROUP K_BY columnName ( ',' columnName )* )? ( K_ORDER K_BY orderingTerm ( ',' orderingTerm )* )?
+ ;
+
+selectTerm
+ : expression ( K_AS columnAlias )?
+ ;
+
 topLimit
  : ( '-' | '+' )? INTEGER_LITERAL
  ;
@@ -71,11 +80,11 @@ expressionList
     in order from highest to lowest precedence:
 
     *    /    %
-    +    -
+    +    -    ..
     <<   >>   &    |
     <    <=   >    >=
     =    ==   ===  !=  !==  <>
-    IS NULL   IN   LIKE
+    IS NULL   IN   LIKE   BETWEEN
     AND  &&
     OR   ||
 */
@@ -86,7 +95,8 @@ expression
  ;
 
 predicateExpression
- : predicateExpression notOperator? K_IN exactMatchModifier? '(' expressionList ')'
+ : predicateExpression notOperator? K_BETWEEN valueExpression K_AND valueExpression
+ | predicateExpression notOperator? K_IN exactMatchModifier? '(' expressionList ')'
  | predicateExpression K_IS notOperator? K_NULL
  | predicateExpression comparisonOperator predicateExpression
  | predicateExpression notOperator? K_LIKE exactMatchModifier? predicateExpression
@@ -97,6 +107,7 @@ valueExpression
  : literalValue
  | columnName
  | functionExpression
+ | caseExpression
  | unaryOperator valueExpression
  | '(' expression ')'
  | valueExpression mathOperator valueExpression
@@ -139,6 +150,7 @@ bitwiseOperator
 mathOperator
  : '*' | '/' | '%'
  | '+' | '-'
+ | '..' // String concatenation
  ;
 
 functionName
@@ -183,10 +195,15 @@ functionName
  | K_TRIMRIGHT
  | K_UPPER
  | K_UTCNOW
+ | IDENTIFIER // User-defined function, see RegisterFunction
  ;
 
 functionExpression
- : functionName '(' expressionList? ')'
+ : functionName '(' ( expressionList | wildcard='*' )? ')'
+ ;
+
+caseExpression
+ : K_CASE expression? ( K_WHEN expression K_THEN expression )+ ( K_ELSE expression )? K_END
  ;
 
 literalValue
@@ -197,6 +214,7 @@ literalValue
  | GUID_LITERAL
  | BOOLEAN_LITERAL
  | K_NULL
+ | PARAMETER
  ;
 
 tableName
@@ -205,12 +223,17 @@ tableName
 
 columnName
  : IDENTIFIER
+ | COLUMN_PATH
  ;
 
 orderByColumnName
  : IDENTIFIER
  ;
 
+columnAlias
+ : IDENTIFIER
+ ;
+
 // Terminals for keywords should come before terminals with pattern expressions
 
 // Keywords
@@ -271,6 +294,16 @@ K_UPPER : U P P E R;
 K_UTCNOW : U T C N O W;
 K_WHERE : W H E R E;
 K_XOR : X O R;
+K_AS : A S;
+K_FROM : F R O M;
+K_GROUP : G R O U P;
+K_SELECT : S E L E C T;
+K_BETWEEN : B E T W E E N;
+K_CASE : C A S E;
+K_ELSE : E L S E;
+K_END : E N D;
+K_THEN : T H E N;
+K_WHEN : W H E N;
 
 BOOLEAN_LITERAL
   : T R U E
@@ -283,6 +316,18 @@ IDENTIFIER
  | [a-zA-Z_] [a-zA-Z_0-9]* // TODO check: needs more chars in set
  ;
 
+COLUMN_PATH
+ : IDENTIFIER '.' IDENTIFIER ( '.' IDENTIFIER )*
+ ;
+
+PARAMETER
+ : '@' [a-zA-Z_] [a-zA-Z_0-9]*
+ ;
+
+CONCAT_OPERATOR
+ : '..'
+ ;
+
 INTEGER_LITERAL
  : DIGIT+
  | '0' X HEX_DIGIT+
@@ -27,6 +27,7 @@ package data
 type FunctionExpression struct {
 	functionType ExpressionFunctionTypeEnum
 	arguments    []Expression
+	userFunction *UserFunction
 }
 
 // NewFunctionExpression creates a new function expression.
@@ -37,6 +38,15 @@ func NewFunctionExpression(functionType ExpressionFunctionTypeEnum, arguments []
 	}
 }
 
+// NewUserFunctionExpression creates a new function expression for a user-defined function.
+func NewUserFunctionExpression(userFunction *UserFunction, arguments []Expression) *FunctionExpression {
+	return &FunctionExpression{
+		functionType: ExpressionFunctionType.UserDefined,
+		arguments:    arguments,
+		userFunction: userFunction,
+	}
+}
+
 // Type gets expression type of the FunctionExpression.
 func (*FunctionExpression) Type() ExpressionTypeEnum {
 	return ExpressionType.Function
@@ -51,3 +61,20 @@ func (fe *FunctionExpression) FunctionType() ExpressionFunctionTypeEnum {
 func (fe *FunctionExpression) Arguments() []Expression {
 	return fe.arguments
 }
+
+// UserFunction gets the user-defined function of the FunctionExpression, if any. Value will be nil
+// unless FunctionType is ExpressionFunctionType.UserDefined.
+func (fe *FunctionExpression) UserFunction() *UserFunction {
+	return fe.userFunction
+}
+
+// String gets the FunctionExpression formatted as canonical filter expression syntax.
+func (fe *FunctionExpression) String() string {
+	name := fe.functionType.String()
+
+	if fe.userFunction != nil {
+		name = fe.userFunction.Name()
+	}
+
+	return name + "(" + expressionListString(fe.arguments) + ")"
+}
@@ -23,6 +23,10 @@
 
 package data
 
+import (
+	"strings"
+)
+
 // InListExpression represents an in-list expression.
 type InListExpression struct {
 	value         Expression
@@ -65,3 +69,24 @@ func (ile *InListExpression) HasNotKeyword() bool {
 func (ile *InListExpression) ExtactMatch() bool {
 	return ile.exactMatch
 }
+
+// String gets the InListExpression formatted as canonical filter expression syntax.
+func (ile *InListExpression) String() string {
+	var image strings.Builder
+
+	image.WriteString(operandString(ile.value, predicatePrecedence))
+
+	if ile.hasNotkeyWord {
+		image.WriteString(" NOT")
+	}
+
+	image.WriteString(" IN ")
+
+	if ile.exactMatch {
+		image.WriteString("BINARY ")
+	}
+
+	image.WriteString("(" + expressionListString(ile.arguments) + ")")
+
+	return image.String()
+}
@@ -58,3 +58,24 @@ func (oe *OperatorExpression) LeftValue() Expression {
 func (oe *OperatorExpression) RightValue() Expression {
 	return oe.rightValue
 }
+
+// String gets the OperatorExpression formatted as canonical filter expression syntax.
+func (oe *OperatorExpression) String() string {
+	precedence := expressionPrecedence(oe)
+
+	if oe.operatorType == ExpressionOperatorType.IsNull || oe.operatorType == ExpressionOperatorType.IsNotNull {
+		return operandString(oe.leftValue, precedence) + " " + oe.operatorType.String()
+	}
+
+	var leftValue string
+
+	// Operators are left associative, so parentheses are not needed for a chain of the same operator,
+	// e.g., "A AND B AND C", except for predicates which can have a different precedence
+	if leftOperator, ok := oe.leftValue.(*OperatorExpression); ok && leftOperator.operatorType == oe.operatorType && precedence != predicatePrecedence {
+		leftValue = ExpressionString(leftOperator)
+	} else {
+		leftValue = operandString(oe.leftValue, precedence)
+	}
+
+	return leftValue + " " + oe.operatorType.String() + " " + operandString(oe.rightValue, precedence)
+}
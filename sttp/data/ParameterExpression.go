@@ -0,0 +1,236 @@
+//******************************************************************************************************
+//  ParameterExpression.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// ParameterExpression represents a named parameter expression, e.g., "@source", where the parameter
+// value is bound at evaluation time. Parameter values are never parsed as filter expression text so
+// they are safe to use for values that originate from user input.
+type ParameterExpression struct {
+	name string
+}
+
+// NewParameterExpression creates a new parameter expression for the specified name, without "@" prefix.
+func NewParameterExpression(name string) *ParameterExpression {
+	return &ParameterExpression{
+		name: name,
+	}
+}
+
+// Type gets expression type of the ParameterExpression.
+func (*ParameterExpression) Type() ExpressionTypeEnum {
+	return ExpressionType.Parameter
+}
+
+// Name gets the name of the ParameterExpression, without "@" prefix.
+func (pe *ParameterExpression) Name() string {
+	return pe.name
+}
+
+// String gets the ParameterExpression formatted as canonical filter expression syntax, i.e., "@" followed by name.
+func (pe *ParameterExpression) String() string {
+	return "@" + pe.name
+}
+
+// Parameters gets the distinct names, without "@" prefix, of the parameters referenced by the ExpressionTree.
+func (et *ExpressionTree) Parameters() []string {
+	names := make([]string, 0)
+	found := make(map[string]bool)
+
+	for _, selectTerm := range et.SelectTerms {
+		collectParameters(selectTerm.Expression, &names, found)
+	}
+
+	collectParameters(et.Root, &names, found)
+	return names
+}
+
+// SetParameters assigns the values of the named parameters referenced by the ExpressionTree, replacing any
+// existing values. Parameter names are case-insensitive and can optionally include the "@" prefix. Values
+// can be a bool, string, integer, float, decimal.Decimal, guid.Guid, time.Time or *ValueExpression; a nil
+// value is treated as Null. An error will be returned if a value type is not supported or any parameter
+// referenced by the ExpressionTree does not have a value.
+func (et *ExpressionTree) SetParameters(parameters map[string]interface{}) error {
+	values := make(map[string]*ValueExpression, len(parameters))
+
+	for name, value := range parameters {
+		name = strings.TrimPrefix(name, "@")
+		parameterValue, err := parameterValue(name, value)
+
+		if err != nil {
+			return err
+		}
+
+		values[strings.ToUpper(name)] = parameterValue
+	}
+
+	for _, name := range et.Parameters() {
+		if _, ok := values[strings.ToUpper(name)]; !ok {
+			return errors.New("no value defined for parameter \"@" + name + "\"")
+		}
+	}
+
+	et.parameters = values
+	return nil
+}
+
+func (et *ExpressionTree) evaluateParameter(expression Expression) (*ValueExpression, error) {
+	name := expression.(*ParameterExpression).Name()
+	value, ok := et.parameters[strings.ToUpper(name)]
+
+	if !ok {
+		return nil, errors.New("failed while evaluating parameter expression, no value defined for parameter \"@" + name + "\"")
+	}
+
+	return value, nil
+}
+
+// constantValue gets the value of a literal or bound parameter expression. Returns false for
+// any other expression type.
+func (et *ExpressionTree) constantValue(expression Expression) (*ValueExpression, bool) {
+	switch expression.Type() {
+	case ExpressionType.Value:
+		return expression.(*ValueExpression), true
+	case ExpressionType.Parameter:
+		value, err := et.evaluateParameter(expression)
+		return value, err == nil
+	default:
+		return nil, false
+	}
+}
+
+//gocyclo:ignore
+func parameterValue(name string, value interface{}) (*ValueExpression, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return NullValue(ExpressionValueType.Undefined), nil
+	case *ValueExpression:
+		if typedValue == nil {
+			return NullValue(ExpressionValueType.Undefined), nil
+		}
+
+		return typedValue, nil
+	case bool:
+		return newValueExpression(ExpressionValueType.Boolean, typedValue), nil
+	case string:
+		return newValueExpression(ExpressionValueType.String, typedValue), nil
+	case int:
+		return newValueExpression(ExpressionValueType.Int64, int64(typedValue)), nil
+	case int8:
+		return newValueExpression(ExpressionValueType.Int32, int32(typedValue)), nil
+	case int16:
+		return newValueExpression(ExpressionValueType.Int32, int32(typedValue)), nil
+	case int32:
+		return newValueExpression(ExpressionValueType.Int32, typedValue), nil
+	case int64:
+		return newValueExpression(ExpressionValueType.Int64, typedValue), nil
+	case uint:
+		return parameterValue(name, uint64(typedValue))
+	case uint8:
+		return newValueExpression(ExpressionValueType.Int32, int32(typedValue)), nil
+	case uint16:
+		return newValueExpression(ExpressionValueType.Int32, int32(typedValue)), nil
+	case uint32:
+		return newValueExpression(ExpressionValueType.Int64, int64(typedValue)), nil
+	case uint64:
+		if typedValue > math.MaxInt64 {
+			return newValueExpression(ExpressionValueType.Double, float64(typedValue)), nil
+		}
+
+		return newValueExpression(ExpressionValueType.Int64, int64(typedValue)), nil
+	case float32:
+		return newValueExpression(ExpressionValueType.Double, float64(typedValue)), nil
+	case float64:
+		return newValueExpression(ExpressionValueType.Double, typedValue), nil
+	case decimal.Decimal:
+		return newValueExpression(ExpressionValueType.Decimal, typedValue), nil
+	case guid.Guid:
+		return newValueExpression(ExpressionValueType.Guid, typedValue), nil
+	case time.Time:
+		return newValueExpression(ExpressionValueType.DateTime, typedValue), nil
+	default:
+		return nil, fmt.Errorf("cannot assign %T value to parameter \"@%s\", value type is not supported", value, name)
+	}
+}
+
+func collectParameters(expression Expression, names *[]string, found map[string]bool) {
+	if expression == nil {
+		return
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Parameter:
+		name := expression.(*ParameterExpression).Name()
+		key := strings.ToUpper(name)
+
+		if !found[key] {
+			found[key] = true
+			*names = append(*names, name)
+		}
+	case ExpressionType.Unary:
+		collectParameters(expression.(*UnaryExpression).Value(), names, found)
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		collectParameters(inListExpression.Value(), names, found)
+
+		for _, argument := range inListExpression.Arguments() {
+			collectParameters(argument, names, found)
+		}
+	case ExpressionType.Function:
+		for _, argument := range expression.(*FunctionExpression).Arguments() {
+			collectParameters(argument, names, found)
+		}
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		collectParameters(operatorExpression.LeftValue(), names, found)
+		collectParameters(operatorExpression.RightValue(), names, found)
+	case ExpressionType.Aggregate:
+		collectParameters(expression.(*AggregateExpression).Value(), names, found)
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+		collectParameters(betweenExpression.Value(), names, found)
+		collectParameters(betweenExpression.LowerBound(), names, found)
+		collectParameters(betweenExpression.UpperBound(), names, found)
+	case ExpressionType.Case:
+		caseExpression := expression.(*CaseExpression)
+		collectParameters(caseExpression.Value(), names, found)
+
+		for i, condition := range caseExpression.Conditions() {
+			collectParameters(condition, names, found)
+			collectParameters(caseExpression.Results()[i], names, found)
+		}
+
+		collectParameters(caseExpression.ElseResult(), names, found)
+	}
+}
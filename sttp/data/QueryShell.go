@@ -0,0 +1,659 @@
+//******************************************************************************************************
+//  QueryShell.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sttp/goapi/sttp/format"
+)
+
+// shellCommands defines the commands supported by a QueryShell, in help order.
+var shellCommands = []struct {
+	name        string
+	arguments   string
+	description string
+}{
+	{".help", "", "Show shell commands"},
+	{".tables", "", "List tables with column and row counts"},
+	{".columns", "[TABLE]", "List columns of a table, defaults to current table"},
+	{".relations", "[TABLE]", "List relations of a table, defaults to current table"},
+	{".functions", "", "List built-in, aggregate and user-defined function names"},
+	{".use", "[TABLE]", "Set current table for WHERE only expressions, clears table when not specified"},
+	{".validate", "STATEMENT", "Check a statement without evaluating it"},
+	{".limit", "[COUNT]", "Show or set the maximum number of displayed rows"},
+}
+
+// shellKeywords defines the filter expression keywords offered as completion candidates.
+var shellKeywords = []string{
+	"AND", "AS", "ASC", "BETWEEN", "BY", "CASE", "DESC", "ELSE", "END", "FALSE", "FILTER", "FROM", "GROUP", "IN",
+	"IS", "LIKE", "NOT", "NULL", "OR", "ORDER", "SELECT", "THEN", "TOP", "TRUE", "WHEN", "WHERE", "XOR",
+}
+
+// QueryShell executes filter expression statements and shell commands against a DataSet for interactive
+// tools, e.g., to debug subscription filter expressions before deploying them. Statements are validated
+// before evaluation so that problems are reported with their position and any close matching names. The
+// Complete function provides tab completion candidates for table, column, relation, function and keyword
+// names. Supported statements are FILTER and SELECT statements, signal ID and point tag lists and, when a
+// current table is set, WHERE expressions without a table. Lines starting with a period are shell commands,
+// see ".help".
+type QueryShell struct {
+	dataSet *DataSet
+	table   string
+
+	// MaxRows defines the maximum number of rows displayed for a result. Defaults to 100.
+	MaxRows int
+
+	// MaxColumnWidth defines the maximum number of characters displayed for a value, longer values
+	// are truncated. Defaults to 40.
+	MaxColumnWidth int
+}
+
+// NewQueryShell creates a new QueryShell for the specified dataSet.
+func NewQueryShell(dataSet *DataSet) *QueryShell {
+	return &QueryShell{
+		dataSet:        dataSet,
+		MaxRows:        100,
+		MaxColumnWidth: 40,
+	}
+}
+
+// DataSet gets the DataSet used by the QueryShell.
+func (qs *QueryShell) DataSet() *DataSet {
+	return qs.dataSet
+}
+
+// SetDataSet replaces the DataSet used by the QueryShell, e.g., when updated metadata is received.
+// The current table is cleared when it does not exist in the new DataSet.
+func (qs *QueryShell) SetDataSet(dataSet *DataSet) {
+	qs.dataSet = dataSet
+
+	if len(qs.table) > 0 && dataSet.Table(qs.table) == nil {
+		qs.table = ""
+	}
+}
+
+// Table gets the current table used for WHERE expressions without a table, if any.
+func (qs *QueryShell) Table() string {
+	return qs.table
+}
+
+// Execute runs the shell command or filter expression statement in line and writes the result to writer.
+// Any validation diagnostics are written before an error is returned for a statement that has errors.
+func (qs *QueryShell) Execute(line string, writer io.Writer) error {
+	line = strings.TrimSpace(line)
+
+	if len(line) == 0 {
+		return nil
+	}
+
+	if qs.dataSet == nil {
+		return errors.New("no data set is loaded")
+	}
+
+	if strings.HasPrefix(line, ".") {
+		return qs.executeCommand(line, writer)
+	}
+
+	if !qs.validate(line, writer) {
+		return errors.New("statement has errors")
+	}
+
+	if isSelectStatement(line) {
+		table, err := SelectDataTable(qs.dataSet, line, true)
+
+		if err != nil {
+			return err
+		}
+
+		return qs.writeRows(writer, table, table.Rows())
+	}
+
+	rows, err := SelectDataRows(qs.dataSet, line, qs.table, nil, true)
+
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		_, err := io.WriteString(writer, "0 rows\n")
+		return err
+	}
+
+	// Rows of a multi-statement expression can be from different tables
+	for start := 0; start < len(rows); {
+		table := rows[start].Parent()
+		end := start + 1
+
+		for end < len(rows) && rows[end].Parent() == table {
+			end++
+		}
+
+		if start > 0 {
+			io.WriteString(writer, "\n")
+		}
+
+		if err := qs.writeRows(writer, table, rows[start:end]); err != nil {
+			return err
+		}
+
+		start = end
+	}
+
+	return nil
+}
+
+func isSelectStatement(statement string) bool {
+	fields := strings.Fields(statement)
+	return len(fields) > 0 && strings.EqualFold(fields[0], "SELECT")
+}
+
+// validate writes any diagnostics for statement and returns true if the statement has no errors.
+func (qs *QueryShell) validate(statement string, writer io.Writer) bool {
+	diagnostics := ValidateForTable(statement, qs.dataSet, qs.table)
+
+	if len(diagnostics) == 0 {
+		return true
+	}
+
+	var image strings.Builder
+
+	image.WriteString("  " + statement + "\n")
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Line == 1 && !strings.ContainsAny(statement, "\r\n") {
+			length := diagnostic.Length
+
+			if length < 1 {
+				length = 1
+			}
+
+			image.WriteString("  " + strings.Repeat(" ", diagnostic.Offset) + strings.Repeat("^", length) + "\n")
+		}
+
+		image.WriteString(diagnostic.String() + "\n")
+	}
+
+	io.WriteString(writer, image.String())
+
+	return !HasErrors(diagnostics)
+}
+
+func (qs *QueryShell) executeCommand(line string, writer io.Writer) error {
+	fields := strings.Fields(line)
+	command := strings.ToLower(fields[0])
+	argument := strings.TrimSpace(line[len(fields[0]):])
+
+	switch command {
+	case ".help":
+		return writeShellHelp(writer)
+	case ".tables":
+		return qs.writeTables(writer)
+	case ".columns", ".relations":
+		table, err := qs.commandTable(argument)
+
+		if err != nil {
+			return err
+		}
+
+		if command == ".columns" {
+			return qs.writeColumns(writer, table)
+		}
+
+		return qs.writeRelations(writer, table)
+	case ".functions":
+		_, err := io.WriteString(writer, strings.Join(sortedNames(functionNames()), "\n")+"\n")
+		return err
+	case ".use":
+		if len(argument) == 0 {
+			qs.table = ""
+			return nil
+		}
+
+		table := qs.dataSet.Table(argument)
+
+		if table == nil {
+			return errors.New("failed to find table \"" + argument + "\"")
+		}
+
+		qs.table = table.Name()
+		return nil
+	case ".validate":
+		if len(argument) == 0 {
+			return errors.New("no statement specified to validate")
+		}
+
+		if qs.validate(argument, writer) {
+			_, err := io.WriteString(writer, "Statement is valid\n")
+			return err
+		}
+
+		return errors.New("statement has errors")
+	case ".limit":
+		if len(argument) > 0 {
+			limit, err := strconv.Atoi(argument)
+
+			if err != nil || limit < 1 {
+				return errors.New("invalid row limit \"" + argument + "\", expected a positive integer")
+			}
+
+			qs.MaxRows = limit
+		}
+
+		_, err := io.WriteString(writer, "Maximum displayed rows: "+strconv.Itoa(qs.MaxRows)+"\n")
+		return err
+	default:
+		return errors.New("unknown command \"" + fields[0] + "\", see .help")
+	}
+}
+
+func writeShellHelp(writer io.Writer) error {
+	var image strings.Builder
+
+	for _, command := range shellCommands {
+		usage := command.name
+
+		if len(command.arguments) > 0 {
+			usage += " " + command.arguments
+		}
+
+		image.WriteString(usage + strings.Repeat(" ", 22-len(usage)) + command.description + "\n")
+	}
+
+	image.WriteString("\nStatements, e.g.:\n")
+	image.WriteString("    FILTER TOP 10 ActiveMeasurements WHERE SignalType = 'FREQ' ORDER BY PointTag\n")
+	image.WriteString("    SELECT SignalType, COUNT(*) AS Total FROM ActiveMeasurements GROUP BY SignalType\n")
+
+	_, err := io.WriteString(writer, image.String())
+	return err
+}
+
+// commandTable gets the named table or, when name is empty, the current table.
+func (qs *QueryShell) commandTable(name string) (*DataTable, error) {
+	if len(name) == 0 {
+		name = qs.table
+	}
+
+	if len(name) == 0 {
+		return nil, errors.New("no table specified and no current table set, see .use")
+	}
+
+	table := qs.dataSet.Table(name)
+
+	if table == nil {
+		return nil, errors.New("failed to find table \"" + name + "\"")
+	}
+
+	return table, nil
+}
+
+func (qs *QueryShell) writeTables(writer io.Writer) error {
+	tables := qs.dataSet.Tables()
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name() < tables[j].Name()
+	})
+
+	values := make([][]string, len(tables))
+
+	for i, table := range tables {
+		values[i] = []string{table.Name(), format.Int(table.ColumnCount()), format.Int(table.RowCount())}
+	}
+
+	return writeAligned(writer, []string{"Table", "Columns", "Rows"}, values)
+}
+
+func (qs *QueryShell) writeColumns(writer io.Writer, table *DataTable) error {
+	values := make([][]string, table.ColumnCount())
+
+	for i := range values {
+		column := table.Column(i)
+		values[i] = []string{column.Name(), column.Type().String(), column.Expression()}
+	}
+
+	return writeAligned(writer, []string{"Column", "Type", "Expression"}, values)
+}
+
+func (qs *QueryShell) writeRelations(writer io.Writer, table *DataTable) error {
+	var values [][]string
+
+	for _, name := range qs.relationNames(table) {
+		relation := qs.dataSet.Relation(table.Name(), name)
+		values = append(values, []string{relation.Name(), relation.ChildColumn().Name(), relation.ParentTable().Name() + "." + relation.ParentColumn().Name()})
+	}
+
+	return writeAligned(writer, []string{"Relation", "Column", "Parent"}, values)
+}
+
+// relationNames gets the names of the relations, added or default, available to the child table.
+func (qs *QueryShell) relationNames(table *DataTable) []string {
+	var names []string
+
+	for _, relation := range qs.dataSet.Relations() {
+		if relation.ChildTable() == table {
+			names = append(names, relation.Name())
+		}
+	}
+
+	for _, fields := range DefaultDataRelations {
+		if strings.EqualFold(fields.ChildTableName, table.Name()) && qs.dataSet.Relation(table.Name(), fields.Name) != nil {
+			names = append(names, fields.Name)
+		}
+	}
+
+	return matchingNames("", names)
+}
+
+// writeRows writes up to MaxRows rows of table with aligned columns followed by the row count.
+func (qs *QueryShell) writeRows(writer io.Writer, table *DataTable, rows []*DataRow) error {
+	columns := make([]string, table.ColumnCount())
+
+	for i := range columns {
+		columns[i] = table.Column(i).Name()
+	}
+
+	count := len(rows)
+
+	if qs.MaxRows > 0 && count > qs.MaxRows {
+		count = qs.MaxRows
+	}
+
+	values := make([][]string, count)
+
+	for i := range values {
+		values[i] = make([]string, len(columns))
+
+		for j := range columns {
+			values[i][j] = qs.displayValue(rows[i], j)
+		}
+	}
+
+	if err := writeAligned(writer, columns, values); err != nil {
+		return err
+	}
+
+	summary := format.Int(len(rows)) + " rows from " + table.Name()
+
+	if count < len(rows) {
+		summary += ", first " + format.Int(count) + " shown"
+	}
+
+	_, err := io.WriteString(writer, summary+"\n")
+	return err
+}
+
+func (qs *QueryShell) displayValue(row *DataRow, columnIndex int) string {
+	if value, err := row.Value(columnIndex); err != nil || value == nil {
+		return "NULL"
+	}
+
+	value := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+
+		return r
+	}, row.ValueAsString(columnIndex))
+
+	if qs.MaxColumnWidth > 3 && utf8.RuneCountInString(value) > qs.MaxColumnWidth {
+		value = string([]rune(value)[:qs.MaxColumnWidth-3]) + "..."
+	}
+
+	return value
+}
+
+// writeAligned writes a header, separator and rows with columns padded to a common width.
+func writeAligned(writer io.Writer, header []string, rows [][]string) error {
+	widths := make([]int, len(header))
+
+	for _, row := range append([][]string{header}, rows...) {
+		for i, value := range row {
+			if width := utf8.RuneCountInString(value); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	var image strings.Builder
+
+	writeRow := func(row []string) {
+		line := make([]string, len(row))
+
+		for i, value := range row {
+			line[i] = value + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(value))
+		}
+
+		image.WriteString(strings.TrimRight(strings.Join(line, "  "), " ") + "\n")
+	}
+
+	separators := make([]string, len(header))
+
+	for i, width := range widths {
+		separators[i] = strings.Repeat("-", width)
+	}
+
+	writeRow(header)
+	writeRow(separators)
+
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	_, err := io.WriteString(writer, image.String())
+	return err
+}
+
+// Complete gets the completion candidates for the partial name at the end of line. The start index of the
+// partial name within line is returned with the sorted candidates, i.e., a candidate replaces line[start:].
+// Candidates are table names where a table is expected, e.g., after FILTER or FROM, otherwise candidates are
+// the column and relation names of the statement table, function names and keywords. Related column paths,
+// e.g., "Device.Acr", complete with the column and relation names of the related table.
+func (qs *QueryShell) Complete(line string) (int, []string) {
+	start := len(line)
+
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(line[:start])
+
+		if !isNameRune(r) {
+			break
+		}
+
+		start -= size
+	}
+
+	partial := line[start:]
+
+	if qs.dataSet == nil {
+		return start, nil
+	}
+
+	// Shell command names
+	if strings.HasPrefix(partial, ".") && len(strings.TrimSpace(line[:start])) == 0 {
+		names := make([]string, len(shellCommands))
+
+		for i, command := range shellCommands {
+			names[i] = command.name
+		}
+
+		return start, matchingNames(partial, names)
+	}
+
+	statement := line[:start]
+
+	if fields := strings.Fields(statement); len(fields) > 0 && strings.HasPrefix(fields[0], ".") {
+		switch strings.ToLower(fields[0]) {
+		case ".columns", ".relations", ".use":
+			if len(fields) == 1 {
+				return start, matchingNames(partial, qs.dataSet.TableNames())
+			}
+
+			return start, nil
+		case ".validate":
+			statement = strings.TrimSpace(statement[len(fields[0]):])
+		default:
+			return start, nil
+		}
+	}
+
+	// Only consider the last statement of a multi-statement expression
+	if index := strings.LastIndexByte(statement, ';'); index > -1 {
+		statement = statement[index+1:]
+	}
+
+	tokens := strings.FieldsFunc(statement, func(r rune) bool {
+		return !isNameRune(r)
+	})
+
+	if len(tokens) > 0 {
+		previous := strings.ToUpper(tokens[len(tokens)-1])
+
+		if previous == "FILTER" {
+			return start, matchingNames(partial, append(qs.dataSet.TableNames(), "TOP"))
+		}
+
+		if previous == "FROM" || len(tokens) > 2 && strings.EqualFold(tokens[len(tokens)-2], "TOP") && strings.EqualFold(tokens[len(tokens)-3], "FILTER") {
+			return start, matchingNames(partial, qs.dataSet.TableNames())
+		}
+	}
+
+	table := qs.statementTable(tokens)
+
+	// Related column path
+	if index := strings.LastIndexByte(partial, '.'); index > -1 {
+		if table == nil {
+			return start, nil
+		}
+
+		path := partial[:index+1]
+
+		for _, relationName := range strings.Split(partial[:index], ".") {
+			relation := qs.dataSet.Relation(table.Name(), strings.Trim(relationName, "[]"))
+
+			if relation == nil {
+				return start, nil
+			}
+
+			table = relation.ParentTable()
+		}
+
+		var names []string
+
+		for _, name := range qs.tableNames(table) {
+			names = append(names, path+name)
+		}
+
+		return start, matchingNames(partial, names)
+	}
+
+	var names []string
+
+	if table != nil {
+		names = qs.tableNames(table)
+	} else {
+		for _, dataTable := range qs.dataSet.Tables() {
+			names = append(names, qs.tableNames(dataTable)...)
+		}
+	}
+
+	names = append(names, functionNames()...)
+	names = append(names, shellKeywords...)
+
+	return start, matchingNames(partial, names)
+}
+
+// statementTable gets the table referenced by FILTER or FROM in the statement tokens, or the current table.
+func (qs *QueryShell) statementTable(tokens []string) *DataTable {
+	for i := 0; i < len(tokens)-1; i++ {
+		name := tokens[i+1]
+
+		switch strings.ToUpper(tokens[i]) {
+		case "FILTER":
+			if strings.EqualFold(name, "TOP") && i+3 < len(tokens) {
+				name = tokens[i+3]
+			}
+		case "FROM":
+		default:
+			continue
+		}
+
+		if table := qs.dataSet.Table(name); table != nil {
+			return table
+		}
+	}
+
+	if len(qs.table) > 0 {
+		return qs.dataSet.Table(qs.table)
+	}
+
+	return nil
+}
+
+// tableNames gets the column and relation names of a table.
+func (qs *QueryShell) tableNames(table *DataTable) []string {
+	names := make([]string, 0, table.ColumnCount())
+
+	for i := 0; i < table.ColumnCount(); i++ {
+		names = append(names, table.Column(i).Name())
+	}
+
+	return append(names, qs.relationNames(table)...)
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || r == '.' || r == '[' || r == ']' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// matchingNames gets the distinct names that start with partial, ignoring case, in sorted order.
+func matchingNames(partial string, names []string) []string {
+	prefix := strings.ToUpper(partial)
+	encountered := make(map[string]bool)
+	var matches []string
+
+	for _, name := range names {
+		key := strings.ToUpper(name)
+
+		if encountered[key] || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		encountered[key] = true
+		matches = append(matches, name)
+	}
+
+	return sortedNames(matches)
+}
+
+func sortedNames(names []string) []string {
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToUpper(names[i]) < strings.ToUpper(names[j])
+	})
+
+	return names
+}
@@ -0,0 +1,157 @@
+//******************************************************************************************************
+//  QueryShell_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func loadQueryShell(t *testing.T) *QueryShell {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("loadQueryShell: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("loadQueryShell: error loading DataSet from XML document: " + err.Error())
+	}
+
+	return NewQueryShell(dataSet)
+}
+
+func TestQueryShellExecute(t *testing.T) {
+	shell := loadQueryShell(t)
+
+	execute := func(line string) (string, error) {
+		var output strings.Builder
+		err := shell.Execute(line, &output)
+		return output.String(), err
+	}
+
+	output, err := execute(".tables")
+
+	if err != nil || !strings.Contains(output, "MeasurementDetail  11       130") {
+		t.Fatal("TestQueryShellExecute: unexpected .tables output:\n" + output)
+	}
+
+	output, err = execute("FILTER TOP 2 MeasurementDetail WHERE SignalAcronym = 'STAT' ORDER BY PointTag")
+
+	if err != nil {
+		t.Fatal("TestQueryShellExecute: unexpected error executing FILTER statement: " + err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], "DeviceAcronym  ID") || !strings.HasPrefix(lines[1], "-------------  --") || lines[4] != "2 rows from MeasurementDetail" {
+		t.Fatal("TestQueryShellExecute: unexpected FILTER statement output:\n" + output)
+	}
+
+	output, err = execute("SELECT SignalAcronym, COUNT(*) AS Total FROM MeasurementDetail GROUP BY SignalAcronym ORDER BY Total DESC")
+
+	if err != nil || !strings.HasPrefix(output, "SignalAcronym  Total\n-------------  -----\nSTAT           116\n") {
+		t.Fatal("TestQueryShellExecute: unexpected SELECT statement output:\n" + output)
+	}
+
+	// Statements with errors are not evaluated, diagnostics mark problem text
+	output, err = execute("FILTER MeasurementDetail WHERE SignalAcronymn = 'FREQ'")
+
+	if err == nil || !strings.Contains(output, "\n                                 ^^^^^^^^^^^^^^\n") || !strings.Contains(output, "did you mean \"SignalAcronym\"?") {
+		t.Fatal("TestQueryShellExecute: expected diagnostics for unknown column:\n" + output)
+	}
+
+	if _, err = execute("SignalAcronym = 'FREQ'"); err == nil {
+		t.Fatal("TestQueryShellExecute: expected error for WHERE expression without current table")
+	}
+
+	if _, err = execute(".use measurementdetail"); err != nil || shell.Table() != "MeasurementDetail" {
+		t.Fatal("TestQueryShellExecute: expected current table to be set")
+	}
+
+	if output, err = execute("SignalAcronym = 'FREQ'"); err != nil || !strings.Contains(output, "TVA_SHELBY:ABBF") || !strings.HasSuffix(output, "1 rows from MeasurementDetail\n") {
+		t.Fatal("TestQueryShellExecute: unexpected WHERE expression output:\n" + output)
+	}
+
+	if output, err = execute(".columns"); err != nil || !strings.Contains(output, "\nSignalID           Guid") {
+		t.Fatal("TestQueryShellExecute: unexpected .columns output:\n" + output)
+	}
+
+	if output, err = execute(".relations"); err != nil || !strings.Contains(output, "\nDevice    DeviceAcronym  DeviceDetail.Acronym\n") {
+		t.Fatal("TestQueryShellExecute: unexpected .relations output:\n" + output)
+	}
+
+	if _, err = execute(".limit 3"); err != nil || shell.MaxRows != 3 {
+		t.Fatal("TestQueryShellExecute: expected row limit to be set")
+	}
+
+	if output, err = execute("FILTER MeasurementDetail WHERE True"); err != nil || strings.Count(output, "\n") != 6 || !strings.HasSuffix(output, "130 rows from MeasurementDetail, first 3 shown\n") {
+		t.Fatal("TestQueryShellExecute: unexpected limited output:\n" + output)
+	}
+
+	if output, err = execute(".validate FILTER PhasorDetail WHERE Len(Label) > 1"); err != nil || output != "Statement is valid\n" {
+		t.Fatal("TestQueryShellExecute: unexpected .validate output:\n" + output)
+	}
+
+	if _, err = execute(".missing"); err == nil {
+		t.Fatal("TestQueryShellExecute: expected error for unknown command")
+	}
+}
+
+func TestQueryShellComplete(t *testing.T) {
+	shell := loadQueryShell(t)
+
+	complete := func(line string) (int, string) {
+		start, candidates := shell.Complete(line)
+		return start, strings.Join(candidates, ",")
+	}
+
+	for _, test := range []struct {
+		line       string
+		start      int
+		candidates string
+	}{
+		{".ta", 0, ".tables"},
+		{".columns Ph", 9, "PhasorDetail"},
+		{"FILTER M", 7, "MeasurementDetail"},
+		{"filter top 10 Dev", 14, "DeviceDetail"},
+		{"SELECT COUNT(*) FROM Sch", 21, "SchemaVersion"},
+		{"FILTER MeasurementDetail WHERE Signal", 31, "SignalAcronym,SignalID,SignalReference"},
+		{"FILTER MeasurementDetail WHERE Device.Comp", 31, "Device.CompanyAcronym,Device.ComputedCol"},
+		{"FILTER PhasorDetail WHERE StartsW", 26, "StartsWith"},
+		{"FILTER PhasorDetail WHERE Label = 'A' ORD", 38, "ORDER"},
+		{"FILTER PhasorDetail WHERE Missing.Col", 26, ""},
+		{".validate FILTER DeviceDetail WHERE Acr", 36, "Acronym"},
+	} {
+		start, candidates := complete(test.line)
+
+		if start != test.start || candidates != test.candidates {
+			t.Fatal("TestQueryShellComplete: unexpected completion for \"" + test.line + "\": " + candidates)
+		}
+	}
+}
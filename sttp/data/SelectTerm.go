@@ -0,0 +1,34 @@
+//******************************************************************************************************
+//  SelectTerm.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+// SelectTerm represents the elements parsed from a result column specified in a "SELECT" statement.
+type SelectTerm struct {
+	// Name is the result column name of the SelectTerm. Value will be the "AS" alias, when
+	// specified, the source column name for simple column references, or a generated name.
+	Name string
+
+	// Expression is the expression evaluated to produce the result column value of the SelectTerm.
+	Expression Expression
+}
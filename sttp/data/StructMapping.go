@@ -0,0 +1,516 @@
+//******************************************************************************************************
+//  StructMapping.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// StructTag defines the struct tag key used to map struct fields to DataColumn names, e.g.:
+//
+//	type Measurement struct {
+//	    SignalID    guid.Guid `sttp:"SignalID"`
+//	    PointTag    string    `sttp:"PointTag"`
+//	    Description *string   `sttp:"Description"` // Pointer fields receive nil for null values
+//	    Internal    int       `sttp:"-"`           // Field is never mapped
+//	}
+//
+// Exported fields without a tag are mapped by field name. Column name matching is case-insensitive.
+const StructTag = "sttp"
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	guidType    = reflect.TypeOf(guid.Guid{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+// dataTypeGoTypes maps each DataType to the Go type used to store its values in a DataRow.
+var dataTypeGoTypes = map[DataTypeEnum]reflect.Type{
+	DataType.String:   reflect.TypeOf(""),
+	DataType.Boolean:  reflect.TypeOf(false),
+	DataType.DateTime: timeType,
+	DataType.Single:   reflect.TypeOf(float32(0)),
+	DataType.Double:   reflect.TypeOf(float64(0)),
+	DataType.Decimal:  decimalType,
+	DataType.Guid:     guidType,
+	DataType.Int8:     reflect.TypeOf(int8(0)),
+	DataType.Int16:    reflect.TypeOf(int16(0)),
+	DataType.Int32:    reflect.TypeOf(int32(0)),
+	DataType.Int64:    reflect.TypeOf(int64(0)),
+	DataType.UInt8:    reflect.TypeOf(uint8(0)),
+	DataType.UInt16:   reflect.TypeOf(uint16(0)),
+	DataType.UInt32:   reflect.TypeOf(uint32(0)),
+	DataType.UInt64:   reflect.TypeOf(uint64(0)),
+}
+
+type structField struct {
+	columnName string
+	index      []int
+}
+
+// structFieldCache caches the mapped fields for each struct type.
+var structFieldCache sync.Map
+
+func structFields(structType reflect.Type) []structField {
+	if fields, ok := structFieldCache.Load(structType); ok {
+		return fields.([]structField)
+	}
+
+	fields := make([]structField, 0, structType.NumField())
+
+	for _, field := range reflect.VisibleFields(structType) {
+		if field.Anonymous || !field.IsExported() || throughEmbeddedPointer(structType, field.Index) {
+			continue
+		}
+
+		columnName := field.Name
+
+		if tag, ok := field.Tag.Lookup(StructTag); ok {
+			if tag == "-" {
+				continue
+			}
+
+			if len(tag) > 0 {
+				columnName = tag
+			}
+		}
+
+		fields = append(fields, structField{
+			columnName: columnName,
+			index:      field.Index,
+		})
+	}
+
+	structFieldCache.Store(structType, fields)
+
+	return fields
+}
+
+// throughEmbeddedPointer determines if a promoted field is reached through an embedded
+// pointer, which may be nil, such fields are not mapped.
+func throughEmbeddedPointer(structType reflect.Type, index []int) bool {
+	for i := 0; i < len(index)-1; i++ {
+		field := structType.Field(index[i])
+
+		if field.Type.Kind() == reflect.Pointer {
+			return true
+		}
+
+		structType = field.Type
+	}
+
+	return false
+}
+
+func structValue(target interface{}, operation string) (reflect.Value, error) {
+	value := reflect.ValueOf(target)
+
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("cannot %s, target must be a non-nil pointer to a struct, received %T", operation, target)
+	}
+
+	return value.Elem(), nil
+}
+
+// Unmarshal copies the column values of the DataRow into the fields of the struct pointed to by
+// target. Fields are matched to columns using the "sttp" struct tag, see StructTag. Pointer fields
+// are set to nil for null column values; non-pointer fields receive the zero value. Values are
+// converted between compatible types, e.g., an Int32 column can be read into an int64 or float64
+// field and any column can be read into a string field. Columns without a matching field and
+// fields without a matching column are ignored.
+func Unmarshal(row *DataRow, target interface{}) error {
+	if row == nil {
+		return errors.New("cannot unmarshal, row is nil")
+	}
+
+	structValue, err := structValue(target, "unmarshal DataRow")
+
+	if err != nil {
+		return err
+	}
+
+	return row.unmarshal(structValue, structFields(structValue.Type()))
+}
+
+func (dr *DataRow) unmarshal(target reflect.Value, fields []structField) error {
+	for _, field := range fields {
+		column := dr.parent.ColumnByName(field.columnName)
+
+		if column == nil {
+			continue
+		}
+
+		value, err := dr.Value(column.Index())
+
+		if err != nil {
+			return err
+		}
+
+		if err := assignValue(target.FieldByIndex(field.index), value); err != nil {
+			return fmt.Errorf("cannot unmarshal DataColumn \"%s\" for table \"%s\": %s", column.Name(), dr.parent.Name(), err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ScanAll unmarshals every row of the DataTable into a new element appended to the slice pointed
+// to by target. The target must be a pointer to a slice of structs or a slice of struct pointers,
+// e.g., &[]Measurement or &[]*Measurement. See Unmarshal for field mapping rules.
+func (dt *DataTable) ScanAll(target interface{}) error {
+	sliceValue := reflect.ValueOf(target)
+
+	if sliceValue.Kind() != reflect.Pointer || sliceValue.IsNil() || sliceValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("cannot scan DataTable \"%s\", target must be a non-nil pointer to a slice, received %T", dt.name, target)
+	}
+
+	sliceValue = sliceValue.Elem()
+	elementType := sliceValue.Type().Elem()
+	pointerElements := elementType.Kind() == reflect.Pointer
+
+	if pointerElements {
+		elementType = elementType.Elem()
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot scan DataTable \"%s\", slice element type must be a struct or struct pointer, received %s", dt.name, sliceValue.Type().Elem())
+	}
+
+	fields := structFields(elementType)
+
+	for _, row := range dt.rows {
+		if row == nil {
+			continue
+		}
+
+		element := reflect.New(elementType)
+
+		if err := row.unmarshal(element.Elem(), fields); err != nil {
+			return err
+		}
+
+		if pointerElements {
+			sliceValue.Set(reflect.Append(sliceValue, element))
+		} else {
+			sliceValue.Set(reflect.Append(sliceValue, element.Elem()))
+		}
+	}
+
+	return nil
+}
+
+// Marshal copies the fields of the struct pointed to by source into the matching column values of
+// the DataRow. Fields are matched to columns using the "sttp" struct tag, see StructTag. Nil pointer
+// fields are assigned as null values. Values are converted to the column data type when compatible.
+// Computed columns, columns without a matching field and fields without a matching column are ignored.
+func Marshal(source interface{}, row *DataRow) error {
+	if row == nil {
+		return errors.New("cannot marshal, row is nil")
+	}
+
+	structValue, err := structValue(source, "marshal to DataRow")
+
+	if err != nil {
+		return err
+	}
+
+	return row.marshal(structValue, structFields(structValue.Type()))
+}
+
+func (dr *DataRow) marshal(source reflect.Value, fields []structField) error {
+	for _, field := range fields {
+		column := dr.parent.ColumnByName(field.columnName)
+
+		if column == nil || column.computed {
+			continue
+		}
+
+		fieldValue := source.FieldByIndex(field.index)
+
+		for fieldValue.Kind() == reflect.Pointer || fieldValue.Kind() == reflect.Interface {
+			if fieldValue.IsNil() {
+				break
+			}
+
+			fieldValue = fieldValue.Elem()
+		}
+
+		if (fieldValue.Kind() == reflect.Pointer || fieldValue.Kind() == reflect.Interface) && fieldValue.IsNil() {
+			dr.values[column.Index()] = nil
+			continue
+		}
+
+		columnValue := reflect.New(dataTypeGoTypes[column.Type()]).Elem()
+
+		if err := assignValue(columnValue, fieldValue.Interface()); err != nil {
+			return fmt.Errorf("cannot marshal field to DataColumn \"%s\" for table \"%s\": %s", column.Name(), dr.parent.Name(), err.Error())
+		}
+
+		dr.values[column.Index()] = columnValue.Interface()
+	}
+
+	dr.parent.rowsVersion++
+	return nil
+}
+
+// AppendAll creates a new DataRow for each struct element of the slice in source, marshals
+// the element into the row and adds the row to the DataTable. The source must be a slice of
+// structs or a slice of struct pointers. See Marshal for field mapping rules.
+func (dt *DataTable) AppendAll(source interface{}) error {
+	sliceValue := reflect.ValueOf(source)
+
+	if sliceValue.Kind() == reflect.Pointer && !sliceValue.IsNil() {
+		sliceValue = sliceValue.Elem()
+	}
+
+	if sliceValue.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot append to DataTable \"%s\", source must be a slice, received %T", dt.name, source)
+	}
+
+	elementType := sliceValue.Type().Elem()
+
+	if elementType.Kind() == reflect.Pointer {
+		elementType = elementType.Elem()
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot append to DataTable \"%s\", slice element type must be a struct or struct pointer, received %s", dt.name, sliceValue.Type().Elem())
+	}
+
+	fields := structFields(elementType)
+
+	for i := 0; i < sliceValue.Len(); i++ {
+		element := sliceValue.Index(i)
+
+		if element.Kind() == reflect.Pointer {
+			if element.IsNil() {
+				continue
+			}
+
+			element = element.Elem()
+		}
+
+		row := dt.CreateRow()
+
+		if err := row.marshal(element, fields); err != nil {
+			return err
+		}
+
+		dt.AddRow(row)
+	}
+
+	return nil
+}
+
+// assignValue assigns a DataRow value, or struct field value, to the target converting
+// between compatible types. A nil value assigns nil to pointer targets and the zero value
+// to all other targets.
+//
+//gocyclo:ignore
+func assignValue(target reflect.Value, value interface{}) error {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	targetType := target.Type()
+
+	if targetType.Kind() == reflect.Pointer {
+		element := reflect.New(targetType.Elem())
+
+		if err := assignValue(element.Elem(), value); err != nil {
+			return err
+		}
+
+		target.Set(element)
+		return nil
+	}
+
+	source := reflect.ValueOf(value)
+
+	// Direct assignment for matching types, including interface{} targets
+	if source.Type().AssignableTo(targetType) {
+		target.Set(source)
+		return nil
+	}
+
+	incompatible := func() error {
+		return fmt.Errorf("cannot convert %T value to %s", value, targetType)
+	}
+
+	// String representations are parsed to supported data types
+	if text, ok := value.(string); ok && targetType.Kind() != reflect.String {
+		dataType, ok := goTypeDataType(targetType)
+
+		if !ok {
+			return incompatible()
+		}
+
+		parsed, err := parseDataValue(text, dataType)
+
+		if err != nil {
+			return err
+		}
+
+		return assignValue(target, parsed)
+	}
+
+	switch targetType {
+	case timeType:
+		return incompatible()
+	case guidType:
+		return incompatible()
+	case decimalType:
+		switch {
+		case source.CanInt():
+			target.Set(reflect.ValueOf(decimal.NewFromInt(source.Int())))
+		case source.CanUint():
+			target.Set(reflect.ValueOf(decimal.NewFromBigInt(new(big.Int).SetUint64(source.Uint()), 0)))
+		case source.CanFloat():
+			target.Set(reflect.ValueOf(decimal.NewFromFloat(source.Float())))
+		default:
+			return incompatible()
+		}
+
+		return nil
+	}
+
+	switch targetType.Kind() {
+	case reflect.String:
+		target.SetString(formatDataValue(value))
+	case reflect.Bool:
+		if source.Kind() != reflect.Bool {
+			return incompatible()
+		}
+
+		target.SetBool(source.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i64 int64
+
+		switch {
+		case source.CanInt():
+			i64 = source.Int()
+		case source.CanUint():
+			if source.Uint() > math.MaxInt64 {
+				return fmt.Errorf("%T value %d overflows %s", value, source.Uint(), targetType)
+			}
+
+			i64 = int64(source.Uint())
+		case source.Type() == decimalType:
+			d := value.(decimal.Decimal)
+
+			if !d.IsInteger() {
+				return fmt.Errorf("decimal value %s is not an integer", d.String())
+			}
+
+			i64 = d.IntPart()
+		default:
+			return incompatible()
+		}
+
+		if target.OverflowInt(i64) {
+			return fmt.Errorf("%T value %d overflows %s", value, i64, targetType)
+		}
+
+		target.SetInt(i64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u64 uint64
+
+		switch {
+		case source.CanUint():
+			u64 = source.Uint()
+		case source.CanInt():
+			if source.Int() < 0 {
+				return fmt.Errorf("%T value %d overflows %s", value, source.Int(), targetType)
+			}
+
+			u64 = uint64(source.Int())
+		case source.Type() == decimalType:
+			d := value.(decimal.Decimal)
+
+			if !d.IsInteger() || d.IsNegative() {
+				return fmt.Errorf("decimal value %s is not an unsigned integer", d.String())
+			}
+
+			u64 = d.BigInt().Uint64()
+		default:
+			return incompatible()
+		}
+
+		if target.OverflowUint(u64) {
+			return fmt.Errorf("%T value %d overflows %s", value, u64, targetType)
+		}
+
+		target.SetUint(u64)
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case source.CanFloat():
+			target.SetFloat(source.Float())
+		case source.CanInt():
+			target.SetFloat(float64(source.Int()))
+		case source.CanUint():
+			target.SetFloat(float64(source.Uint()))
+		case source.Type() == decimalType:
+			f64, _ := value.(decimal.Decimal).Float64()
+			target.SetFloat(f64)
+		default:
+			return incompatible()
+		}
+	default:
+		return incompatible()
+	}
+
+	return nil
+}
+
+// goTypeDataType gets the DataType used to parse string values for the specified Go type.
+func goTypeDataType(goType reflect.Type) (DataTypeEnum, bool) {
+	for dataType, dataTypeGoType := range dataTypeGoTypes {
+		if goType == dataTypeGoType {
+			return dataType, true
+		}
+	}
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return DataType.Boolean, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return DataType.Int64, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return DataType.UInt64, true
+	case reflect.Float32, reflect.Float64:
+		return DataType.Double, true
+	default:
+		return DataType.String, false
+	}
+}
@@ -0,0 +1,167 @@
+//******************************************************************************************************
+//  StructMapping_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+type testMeasurementDetail struct {
+	SignalID        guid.Guid `sttp:"SignalID"`
+	PointTag        string
+	SignalReference string    `sttp:"SignalReference"`
+	Description     *string   `sttp:"Description"`
+	Internal        int       `sttp:"-"`
+	UpdatedOn       time.Time `sttp:"updatedon"`
+}
+
+func TestScanAllMetadata(t *testing.T) {
+	buffer, err := os.ReadFile("../../test/MetadataSample1.xml")
+
+	if err != nil {
+		t.Fatal("TestScanAllMetadata: failed to read metadata sample: " + err.Error())
+	}
+
+	dataTable := FromXml(buffer).Table("MeasurementDetail")
+	var measurements []*testMeasurementDetail
+
+	if err := dataTable.ScanAll(&measurements); err != nil {
+		t.Fatal("TestScanAllMetadata: failed to scan rows: " + err.Error())
+	}
+
+	if len(measurements) != dataTable.RowCount() {
+		t.Fatal("TestScanAllMetadata: expected " + strconv.Itoa(dataTable.RowCount()) + " measurements, received: " + strconv.Itoa(len(measurements)))
+	}
+
+	for i, measurement := range measurements {
+		row := dataTable.Row(i)
+		signalID, _, _ := row.GuidValueByName("SignalID")
+		pointTag, _, _ := row.StringValueByName("PointTag")
+		description, null, _ := row.StringValueByName("Description")
+
+		if !measurement.SignalID.Equal(signalID) || measurement.PointTag != pointTag {
+			t.Fatal("TestScanAllMetadata: scanned values do not match row " + strconv.Itoa(i))
+		}
+
+		if null != (measurement.Description == nil) || (!null && *measurement.Description != description) {
+			t.Fatal("TestScanAllMetadata: scanned nullable description does not match row " + strconv.Itoa(i))
+		}
+
+		if measurement.UpdatedOn.IsZero() {
+			t.Fatal("TestScanAllMetadata: expected UpdatedOn to be mapped by case-insensitive tag")
+		}
+	}
+}
+
+func TestUnmarshalConversions(t *testing.T) {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("Conversions")
+
+	int32Field := createDataColumn(dataTable, "Int32Value", DataType.Int32)
+	guidField := createDataColumn(dataTable, "GuidValue", DataType.Guid)
+	stringField := createDataColumn(dataTable, "StringValue", DataType.String)
+	int64Field := createDataColumn(dataTable, "Int64Value", DataType.Int64)
+
+	signalID := guid.New()
+	dataRow := dataTable.CreateRow()
+	dataRow.SetValue(int32Field, int32(42))
+	dataRow.SetValue(guidField, signalID)
+	dataRow.SetValue(stringField, "1.5")
+	dataRow.SetValue(int64Field, int64(1000))
+	dataTable.AddRow(dataRow)
+
+	var target struct {
+		Int32Value  float64
+		GuidValue   string
+		StringValue float32
+		Int64Value  *uint16
+	}
+
+	if err := Unmarshal(dataRow, &target); err != nil {
+		t.Fatal("TestUnmarshalConversions: failed to unmarshal row: " + err.Error())
+	}
+
+	if target.Int32Value != 42 || target.GuidValue != signalID.String() || target.StringValue != 1.5 || target.Int64Value == nil || *target.Int64Value != 1000 {
+		t.Fatal("TestUnmarshalConversions: unexpected converted values")
+	}
+
+	var overflow struct {
+		Int64Value int8
+	}
+
+	if err := Unmarshal(dataRow, &overflow); err == nil {
+		t.Fatal("TestUnmarshalConversions: expected overflow error")
+	}
+
+	var incompatible struct {
+		GuidValue float64
+	}
+
+	if err := Unmarshal(dataRow, &incompatible); err == nil {
+		t.Fatal("TestUnmarshalConversions: expected incompatible type error")
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+	dataTable := dataSet.Table("ActiveMeasurements")
+
+	type activeMeasurement struct {
+		SignalID   string
+		SignalType *string
+	}
+
+	signalType := "VPHM"
+	source := []activeMeasurement{
+		{SignalID: guid.New().String(), SignalType: &signalType},
+		{SignalID: guid.New().String()},
+	}
+
+	if err := dataTable.AppendAll(source); err != nil {
+		t.Fatal("TestMarshalRoundTrip: failed to append rows: " + err.Error())
+	}
+
+	if dataTable.RowCount() != 4 {
+		t.Fatal("TestMarshalRoundTrip: expected row count of 4, received: " + strconv.Itoa(dataTable.RowCount()))
+	}
+
+	if _, null, _ := dataTable.Row(3).StringValueByName("SignalType"); !null {
+		t.Fatal("TestMarshalRoundTrip: expected nil pointer field to marshal as null")
+	}
+
+	var target []activeMeasurement
+
+	if err := dataTable.ScanAll(&target); err != nil {
+		t.Fatal("TestMarshalRoundTrip: failed to scan rows: " + err.Error())
+	}
+
+	if target[2].SignalID != source[0].SignalID || *target[2].SignalType != signalType {
+		t.Fatal("TestMarshalRoundTrip: scanned values do not match marshaled source")
+	}
+}
@@ -58,6 +58,15 @@ func (ue *UnaryExpression) UnaryType() ExpressionUnaryTypeEnum {
 	return ue.unaryType
 }
 
+// String gets the UnaryExpression formatted as canonical filter expression syntax.
+func (ue *UnaryExpression) String() string {
+	if ue.unaryType == ExpressionUnaryType.Not {
+		return "NOT (" + ExpressionString(ue.value) + ")"
+	}
+
+	return ue.unaryType.String() + operandString(ue.value, unaryPrecedence)
+}
+
 func (ue *UnaryExpression) unaryBoolean(value bool) (*ValueExpression, error) {
 	switch ue.unaryType {
 	case ExpressionUnaryType.Not:
@@ -0,0 +1,321 @@
+//******************************************************************************************************
+//  UserFunction.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/data/parser"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// UserFunctionHandler defines the implementation of a user-defined filter expression function.
+// Each argument value is provided as the Go type of its declared DataType, e.g., a float64 for
+// DataType.Double, or nil when the argument value is Null. The returned value must be the Go type
+// of the declared return DataType, or nil for a Null result.
+type UserFunctionHandler func(arguments []interface{}) (interface{}, error)
+
+// UserFunction represents a named scalar function, registered with RegisterFunction, that can
+// be called from filter expressions, e.g., "FILTER ActiveMeasurements WHERE PhaseOf(SignalReference) = 'A'".
+type UserFunction struct {
+	name          string
+	argumentTypes []DataTypeEnum
+	returnType    DataTypeEnum
+	handler       UserFunctionHandler
+}
+
+// Name gets the name of the UserFunction as used in filter expressions.
+func (uf *UserFunction) Name() string {
+	return uf.name
+}
+
+// ArgumentTypes gets the declared data types of the UserFunction arguments.
+func (uf *UserFunction) ArgumentTypes() []DataTypeEnum {
+	return uf.argumentTypes
+}
+
+// ReturnType gets the declared data type of the UserFunction result.
+func (uf *UserFunction) ReturnType() DataTypeEnum {
+	return uf.returnType
+}
+
+var userFunctions = make(map[string]*UserFunction)
+var userFunctionsLock sync.RWMutex
+
+// RegisterFunction registers a user-defined scalar function for use in filter expressions. Function
+// names are case-insensitive and must be a valid identifier that is not a filter expression keyword
+// or built-in function name, e.g., "PhaseOf". Arguments are converted to the specified argumentTypes
+// before the handler is called, the number of arguments is validated when an expression is parsed.
+// Registering a function with an existing name replaces the existing function. Only expressions
+// parsed after registration will resolve the function, cached compiled filters are cleared.
+func RegisterFunction(name string, argumentTypes []DataTypeEnum, returnType DataTypeEnum, handler UserFunctionHandler) error {
+	if handler == nil {
+		return errors.New("cannot register user-defined function \"" + name + "\", handler is nil")
+	}
+
+	if !isFunctionIdentifier(name) {
+		return errors.New("cannot register user-defined function \"" + name + "\", name is not a valid identifier or is a reserved keyword")
+	}
+
+	if _, err := ParseExpressionAggregateType(name); err == nil {
+		return errors.New("cannot register user-defined function \"" + name + "\", name is reserved for an aggregate function")
+	}
+
+	for _, dataType := range append([]DataTypeEnum{returnType}, argumentTypes...) {
+		if dataType < DataType.String || dataType > DataType.UInt64 {
+			return errors.New("cannot register user-defined function \"" + name + "\", data type \"" + dataType.String() + "\" is not supported")
+		}
+	}
+
+	userFunction := &UserFunction{
+		name:          name,
+		argumentTypes: append([]DataTypeEnum(nil), argumentTypes...),
+		returnType:    returnType,
+		handler:       handler,
+	}
+
+	userFunctionsLock.Lock()
+	defer userFunctionsLock.Unlock()
+
+	userFunctions[strings.ToUpper(name)] = userFunction
+	ClearCompiledFilterCache()
+
+	return nil
+}
+
+// UnregisterFunction removes the user-defined function with the specified name. Returns true if
+// the function was registered. Previously parsed expressions will continue to use the function,
+// cached compiled filters are cleared.
+func UnregisterFunction(name string) bool {
+	userFunctionsLock.Lock()
+	defer userFunctionsLock.Unlock()
+
+	key := strings.ToUpper(name)
+	_, ok := userFunctions[key]
+	delete(userFunctions, key)
+	ClearCompiledFilterCache()
+
+	return ok
+}
+
+// LookupFunction gets the user-defined function with the specified name, or nil if no function
+// has been registered with the name.
+func LookupFunction(name string) *UserFunction {
+	userFunctionsLock.RLock()
+	defer userFunctionsLock.RUnlock()
+
+	return userFunctions[strings.ToUpper(name)]
+}
+
+// isFunctionIdentifier determines if name lexes as a single, unquoted IDENTIFIER token.
+func isFunctionIdentifier(name string) bool {
+	if len(name) == 0 || name[0] == '`' || name[0] == '[' {
+		return false
+	}
+
+	lexer := parser.NewFilterExpressionSyntaxLexer(antlr.NewInputStream(name))
+	lexer.RemoveErrorListeners()
+	tokens := lexer.GetAllTokens()
+
+	return len(tokens) == 1 && tokens[0].GetTokenType() == parser.FilterExpressionSyntaxLexerIDENTIFIER && tokens[0].GetText() == name
+}
+
+func (et *ExpressionTree) evaluateUserFunction(functionExpression *FunctionExpression) (*ValueExpression, error) {
+	userFunction := functionExpression.UserFunction()
+
+	if userFunction == nil {
+		return nil, errors.New("failed while evaluating user-defined function expression, function reference is not defined")
+	}
+
+	arguments := functionExpression.Arguments()
+
+	if len(arguments) != len(userFunction.argumentTypes) {
+		return nil, errors.New("\"" + userFunction.name + "\" function expects " + strconv.Itoa(len(userFunction.argumentTypes)) + " arguments, received " + strconv.Itoa(len(arguments)))
+	}
+
+	values := make([]interface{}, len(arguments))
+
+	for i, argument := range arguments {
+		argumentType := userFunction.argumentTypes[i]
+		argumentValue, err := et.evaluateAs(argument, dataTypeValueType(argumentType))
+
+		if err != nil {
+			return nil, errors.New("failed while evaluating \"" + userFunction.name + "\" function argument " + strconv.Itoa(i) + ": " + err.Error())
+		}
+
+		if argumentValue.IsNull() {
+			continue
+		}
+
+		if values[i], err = convertValueExpression(argumentValue, argumentType); err != nil {
+			return nil, errors.New("failed while converting \"" + userFunction.name + "\" function argument " + strconv.Itoa(i) + ": " + err.Error())
+		}
+	}
+
+	result, err := userFunction.handler(values)
+
+	if err != nil {
+		return nil, errors.New("failed while evaluating \"" + userFunction.name + "\" function: " + err.Error())
+	}
+
+	if result == nil {
+		return NullValue(dataTypeValueType(userFunction.returnType)), nil
+	}
+
+	return dataValueExpression(userFunction.name, result, userFunction.returnType)
+}
+
+// dataTypeValueType gets the ExpressionValueType used to represent values of the specified
+// DataType, matching the mapping used when evaluating column expressions.
+func dataTypeValueType(dataType DataTypeEnum) ExpressionValueTypeEnum {
+	switch dataType {
+	case DataType.String:
+		return ExpressionValueType.String
+	case DataType.Boolean:
+		return ExpressionValueType.Boolean
+	case DataType.DateTime:
+		return ExpressionValueType.DateTime
+	case DataType.Single, DataType.Double:
+		return ExpressionValueType.Double
+	case DataType.Decimal:
+		return ExpressionValueType.Decimal
+	case DataType.Guid:
+		return ExpressionValueType.Guid
+	case DataType.Int8, DataType.Int16, DataType.Int32, DataType.UInt8, DataType.UInt16:
+		return ExpressionValueType.Int32
+	case DataType.Int64, DataType.UInt32, DataType.UInt64:
+		return ExpressionValueType.Int64
+	default:
+		return ExpressionValueType.Undefined
+	}
+}
+
+// valueTypeDataType gets the DataType used to store values of the specified ExpressionValueType.
+// Undefined values, i.e., values that are always Null, are stored as a String.
+func valueTypeDataType(valueType ExpressionValueTypeEnum) DataTypeEnum {
+	switch valueType {
+	case ExpressionValueType.Boolean:
+		return DataType.Boolean
+	case ExpressionValueType.Int32:
+		return DataType.Int32
+	case ExpressionValueType.Int64:
+		return DataType.Int64
+	case ExpressionValueType.Decimal:
+		return DataType.Decimal
+	case ExpressionValueType.Double:
+		return DataType.Double
+	case ExpressionValueType.Guid:
+		return DataType.Guid
+	case ExpressionValueType.DateTime:
+		return DataType.DateTime
+	default:
+		return DataType.String
+	}
+}
+
+//gocyclo:ignore
+func dataValueExpression(functionName string, value interface{}, dataType DataTypeEnum) (*ValueExpression, error) {
+	var result *ValueExpression
+
+	switch dataType {
+	case DataType.String:
+		if v, ok := value.(string); ok {
+			result = newValueExpression(ExpressionValueType.String, v)
+		}
+	case DataType.Boolean:
+		if v, ok := value.(bool); ok {
+			result = newValueExpression(ExpressionValueType.Boolean, v)
+		}
+	case DataType.DateTime:
+		if v, ok := value.(time.Time); ok {
+			result = newValueExpression(ExpressionValueType.DateTime, v)
+		}
+	case DataType.Single:
+		if v, ok := value.(float32); ok {
+			result = newValueExpression(ExpressionValueType.Double, float64(v))
+		}
+	case DataType.Double:
+		if v, ok := value.(float64); ok {
+			result = newValueExpression(ExpressionValueType.Double, v)
+		}
+	case DataType.Decimal:
+		if v, ok := value.(decimal.Decimal); ok {
+			result = newValueExpression(ExpressionValueType.Decimal, v)
+		}
+	case DataType.Guid:
+		if v, ok := value.(guid.Guid); ok {
+			result = newValueExpression(ExpressionValueType.Guid, v)
+		}
+	case DataType.Int8:
+		if v, ok := value.(int8); ok {
+			result = newValueExpression(ExpressionValueType.Int32, int32(v))
+		}
+	case DataType.Int16:
+		if v, ok := value.(int16); ok {
+			result = newValueExpression(ExpressionValueType.Int32, int32(v))
+		}
+	case DataType.Int32:
+		if v, ok := value.(int32); ok {
+			result = newValueExpression(ExpressionValueType.Int32, v)
+		}
+	case DataType.Int64:
+		if v, ok := value.(int64); ok {
+			result = newValueExpression(ExpressionValueType.Int64, v)
+		}
+	case DataType.UInt8:
+		if v, ok := value.(uint8); ok {
+			result = newValueExpression(ExpressionValueType.Int32, int32(v))
+		}
+	case DataType.UInt16:
+		if v, ok := value.(uint16); ok {
+			result = newValueExpression(ExpressionValueType.Int32, int32(v))
+		}
+	case DataType.UInt32:
+		if v, ok := value.(uint32); ok {
+			result = newValueExpression(ExpressionValueType.Int64, int64(v))
+		}
+	case DataType.UInt64:
+		if v, ok := value.(uint64); ok {
+			if v > math.MaxInt64 {
+				result = newValueExpression(ExpressionValueType.Double, float64(v))
+			} else {
+				result = newValueExpression(ExpressionValueType.Int64, int64(v))
+			}
+		}
+	}
+
+	if result == nil {
+		return nil, fmt.Errorf("\"%s\" function returned %T value, expected Go type for \"%s\"", functionName, value, dataType.String())
+	}
+
+	return result, nil
+}
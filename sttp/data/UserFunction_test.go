@@ -0,0 +1,105 @@
+//******************************************************************************************************
+//  UserFunction_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUserFunctionSelect(t *testing.T) {
+	dataSet, _, _, _, freqID := createDataSet()
+
+	err := RegisterFunction("SignalClass", []DataTypeEnum{DataType.String}, DataType.String, func(arguments []interface{}) (interface{}, error) {
+		if arguments[0] == nil {
+			return nil, nil
+		}
+
+		return strings.ToLower(arguments[0].(string)[:1]), nil
+	})
+
+	if err != nil {
+		t.Fatal("TestUserFunctionSelect: failed to register function: " + err.Error())
+	}
+
+	defer UnregisterFunction("SignalClass")
+
+	idSet, err := SelectSignalIDSet(dataSet, "FILTER ActiveMeasurements WHERE signalclass(SignalType) = 'f'", "ActiveMeasurements", nil, true)
+
+	if err != nil {
+		t.Fatal("TestUserFunctionSelect: error executing SelectSignalIDSet: " + err.Error())
+	}
+
+	if len(idSet) != 1 || idSet.Keys()[0] != freqID {
+		t.Fatal("TestUserFunctionSelect: expected FREQ signal ID result")
+	}
+
+	if _, err := SelectSignalIDSet(dataSet, "FILTER ActiveMeasurements WHERE SignalClass(SignalType, 1) = 'f'", "ActiveMeasurements", nil, true); err == nil {
+		t.Fatal("TestUserFunctionSelect: expected argument count error")
+	}
+
+	if _, err := SelectSignalIDSet(dataSet, "FILTER ActiveMeasurements WHERE Unregistered(SignalType) = 'f'", "ActiveMeasurements", nil, true); err == nil {
+		t.Fatal("TestUserFunctionSelect: expected unknown function error")
+	}
+}
+
+func TestUserFunctionConversions(t *testing.T) {
+	err := RegisterFunction("Scale", []DataTypeEnum{DataType.Double, DataType.Int32}, DataType.Double, func(arguments []interface{}) (interface{}, error) {
+		if arguments[0] == nil || arguments[1] == nil {
+			return nil, nil
+		}
+
+		return arguments[0].(float64) * float64(arguments[1].(int32)), nil
+	})
+
+	if err != nil {
+		t.Fatal("TestUserFunctionConversions: failed to register function: " + err.Error())
+	}
+
+	defer UnregisterFunction("Scale")
+
+	result, err := EvaluateExpression("Scale(1.5, '2')", true)
+
+	if err != nil {
+		t.Fatal("TestUserFunctionConversions: error evaluating expression: " + err.Error())
+	}
+
+	if value, err := result.DoubleValue(); err != nil || value != 3.0 {
+		t.Fatal("TestUserFunctionConversions: expected result of 3.0, received: " + result.String())
+	}
+
+	if result, err = EvaluateExpression("Scale(NULL, 2) IS NULL", true); err != nil {
+		t.Fatal("TestUserFunctionConversions: error evaluating expression: " + err.Error())
+	}
+
+	if value, err := result.BooleanValue(); err != nil || !value {
+		t.Fatal("TestUserFunctionConversions: expected Null result, received: " + result.String())
+	}
+
+	for _, name := range []string{"", "Abs", "and", "Filter", "1st", "`Quoted`", "Two Words"} {
+		if err := RegisterFunction(name, nil, DataType.Boolean, func([]interface{}) (interface{}, error) { return true, nil }); err == nil {
+			t.Fatal("TestUserFunctionConversions: expected registration error for function name \"" + name + "\"")
+		}
+	}
+}
@@ -0,0 +1,1333 @@
+//******************************************************************************************************
+//  Validate.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/sttp/goapi/sttp/data/parser"
+)
+
+// DiagnosticSeverityEnum defines the type of the DiagnosticSeverity enumeration.
+type DiagnosticSeverityEnum int
+
+// DiagnosticSeverity is an enumeration of possible filter expression diagnostic severities.
+var DiagnosticSeverity = struct {
+	// Error defines a diagnostic for a filter expression that will fail to parse or evaluate.
+	Error DiagnosticSeverityEnum
+	// Warning defines a diagnostic for a filter expression that is valid but likely not what was intended.
+	Warning DiagnosticSeverityEnum
+}{
+	Error:   0,
+	Warning: 1,
+}
+
+// String gets the DiagnosticSeverity enumeration value as a string.
+func (dse DiagnosticSeverityEnum) String() string {
+	switch dse {
+	case DiagnosticSeverity.Error:
+		return "Error"
+	case DiagnosticSeverity.Warning:
+		return "Warning"
+	default:
+		return "0x" + strconv.FormatInt(int64(dse), 16)
+	}
+}
+
+// DiagnosticCodeEnum defines the type of the DiagnosticCode enumeration.
+type DiagnosticCodeEnum int
+
+// DiagnosticCode is an enumeration of possible filter expression diagnostic codes.
+var DiagnosticCode = struct {
+	// Syntax defines a diagnostic code for a filter expression syntax error.
+	Syntax DiagnosticCodeEnum
+	// UnknownTable defines a diagnostic code for a table name that does not exist in the DataSet.
+	UnknownTable DiagnosticCodeEnum
+	// UnknownColumn defines a diagnostic code for a column name that does not exist in its table.
+	UnknownColumn DiagnosticCodeEnum
+	// UnknownRelation defines a diagnostic code for a column path relation that does not exist for its table.
+	UnknownRelation DiagnosticCodeEnum
+	// UnknownFunction defines a diagnostic code for a function name that is not built-in, an aggregate or registered.
+	UnknownFunction DiagnosticCodeEnum
+	// ArgumentCount defines a diagnostic code for a function called with an unexpected number of arguments.
+	ArgumentCount DiagnosticCodeEnum
+	// TypeMismatch defines a diagnostic code for an operand or argument with a type that is not valid for its operation.
+	TypeMismatch DiagnosticCodeEnum
+	// ConstantPredicate defines a diagnostic code for a predicate that is always true, always false or never true.
+	ConstantPredicate DiagnosticCodeEnum
+	// Invalid defines a diagnostic code for any other filter expression that fails to parse.
+	Invalid DiagnosticCodeEnum
+}{
+	Syntax:            0,
+	UnknownTable:      1,
+	UnknownColumn:     2,
+	UnknownRelation:   3,
+	UnknownFunction:   4,
+	ArgumentCount:     5,
+	TypeMismatch:      6,
+	ConstantPredicate: 7,
+	Invalid:           8,
+}
+
+// String gets the DiagnosticCode enumeration value as a string.
+func (dce DiagnosticCodeEnum) String() string {
+	switch dce {
+	case DiagnosticCode.Syntax:
+		return "Syntax"
+	case DiagnosticCode.UnknownTable:
+		return "UnknownTable"
+	case DiagnosticCode.UnknownColumn:
+		return "UnknownColumn"
+	case DiagnosticCode.UnknownRelation:
+		return "UnknownRelation"
+	case DiagnosticCode.UnknownFunction:
+		return "UnknownFunction"
+	case DiagnosticCode.ArgumentCount:
+		return "ArgumentCount"
+	case DiagnosticCode.TypeMismatch:
+		return "TypeMismatch"
+	case DiagnosticCode.ConstantPredicate:
+		return "ConstantPredicate"
+	case DiagnosticCode.Invalid:
+		return "Invalid"
+	default:
+		return "0x" + strconv.FormatInt(int64(dce), 16)
+	}
+}
+
+// Diagnostic represents a problem found while statically validating a filter expression.
+type Diagnostic struct {
+	// Severity defines the severity of the diagnostic.
+	Severity DiagnosticSeverityEnum
+
+	// Code defines the kind of problem found.
+	Code DiagnosticCodeEnum
+
+	// Message defines a description of the problem.
+	Message string
+
+	// Line defines the 1-based line number of the start of the problem text.
+	Line int
+
+	// Column defines the 1-based character position in Line of the start of the problem text.
+	Column int
+
+	// Offset defines the 0-based character offset of the start of the problem text within the filter expression.
+	Offset int
+
+	// Length defines the number of characters in the problem text.
+	Length int
+
+	// Suggestions defines any close matching names for an unknown table, column, relation or function name.
+	Suggestions []string
+}
+
+// String gets a representation of the Diagnostic, e.g.: 1:29: Error: unknown column "SignalTyp", did you mean "SignalType"?
+func (d *Diagnostic) String() string {
+	var image strings.Builder
+
+	image.WriteString(strconv.Itoa(d.Line))
+	image.WriteRune(':')
+	image.WriteString(strconv.Itoa(d.Column))
+	image.WriteString(": ")
+	image.WriteString(d.Severity.String())
+	image.WriteString(": ")
+	image.WriteString(d.Message)
+
+	for i, suggestion := range d.Suggestions {
+		if i == 0 {
+			image.WriteString(", did you mean ")
+		} else if i == len(d.Suggestions)-1 {
+			image.WriteString(" or ")
+		} else {
+			image.WriteString(", ")
+		}
+
+		image.WriteString("\"" + suggestion + "\"")
+
+		if i == len(d.Suggestions)-1 {
+			image.WriteRune('?')
+		}
+	}
+
+	return image.String()
+}
+
+// HasErrors determines if any of the diagnostics have an Error severity.
+func HasErrors(diagnostics []*Diagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == DiagnosticSeverity.Error {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate statically checks the filterExpression against the tables of the dataSet without evaluating it.
+// Diagnostics are returned, in source order, for syntax errors, unknown table, column, relation and function
+// names, function argument count and type errors, operand type mismatches and predicates that are always true
+// or never true. Unknown names include suggestions for close matches. Filter expressions that only define a
+// "WHERE" expression, i.e., with no "FILTER" or "SELECT" table, are validated using ValidateForTable.
+func Validate(filterExpression string, dataSet *DataSet) []*Diagnostic {
+	return ValidateForTable(filterExpression, dataSet, "")
+}
+
+// ValidateForTable statically checks the filterExpression against the tables of the dataSet without evaluating it,
+// using primaryTable for statements that do not specify a table name. See Validate.
+func ValidateForTable(filterExpression string, dataSet *DataSet, primaryTable string) []*Diagnostic {
+	if dataSet == nil {
+		dataSet = NewDataSet()
+	}
+
+	validator := &filterExpressionValidator{
+		dataSet:          dataSet,
+		primaryTableName: primaryTable,
+		source:           []rune(filterExpression),
+		diagnostics:      make([]*Diagnostic, 0),
+	}
+
+	if len(strings.TrimSpace(filterExpression)) > 0 {
+		validator.validate(filterExpression)
+	}
+
+	// Parsing stops at the first error found while generating expression trees, order all found by position
+	sort.SliceStable(validator.diagnostics, func(i, j int) bool {
+		return validator.diagnostics[i].Offset < validator.diagnostics[j].Offset
+	})
+
+	return validator.diagnostics
+}
+
+// filterExpressionValidator checks names and argument counts while walking the parse tree, then checks
+// types and constant predicates of the expression trees generated from the same parse tree.
+type filterExpressionValidator struct {
+	*parser.BaseFilterExpressionSyntaxListener
+
+	dataSet          *DataSet
+	primaryTableName string
+	source           []rune
+	diagnostics      []*Diagnostic
+
+	table         *DataTable
+	tableResolved bool
+	tableReported bool
+
+	expressionContexts map[Expression]antlr.ParserRuleContext
+	expressionTypes    map[Expression]ExpressionValueTypeEnum
+	parameters         map[string]*ValueExpression
+}
+
+func (v *filterExpressionValidator) validate(filterExpression string) {
+	fep := NewFilterExpressionParser(filterExpression, true)
+	fep.DataSet = v.dataSet
+	fep.expressionContexts = make(map[Expression]antlr.ParserRuleContext)
+
+	if len(v.primaryTableName) > 0 {
+		fep.PrimaryTableName = v.primaryTableName
+		fep.TableIDFields[v.primaryTableName] = DefaultTableIDFields
+	}
+
+	errorListener := &validatorErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener(), validator: v}
+	fep.lexer.RemoveErrorListeners()
+	fep.lexer.AddErrorListener(errorListener)
+	fep.parser.RemoveErrorListeners()
+	fep.parser.AddErrorListener(errorListener)
+
+	parseTree := fep.parser.Parse()
+
+	// Recovered parse trees can be missing nodes, so names are only checked for well-formed expressions
+	if len(v.diagnostics) > 0 {
+		return
+	}
+
+	walker := antlr.NewParseTreeWalker()
+	walker.Walk(v, parseTree)
+
+	// Expression trees cannot be generated with unknown names or malformed function calls
+	if HasErrors(v.diagnostics) {
+		return
+	}
+
+	expressionTrees, ok := v.generateExpressionTrees(fep, parseTree)
+
+	if !ok {
+		return
+	}
+
+	v.expressionContexts = fep.expressionContexts
+	v.expressionTypes = make(map[Expression]ExpressionValueTypeEnum)
+
+	for _, expressionTree := range expressionTrees {
+		v.checkExpressionTree(expressionTree)
+	}
+}
+
+// generateExpressionTrees walks the parse tree with the filter expression parser, reporting any
+// failure at the position of the innermost parser rule being visited.
+func (v *filterExpressionValidator) generateExpressionTrees(fep *FilterExpressionParser, parseTree antlr.ParseTree) (expressionTrees []*ExpressionTree, ok bool) {
+	treeParser := &validatingFilterExpressionParser{FilterExpressionParser: fep}
+
+	defer func() {
+		if r := recover(); r != nil {
+			var message string
+
+			switch rt := r.(type) {
+			case string:
+				message = rt
+			case error:
+				message = rt.Error()
+			default:
+				message = "unknown panic"
+			}
+
+			var context antlr.ParserRuleContext
+
+			if count := len(treeParser.rules); count > 0 {
+				context = treeParser.rules[count-1]
+			}
+
+			v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.Invalid, context, message, nil)
+			expressionTrees, ok = nil, false
+		}
+	}()
+
+	walker := antlr.NewParseTreeWalker()
+	walker.Walk(treeParser, parseTree)
+
+	return fep.expressionTrees, true
+}
+
+// validatingFilterExpressionParser tracks the parser rules being visited by the filter expression parser.
+type validatingFilterExpressionParser struct {
+	*FilterExpressionParser
+	rules []antlr.ParserRuleContext
+}
+
+// EnterEveryRule is called when any rule is entered.
+func (vfep *validatingFilterExpressionParser) EnterEveryRule(context antlr.ParserRuleContext) {
+	vfep.rules = append(vfep.rules, context)
+}
+
+// ExitEveryRule is called when any rule is exited.
+func (vfep *validatingFilterExpressionParser) ExitEveryRule(antlr.ParserRuleContext) {
+	vfep.rules = vfep.rules[:len(vfep.rules)-1]
+}
+
+// validatorErrorListener reports ANTLR lexer and parser syntax errors as diagnostics.
+type validatorErrorListener struct {
+	*antlr.DefaultErrorListener
+	validator *filterExpressionValidator
+}
+
+// SyntaxError is called when ANTLR lexer or parser encounters a syntax error.
+func (vel *validatorErrorListener) SyntaxError(_ antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+	if token, ok := offendingSymbol.(antlr.Token); ok && token.GetTokenType() != antlr.TokenEOF {
+		vel.validator.addTokenDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.Syntax, token, token, msg, nil)
+		return
+	}
+
+	v := vel.validator
+	offset := v.offset(line, column)
+	length := 1
+
+	// Errors at end of input have no text
+	if offset >= len(v.source) {
+		length = 0
+	}
+
+	v.diagnostics = append(v.diagnostics, &Diagnostic{
+		Severity: DiagnosticSeverity.Error,
+		Code:     DiagnosticCode.Syntax,
+		Message:  msg,
+		Line:     line,
+		Column:   column + 1,
+		Offset:   offset,
+		Length:   length,
+	})
+}
+
+// offset gets the character offset of the 0-based column in the 1-based line of the source.
+func (v *filterExpressionValidator) offset(line, column int) int {
+	currentLine := 1
+
+	for i, char := range v.source {
+		if currentLine == line {
+			if i+column > len(v.source) {
+				return len(v.source)
+			}
+
+			return i + column
+		}
+
+		if char == '\n' {
+			currentLine++
+		}
+	}
+
+	return len(v.source)
+}
+
+func (v *filterExpressionValidator) addTokenDiagnostic(severity DiagnosticSeverityEnum, code DiagnosticCodeEnum, start, stop antlr.Token, message string, suggestions []string) {
+	length := stop.GetStop() - start.GetStart() + 1
+
+	if length < 1 {
+		length = 1
+	}
+
+	v.diagnostics = append(v.diagnostics, &Diagnostic{
+		Severity:    severity,
+		Code:        code,
+		Message:     message,
+		Line:        start.GetLine(),
+		Column:      start.GetColumn() + 1,
+		Offset:      start.GetStart(),
+		Length:      length,
+		Suggestions: suggestions,
+	})
+}
+
+func (v *filterExpressionValidator) addContextDiagnostic(severity DiagnosticSeverityEnum, code DiagnosticCodeEnum, context antlr.ParserRuleContext, message string, suggestions []string) {
+	if context == nil || context.GetStart() == nil {
+		v.diagnostics = append(v.diagnostics, &Diagnostic{
+			Severity:    severity,
+			Code:        code,
+			Message:     message,
+			Line:        1,
+			Column:      1,
+			Length:      len(v.source),
+			Suggestions: suggestions,
+		})
+
+		return
+	}
+
+	stop := context.GetStop()
+
+	if stop == nil || stop.GetStop() < context.GetStart().GetStart() {
+		stop = context.GetStart()
+	}
+
+	v.addTokenDiagnostic(severity, code, context.GetStart(), stop, message, suggestions)
+}
+
+func (v *filterExpressionValidator) addExpressionDiagnostic(severity DiagnosticSeverityEnum, code DiagnosticCodeEnum, expression Expression, message string) {
+	v.addContextDiagnostic(severity, code, v.expressionContexts[expression], message, nil)
+}
+
+// Name Validation
+
+// EnterFilterExpressionStatement is called when production filterExpressionStatement is entered.
+func (v *filterExpressionValidator) EnterFilterExpressionStatement(*parser.FilterExpressionStatementContext) {
+	v.table = nil
+	v.tableResolved = false
+	v.tableReported = false
+}
+
+// EnterFilterStatement is called when production filterStatement is entered.
+func (v *filterExpressionValidator) EnterFilterStatement(context *parser.FilterStatementContext) {
+	if !v.resolveTable(context.TableName()) {
+		return
+	}
+
+	for _, orderingTerm := range context.AllOrderingTerm() {
+		orderByColumnName := orderingTerm.(*parser.OrderingTermContext).OrderByColumnName()
+		v.checkColumn(orderByColumnName, v.table, parseIdentifier(orderByColumnName.GetText()))
+	}
+}
+
+// EnterSelectStatement is called when production selectStatement is entered.
+func (v *filterExpressionValidator) EnterSelectStatement(context *parser.SelectStatementContext) {
+	v.resolveTable(context.TableName())
+}
+
+// ExitSelectStatement is called when production selectStatement is exited.
+func (v *filterExpressionValidator) ExitSelectStatement(context *parser.SelectStatementContext) {
+	if v.table == nil || context.K_ORDER() == nil {
+		return
+	}
+
+	// Order by terms for select statements reference result columns
+	selectTerms := context.AllSelectTerm()
+	names := make([]string, len(selectTerms))
+
+	for i, selectTerm := range selectTerms {
+		selectTermContext := selectTerm.(*parser.SelectTermContext)
+
+		if columnAlias := selectTermContext.ColumnAlias(); columnAlias != nil {
+			names[i] = parseIdentifier(columnAlias.GetText())
+		} else if columnName := selectTermColumnName(selectTermContext.Expression()); columnName != "" {
+			names[i] = columnName
+		} else {
+			names[i] = "Column" + strconv.Itoa(i+1)
+		}
+	}
+
+	for _, orderingTerm := range context.AllOrderingTerm() {
+		orderByColumnName := orderingTerm.(*parser.OrderingTermContext).OrderByColumnName()
+		columnName := parseIdentifier(orderByColumnName.GetText())
+		found := false
+
+		for _, name := range names {
+			if strings.EqualFold(name, columnName) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, orderByColumnName, "unknown order by column \""+columnName+"\" in select result columns", suggestNames(columnName, names))
+		}
+	}
+}
+
+// selectTermColumnName gets the column name of a select term expression that is only a column reference.
+func selectTermColumnName(tree antlr.Tree) string {
+	for tree != nil {
+		if columnNameContext, ok := tree.(*parser.ColumnNameContext); ok {
+			pathElements := parseColumnPath(columnNameContext.GetText())
+			return pathElements[len(pathElements)-1]
+		}
+
+		if tree.GetChildCount() != 1 {
+			return ""
+		}
+
+		tree = tree.GetChild(0)
+	}
+
+	return ""
+}
+
+// ExitColumnName is called when production columnName is exited.
+func (v *filterExpressionValidator) ExitColumnName(context *parser.ColumnNameContext) {
+	table := v.statementTable(context)
+
+	if table == nil {
+		return
+	}
+
+	if context.COLUMN_PATH() == nil {
+		v.checkColumn(context, table, parseIdentifier(context.GetText()))
+		return
+	}
+
+	pathElements := parseColumnPath(context.COLUMN_PATH().GetText())
+
+	for _, relationName := range pathElements[:len(pathElements)-1] {
+		relation := v.dataSet.Relation(table.Name(), relationName)
+
+		if relation == nil {
+			names := make([]string, 0)
+
+			for _, candidate := range v.dataSet.Relations() {
+				if strings.EqualFold(candidate.ChildTable().Name(), table.Name()) {
+					names = append(names, candidate.Name())
+				}
+			}
+
+			for _, fields := range DefaultDataRelations {
+				if strings.EqualFold(fields.ChildTableName, table.Name()) && v.dataSet.Relation(table.Name(), fields.Name) != nil {
+					names = append(names, fields.Name)
+				}
+			}
+
+			v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownRelation, context, "unknown relation \""+relationName+"\" for table \""+table.Name()+"\"", suggestNames(relationName, names))
+			return
+		}
+
+		table = relation.ParentTable()
+	}
+
+	v.checkColumn(context, table, pathElements[len(pathElements)-1])
+}
+
+// ExitFunctionExpression is called when production functionExpression is exited.
+func (v *filterExpressionValidator) ExitFunctionExpression(context *parser.FunctionExpressionContext) {
+	functionName := context.FunctionName().GetText()
+	argumentCount := 0
+	hasStarArgument := context.GetToken(parser.FilterExpressionSyntaxParserT__25, 0) != nil
+
+	if expressionList := context.ExpressionList(); expressionList != nil {
+		argumentCount = len(expressionList.(*parser.ExpressionListContext).AllExpression())
+	}
+
+	if aggregateType, err := ParseExpressionAggregateType(functionName); err == nil {
+		if hasStarArgument || argumentCount == 0 {
+			if aggregateType != ExpressionAggregateType.Count {
+				v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, context, "\""+aggregateType.String()+"\" aggregate function requires a value argument", nil)
+			}
+		} else if argumentCount != 1 {
+			v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, context, "\""+aggregateType.String()+"\" aggregate function expects 1 argument, received "+strconv.Itoa(argumentCount), nil)
+		}
+
+		return
+	}
+
+	if hasStarArgument {
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, context, "\"*\" argument is only valid for \"Count\" aggregate function, received \""+functionName+"\"", nil)
+		return
+	}
+
+	if functionType, ok := builtInFunctionTypes[strings.ToUpper(functionName)]; ok {
+		signature := functionSignatures[functionType]
+
+		if argumentCount < signature.minArguments || signature.maxArguments > -1 && argumentCount > signature.maxArguments {
+			v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, context, "\""+functionType.String()+"\" function expects "+signature.argumentCountText()+", received "+strconv.Itoa(argumentCount), nil)
+		}
+
+		return
+	}
+
+	userFunction := LookupFunction(functionName)
+
+	if userFunction == nil {
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownFunction, context.FunctionName(), "unknown function \""+functionName+"\"", suggestNames(functionName, functionNames()))
+		return
+	}
+
+	if argumentCount != len(userFunction.ArgumentTypes()) {
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, context, "\""+userFunction.Name()+"\" function expects "+strconv.Itoa(len(userFunction.ArgumentTypes()))+" arguments, received "+strconv.Itoa(argumentCount), nil)
+	}
+}
+
+func (v *filterExpressionValidator) resolveTable(tableNameContext parser.ITableNameContext) bool {
+	tableName := parseIdentifier(tableNameContext.GetText())
+	v.table = v.dataSet.Table(tableName)
+	v.tableResolved = true
+
+	if v.table == nil {
+		v.tableReported = true
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownTable, tableNameContext, "unknown table \""+tableName+"\"", suggestNames(tableName, v.dataSet.TableNames()))
+		return false
+	}
+
+	return true
+}
+
+// statementTable gets the table of the active statement, or the primary table for statements that do not define one.
+func (v *filterExpressionValidator) statementTable(context antlr.ParserRuleContext) *DataTable {
+	if v.tableResolved {
+		return v.table
+	}
+
+	v.tableResolved = true
+
+	if len(v.primaryTableName) == 0 {
+		v.tableReported = true
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownTable, context, "no table name defined for expression nor is any primary table defined", nil)
+		return nil
+	}
+
+	if v.table = v.dataSet.Table(v.primaryTableName); v.table == nil {
+		v.tableReported = true
+		v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownTable, context, "unknown primary table \""+v.primaryTableName+"\"", suggestNames(v.primaryTableName, v.dataSet.TableNames()))
+	}
+
+	return v.table
+}
+
+func (v *filterExpressionValidator) checkColumn(context antlr.ParserRuleContext, table *DataTable, columnName string) {
+	if table.ColumnByName(columnName) != nil {
+		return
+	}
+
+	names := make([]string, table.ColumnCount())
+
+	for i := range names {
+		names[i] = table.Column(i).Name()
+	}
+
+	v.addContextDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, context, "unknown column \""+columnName+"\" in table \""+table.Name()+"\"", suggestNames(columnName, names))
+}
+
+// builtInFunctionTypes maps upper-case built-in function names to their function type.
+var builtInFunctionTypes = func() map[string]ExpressionFunctionTypeEnum {
+	functionTypes := make(map[string]ExpressionFunctionTypeEnum)
+
+	for functionType := ExpressionFunctionType.Abs; functionType < ExpressionFunctionType.UserDefined; functionType++ {
+		functionTypes[strings.ToUpper(functionType.String())] = functionType
+	}
+
+	return functionTypes
+}()
+
+// functionNames gets the names of all built-in, aggregate and registered user-defined functions.
+func functionNames() []string {
+	names := make([]string, 0, len(builtInFunctionTypes)+5)
+
+	for functionType := ExpressionFunctionType.Abs; functionType < ExpressionFunctionType.UserDefined; functionType++ {
+		names = append(names, functionType.String())
+	}
+
+	for aggregateType := ExpressionAggregateType.Count; aggregateType <= ExpressionAggregateType.Max; aggregateType++ {
+		names = append(names, aggregateType.String())
+	}
+
+	userFunctionsLock.RLock()
+	defer userFunctionsLock.RUnlock()
+
+	for _, userFunction := range userFunctions {
+		names = append(names, userFunction.name)
+	}
+
+	return names
+}
+
+// suggestNames gets up to three candidates that closely match name, ignoring case, closest first.
+func suggestNames(name string, candidates []string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	target := strings.ToUpper(name)
+	maxDistance := len([]rune(target)) / 4
+
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	suggestions := make([]suggestion, 0)
+	encountered := make(map[string]bool)
+
+	for _, candidate := range candidates {
+		source := strings.ToUpper(candidate)
+
+		if encountered[source] {
+			continue
+		}
+
+		encountered[source] = true
+		distance := editDistance(target, source)
+
+		// Treat a partially typed name as a close match
+		if distance > maxDistance && len(target) >= 3 && strings.HasPrefix(source, target) {
+			distance = maxDistance
+		}
+
+		if distance > 0 && distance <= maxDistance {
+			suggestions = append(suggestions, suggestion{candidate, distance})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	if len(suggestions) > 3 {
+		suggestions = suggestions[:3]
+	}
+
+	names := make([]string, len(suggestions))
+
+	for i, suggestion := range suggestions {
+		names[i] = suggestion.name
+	}
+
+	return names
+}
+
+// editDistance gets the Levenshtein distance between two strings.
+func editDistance(left, right string) int {
+	source, target := []rune(left), []rune(right)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
+
+// Function Signatures
+
+// argumentKind defines the values types accepted for a function argument.
+type argumentKind int
+
+const (
+	anyArgument argumentKind = iota
+	stringArgument
+	booleanArgument
+	integerArgument
+	numericArgument
+	dateArgument
+)
+
+func (ak argumentKind) accepts(valueType ExpressionValueTypeEnum) bool {
+	switch ak {
+	case stringArgument:
+		return valueType == ExpressionValueType.String
+	case booleanArgument:
+		return valueType == ExpressionValueType.Boolean
+	case integerArgument:
+		return valueType.IsIntegerType()
+	case numericArgument:
+		return valueType.IsNumericType()
+	case dateArgument:
+		return valueType == ExpressionValueType.DateTime || valueType == ExpressionValueType.String
+	default:
+		return true
+	}
+}
+
+func (ak argumentKind) String() string {
+	switch ak {
+	case stringArgument:
+		return "a \"String\""
+	case booleanArgument:
+		return "a \"Boolean\""
+	case integerArgument:
+		return "an integer type"
+	case numericArgument:
+		return "numeric"
+	case dateArgument:
+		return "a \"DateTime\" or a \"String\""
+	default:
+		return "any type"
+	}
+}
+
+// functionSignature defines the expected arguments and result type of a built-in function. Functions that
+// return the type of their first argument have a sourceResult, functions with dynamic results are Undefined.
+type functionSignature struct {
+	minArguments  int
+	maxArguments  int
+	argumentKinds []argumentKind
+	resultType    ExpressionValueTypeEnum
+	sourceResult  bool
+}
+
+func (fs functionSignature) argumentCountText() string {
+	switch {
+	case fs.maxArguments < 0:
+		return strconv.Itoa(fs.minArguments) + " or more arguments"
+	case fs.minArguments == fs.maxArguments && fs.minArguments == 1:
+		return "1 argument"
+	case fs.minArguments == fs.maxArguments:
+		return strconv.Itoa(fs.minArguments) + " arguments"
+	default:
+		return strconv.Itoa(fs.minArguments) + " or " + strconv.Itoa(fs.maxArguments) + " arguments"
+	}
+}
+
+var functionSignatures = map[ExpressionFunctionTypeEnum]functionSignature{
+	ExpressionFunctionType.Abs:         {1, 1, []argumentKind{numericArgument}, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.Ceiling:     {1, 1, []argumentKind{numericArgument}, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.Coalesce:    {2, -1, nil, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.Convert:     {2, 2, []argumentKind{anyArgument, stringArgument}, ExpressionValueType.Undefined, false},
+	ExpressionFunctionType.Contains:    {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.DateAdd:     {3, 3, []argumentKind{dateArgument, integerArgument, stringArgument}, ExpressionValueType.DateTime, false},
+	ExpressionFunctionType.DateDiff:    {3, 3, []argumentKind{dateArgument, dateArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.DatePart:    {2, 2, []argumentKind{dateArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.EndsWith:    {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.Floor:       {1, 1, []argumentKind{numericArgument}, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.IIf:         {3, 3, []argumentKind{booleanArgument}, ExpressionValueType.Undefined, false},
+	ExpressionFunctionType.IndexOf:     {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.IsDate:      {1, 1, nil, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.IsInteger:   {1, 1, nil, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.IsGuid:      {1, 1, nil, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.IsNull:      {2, 2, nil, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.IsNumeric:   {1, 1, nil, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.LastIndexOf: {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.Len:         {1, 1, []argumentKind{stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.Lower:       {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.MaxOf:       {2, -1, nil, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.MinOf:       {2, -1, nil, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.Now:         {0, 0, nil, ExpressionValueType.DateTime, false},
+	ExpressionFunctionType.NthIndexOf:  {3, 4, []argumentKind{stringArgument, stringArgument, integerArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.Power:       {2, 2, []argumentKind{numericArgument, numericArgument}, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.RegExMatch:  {2, 2, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.RegExVal:    {2, 2, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Replace:     {3, 4, []argumentKind{stringArgument, stringArgument, stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Reverse:     {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Round:       {1, 1, []argumentKind{numericArgument}, ExpressionValueType.Undefined, true},
+	ExpressionFunctionType.Split:       {3, 4, []argumentKind{stringArgument, stringArgument, integerArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Sqrt:        {1, 1, []argumentKind{numericArgument}, ExpressionValueType.Double, false},
+	ExpressionFunctionType.StartsWith:  {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Boolean, false},
+	ExpressionFunctionType.StrCount:    {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.StrCmp:      {2, 3, []argumentKind{stringArgument, stringArgument}, ExpressionValueType.Int32, false},
+	ExpressionFunctionType.SubStr:      {2, 3, []argumentKind{stringArgument, integerArgument, integerArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Trim:        {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.TrimLeft:    {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.TrimRight:   {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.Upper:       {1, 1, []argumentKind{stringArgument}, ExpressionValueType.String, false},
+	ExpressionFunctionType.UtcNow:      {0, 0, nil, ExpressionValueType.DateTime, false},
+}
+
+var ordinalNames = []string{"first", "second", "third", "fourth"}
+
+// Type Validation
+
+func (v *filterExpressionValidator) checkExpressionTree(expressionTree *ExpressionTree) {
+	for _, selectTerm := range expressionTree.SelectTerms {
+		v.valueType(selectTerm.Expression)
+		v.checkPredicates(selectTerm.Expression)
+	}
+
+	root := expressionTree.Root
+
+	if root == nil {
+		return
+	}
+
+	rootType, known := v.valueType(root)
+
+	if known && rootType != ExpressionValueType.Boolean {
+		if len(expressionTree.TableName) > 0 {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, root, "\"WHERE\" expression must be a \"Boolean\", received \""+rootType.String()+"\"")
+		} else {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.TypeMismatch, root, "filter expression is a \""+rootType.String()+"\" and will not match any rows, expected a \"Boolean\"")
+		}
+	}
+
+	v.checkPredicates(root)
+}
+
+// valueType gets the statically derived value type of the expression, reporting any type errors. Returned
+// flag is false when the type cannot be known before evaluation, e.g., for NULL literals or parameters.
+func (v *filterExpressionValidator) valueType(expression Expression) (ExpressionValueTypeEnum, bool) {
+	if expression == nil {
+		return ExpressionValueType.Undefined, false
+	}
+
+	valueType := v.deriveValueType(expression)
+	v.expressionTypes[expression] = valueType
+
+	return valueType, valueType != ExpressionValueType.Undefined
+}
+
+//gocyclo:ignore
+func (v *filterExpressionValidator) deriveValueType(expression Expression) ExpressionValueTypeEnum {
+	switch expression.Type() {
+	case ExpressionType.Value:
+		return expression.(*ValueExpression).ValueType()
+	case ExpressionType.Column:
+		return dataTypeValueType(expression.(*ColumnExpression).DataColumn().Type())
+	case ExpressionType.Parameter:
+		if value, ok := v.parameters[strings.ToUpper(expression.(*ParameterExpression).Name())]; ok {
+			return value.ValueType()
+		}
+
+		return ExpressionValueType.Undefined
+	case ExpressionType.Unary:
+		unaryExpression := expression.(*UnaryExpression)
+		valueType, known := v.valueType(unaryExpression.Value())
+
+		if known && !valueType.IsNumericType() {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, expression, "cannot apply unary \""+unaryExpression.UnaryType().String()+"\" operator to \""+valueType.String()+"\"")
+			return ExpressionValueType.Undefined
+		}
+
+		return valueType
+	case ExpressionType.Operator:
+		return v.deriveOperatorValueType(expression.(*OperatorExpression))
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		valueType, known := v.valueType(inListExpression.Value())
+
+		for _, argument := range inListExpression.Arguments() {
+			if argumentType, argumentKnown := v.valueType(argument); known && argumentKnown {
+				if _, err := ExpressionOperatorType.Equal.deriveOperationValueType(valueType, argumentType); err != nil {
+					v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, argument, "\"IN\" expression argument: "+err.Error())
+				}
+			}
+		}
+
+		return ExpressionValueType.Boolean
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+		valueType, known := v.valueType(betweenExpression.Value())
+
+		for _, bound := range []Expression{betweenExpression.LowerBound(), betweenExpression.UpperBound()} {
+			if boundType, boundKnown := v.valueType(bound); known && boundKnown {
+				if _, err := ExpressionOperatorType.GreaterThanOrEqual.deriveOperationValueType(valueType, boundType); err != nil {
+					v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, bound, "\"BETWEEN\" expression bound: "+err.Error())
+				}
+			}
+		}
+
+		return ExpressionValueType.Boolean
+	case ExpressionType.Case:
+		return v.deriveCaseValueType(expression.(*CaseExpression))
+	case ExpressionType.Function:
+		return v.deriveFunctionValueType(expression.(*FunctionExpression))
+	case ExpressionType.Aggregate:
+		aggregateExpression := expression.(*AggregateExpression)
+		valueType, known := v.valueType(aggregateExpression.Value())
+
+		switch aggregateExpression.AggregateType() {
+		case ExpressionAggregateType.Count:
+			return ExpressionValueType.Int32
+		case ExpressionAggregateType.Sum, ExpressionAggregateType.Avg:
+			if known {
+				if err := validateNumericAggregate(aggregateExpression.AggregateType(), valueType); err != nil {
+					v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, expression, err.Error())
+					return ExpressionValueType.Undefined
+				}
+			}
+
+			if aggregateExpression.AggregateType() == ExpressionAggregateType.Avg && valueType != ExpressionValueType.Decimal {
+				return ExpressionValueType.Double
+			}
+
+			return valueType
+		default:
+			return valueType
+		}
+	default:
+		return ExpressionValueType.Undefined
+	}
+}
+
+func (v *filterExpressionValidator) deriveOperatorValueType(operatorExpression *OperatorExpression) ExpressionValueTypeEnum {
+	operatorType := operatorExpression.OperatorType()
+	leftType, leftKnown := v.valueType(operatorExpression.LeftValue())
+	rightType, rightKnown := v.valueType(operatorExpression.RightValue())
+
+	var resultType ExpressionValueTypeEnum
+
+	switch operatorType {
+	case ExpressionOperatorType.IsNull, ExpressionOperatorType.IsNotNull:
+		return ExpressionValueType.Boolean
+	case ExpressionOperatorType.Like, ExpressionOperatorType.LikeExactMatch, ExpressionOperatorType.NotLike, ExpressionOperatorType.NotLikeExactMatch:
+		if leftKnown && leftType != ExpressionValueType.String || rightKnown && rightType != ExpressionValueType.String {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, operatorExpression, "cannot perform \"LIKE\" operation on \""+leftType.String()+"\" and \""+rightType.String()+"\"")
+		}
+
+		return ExpressionValueType.Boolean
+	case ExpressionOperatorType.BitShiftLeft, ExpressionOperatorType.BitShiftRight:
+		if leftKnown && !leftType.IsIntegerType() {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, operatorExpression, "cannot apply bit-shift \""+operatorType.String()+"\" operator to \""+leftType.String()+"\"")
+			return ExpressionValueType.Undefined
+		}
+
+		if rightKnown && !rightType.IsIntegerType() {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, operatorExpression, "BitShift operation shift value must be an integer")
+		}
+
+		return leftType
+	case ExpressionOperatorType.Concatenate:
+		return ExpressionValueType.String
+	case ExpressionOperatorType.And, ExpressionOperatorType.Or:
+		if leftKnown && leftType != ExpressionValueType.Boolean || rightKnown && rightType != ExpressionValueType.Boolean {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, operatorExpression, "cannot perform \""+operatorType.String()+"\" operation on \""+leftType.String()+"\" and \""+rightType.String()+"\"")
+		}
+
+		return ExpressionValueType.Boolean
+	}
+
+	isComparison := operatorType >= ExpressionOperatorType.LessThan && operatorType <= ExpressionOperatorType.NotEqualExactMatch
+
+	if leftKnown && rightKnown {
+		var err error
+
+		if resultType, err = operatorType.deriveOperationValueType(leftType, rightType); err != nil {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, operatorExpression, err.Error())
+			resultType = ExpressionValueType.Undefined
+		}
+	}
+
+	if isComparison {
+		return ExpressionValueType.Boolean
+	}
+
+	return resultType
+}
+
+func (v *filterExpressionValidator) deriveCaseValueType(caseExpression *CaseExpression) ExpressionValueTypeEnum {
+	valueType, known := v.valueType(caseExpression.Value())
+
+	for _, condition := range caseExpression.Conditions() {
+		conditionType, conditionKnown := v.valueType(condition)
+
+		if !conditionKnown {
+			continue
+		}
+
+		if caseExpression.Value() == nil {
+			if conditionType != ExpressionValueType.Boolean {
+				v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, condition, "\"CASE\" expression \"WHEN\" condition must be a \"Boolean\", received \""+conditionType.String()+"\"")
+			}
+		} else if known {
+			if _, err := ExpressionOperatorType.Equal.deriveOperationValueType(valueType, conditionType); err != nil {
+				v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, condition, "\"CASE\" expression \"WHEN\" value: "+err.Error())
+			}
+		}
+	}
+
+	resultType := ExpressionValueType.Undefined
+
+	for _, result := range append(caseExpression.Results(), caseExpression.ElseResult()) {
+		if resultValueType, resultKnown := v.valueType(result); resultKnown && resultType == ExpressionValueType.Undefined {
+			resultType = resultValueType
+		}
+	}
+
+	return resultType
+}
+
+func (v *filterExpressionValidator) deriveFunctionValueType(functionExpression *FunctionExpression) ExpressionValueTypeEnum {
+	arguments := functionExpression.Arguments()
+	argumentTypes := make([]ExpressionValueTypeEnum, len(arguments))
+	argumentsKnown := make([]bool, len(arguments))
+
+	for i, argument := range arguments {
+		argumentTypes[i], argumentsKnown[i] = v.valueType(argument)
+	}
+
+	functionType := functionExpression.FunctionType()
+
+	if functionType == ExpressionFunctionType.UserDefined {
+		userFunction := functionExpression.UserFunction()
+
+		for i, argumentType := range userFunction.ArgumentTypes() {
+			if i < len(arguments) && argumentsKnown[i] && argumentTypes[i] != dataTypeValueType(argumentType) {
+				v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.TypeMismatch, arguments[i], "\""+userFunction.Name()+"\" function argument "+strconv.Itoa(i)+" expects a \""+dataTypeValueType(argumentType).String()+"\", received \""+argumentTypes[i].String()+"\", value will be converted")
+			}
+		}
+
+		return dataTypeValueType(userFunction.ReturnType())
+	}
+
+	signature, ok := functionSignatures[functionType]
+
+	if !ok {
+		return ExpressionValueType.Undefined
+	}
+
+	for i, kind := range signature.argumentKinds {
+		if i < len(arguments) && argumentsKnown[i] && !kind.accepts(argumentTypes[i]) {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, arguments[i], "\""+functionType.String()+"\" function "+ordinalNames[i]+" argument must be "+kind.String()+", received \""+argumentTypes[i].String()+"\"")
+		}
+	}
+
+	if functionType == ExpressionFunctionType.Convert && len(arguments) == 2 && isConstantExpression(arguments[1]) {
+		// Converting a Null value to the target type derives the result type
+		result, err := evaluateConstantExpression(NewFunctionExpression(functionType, []Expression{NullValue(ExpressionValueType.Undefined), arguments[1]}))
+
+		if err != nil {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, arguments[1], err.Error())
+			return ExpressionValueType.Undefined
+		}
+
+		return result.ValueType()
+	}
+
+	if signature.sourceResult && len(arguments) > 0 {
+		return argumentTypes[0]
+	}
+
+	return signature.resultType
+}
+
+// staticValueTypes derives the value types of the expression and its child expressions without evaluation, using
+// any bound parameter values for parameter types. Types that cannot be known before evaluation are Undefined.
+func staticValueTypes(expression Expression, parameters map[string]*ValueExpression) map[Expression]ExpressionValueTypeEnum {
+	validator := &filterExpressionValidator{
+		expressionTypes: make(map[Expression]ExpressionValueTypeEnum),
+		parameters:      parameters,
+	}
+
+	validator.valueType(expression)
+
+	return validator.expressionTypes
+}
+
+// Constant Predicate Validation
+
+// checkPredicates reports boolean sub-expressions with results that do not depend on row values.
+//gocyclo:ignore
+func (v *filterExpressionValidator) checkPredicates(expression Expression) {
+	if expression == nil {
+		return
+	}
+
+	if expression.Type() != ExpressionType.Value && v.expressionTypes[expression] == ExpressionValueType.Boolean && isConstantExpression(expression) {
+		if result, err := evaluateConstantExpression(expression); err == nil && result.ValueType() == ExpressionValueType.Boolean && !result.IsNull() {
+			v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, expression, "expression \""+ExpressionString(expression)+"\" is always "+strings.ToLower(result.String()))
+		}
+
+		return
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Unary:
+		v.checkPredicates(expression.(*UnaryExpression).Value())
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		operatorType := operatorExpression.OperatorType()
+		leftValue, rightValue := operatorExpression.LeftValue(), operatorExpression.RightValue()
+
+		switch {
+		case operatorType == ExpressionOperatorType.And || operatorType == ExpressionOperatorType.Or:
+			// A constant operand that decides the logical operation makes the other operand irrelevant
+			decidingValue := operatorType == ExpressionOperatorType.Or
+
+			for _, operand := range []Expression{leftValue, rightValue} {
+				if operand.Type() == ExpressionType.Value && isConstantBoolean(operand, decidingValue) {
+					v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, expression, "expression \""+ExpressionString(expression)+"\" is always "+strconv.FormatBool(decidingValue))
+					break
+				}
+			}
+		case operatorType >= ExpressionOperatorType.LessThan && operatorType <= ExpressionOperatorType.NotEqualExactMatch:
+			if isNullLiteral(leftValue) || isNullLiteral(rightValue) {
+				v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, expression, "comparison \""+ExpressionString(expression)+"\" with NULL is never true, use \"IS NULL\" or \"IS NOT NULL\"")
+			}
+		}
+
+		v.checkPredicates(leftValue)
+		v.checkPredicates(rightValue)
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		v.checkPredicates(inListExpression.Value())
+
+		for _, argument := range inListExpression.Arguments() {
+			v.checkPredicates(argument)
+		}
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+		lowerBound, upperBound := betweenExpression.LowerBound(), betweenExpression.UpperBound()
+
+		if isConstantExpression(lowerBound) && isConstantExpression(upperBound) {
+			if result, err := evaluateConstantExpression(NewOperatorExpression(ExpressionOperatorType.GreaterThan, lowerBound, upperBound)); err == nil && result.ValueType() == ExpressionValueType.Boolean && !result.IsNull() && result.booleanValue() {
+				v.addExpressionDiagnostic(DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, expression, "expression \""+ExpressionString(expression)+"\" is always "+strconv.FormatBool(betweenExpression.HasNotKeyword())+", lower bound is greater than upper bound")
+			}
+		}
+
+		v.checkPredicates(betweenExpression.Value())
+		v.checkPredicates(lowerBound)
+		v.checkPredicates(upperBound)
+	case ExpressionType.Case:
+		caseExpression := expression.(*CaseExpression)
+		v.checkPredicates(caseExpression.Value())
+
+		for i, condition := range caseExpression.Conditions() {
+			v.checkPredicates(condition)
+			v.checkPredicates(caseExpression.Results()[i])
+		}
+
+		v.checkPredicates(caseExpression.ElseResult())
+	case ExpressionType.Function:
+		for _, argument := range expression.(*FunctionExpression).Arguments() {
+			v.checkPredicates(argument)
+		}
+	case ExpressionType.Aggregate:
+		v.checkPredicates(expression.(*AggregateExpression).Value())
+	}
+}
+
+// isConstantExpression determines if the expression result does not depend on row values, parameters or time.
+func isConstantExpression(expression Expression) bool {
+	if expression == nil {
+		return true
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Value:
+		return true
+	case ExpressionType.Unary:
+		return isConstantExpression(expression.(*UnaryExpression).Value())
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		return isConstantExpression(operatorExpression.LeftValue()) && isConstantExpression(operatorExpression.RightValue())
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		return isConstantExpression(inListExpression.Value()) && allConstantExpressions(inListExpression.Arguments())
+	case ExpressionType.Between:
+		betweenExpression := expression.(*BetweenExpression)
+		return allConstantExpressions([]Expression{betweenExpression.Value(), betweenExpression.LowerBound(), betweenExpression.UpperBound()})
+	case ExpressionType.Case:
+		caseExpression := expression.(*CaseExpression)
+		return isConstantExpression(caseExpression.Value()) && allConstantExpressions(caseExpression.Conditions()) &&
+			allConstantExpressions(caseExpression.Results()) && isConstantExpression(caseExpression.ElseResult())
+	case ExpressionType.Function:
+		functionExpression := expression.(*FunctionExpression)
+
+		switch functionExpression.FunctionType() {
+		case ExpressionFunctionType.Now, ExpressionFunctionType.UtcNow, ExpressionFunctionType.UserDefined:
+			return false
+		default:
+			return allConstantExpressions(functionExpression.Arguments())
+		}
+	default:
+		return false
+	}
+}
+
+func allConstantExpressions(expressions []Expression) bool {
+	for _, expression := range expressions {
+		if !isConstantExpression(expression) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func evaluateConstantExpression(expression Expression) (*ValueExpression, error) {
+	if expression == nil {
+		return nil, errors.New("expression is nil")
+	}
+
+	expressionTree := NewExpressionTree()
+	expressionTree.Root = expression
+
+	return expressionTree.Evaluate(nil)
+}
+
+func isConstantBoolean(expression Expression, value bool) bool {
+	valueExpression := expression.(*ValueExpression)
+	return valueExpression.ValueType() == ExpressionValueType.Boolean && !valueExpression.IsNull() && valueExpression.booleanValue() == value
+}
+
+func isNullLiteral(expression Expression) bool {
+	valueExpression, ok := expression.(*ValueExpression)
+	return ok && valueExpression.IsNull()
+}
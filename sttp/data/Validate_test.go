@@ -0,0 +1,231 @@
+//******************************************************************************************************
+//  Validate_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func findDiagnostic(diagnostics []*Diagnostic, code DiagnosticCodeEnum) *Diagnostic {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Code == code {
+			return diagnostic
+		}
+	}
+
+	return nil
+}
+
+func TestValidateValidExpressions(t *testing.T) {
+	dataSet, _ := createIndexedDataSet()
+
+	expressions := []string{
+		"FILTER ActiveMeasurements WHERE SignalType = 'FREQ'",
+		"FILTER TOP 10 ActiveMeasurements WHERE Value BETWEEN 1 AND 5 ORDER BY PointTag DESC",
+		"FILTER ActiveMeasurements WHERE Contains(PointTag, 'TEST') AND Len(SignalType) > 3",
+		"FILTER ActiveMeasurements WHERE SubStr(PointTag, 4) LIKE 'TEST%' OR SignalType IN ('VPHM', 'VPHA')",
+		"FILTER ActiveMeasurements WHERE CASE WHEN ID > 100 THEN Value ELSE 0 END > 1.5",
+		"FILTER ActiveMeasurements WHERE SignalType IS NULL; FILTER ActiveMeasurements WHERE ID % 2 = 0",
+		"FILTER ActiveMeasurements WHERE Convert(ID, 'System.String') = '10'",
+		"FILTER ActiveMeasurements WHERE Value > @threshold",
+		"SELECT SignalType, COUNT(*) AS Total FROM ActiveMeasurements GROUP BY SignalType ORDER BY Total DESC",
+		"SELECT PointTag, Value * 2 AS Doubled FROM ActiveMeasurements WHERE SignalType = 'FREQ'",
+		"{00000000-0000-0000-0000-000000000000}",
+		"",
+	}
+
+	for _, expression := range expressions {
+		if diagnostics := Validate(expression, dataSet); len(diagnostics) > 0 {
+			t.Fatal("TestValidateValidExpressions: unexpected diagnostic for \"" + expression + "\": " + diagnostics[0].String())
+		}
+	}
+
+	if diagnostics := ValidateForTable("SignalType = 'FREQ' AND Value > 2", dataSet, "ActiveMeasurements"); len(diagnostics) > 0 {
+		t.Fatal("TestValidateValidExpressions: unexpected diagnostic for primary table expression: " + diagnostics[0].String())
+	}
+}
+
+func TestValidateDiagnostics(t *testing.T) {
+	dataSet, _ := createIndexedDataSet()
+
+	tests := []struct {
+		expression  string
+		severity    DiagnosticSeverityEnum
+		code        DiagnosticCodeEnum
+		line        int
+		column      int
+		length      int
+		suggestions []string
+	}{
+		{"FILTER ActiveMeasurement WHERE ID = 1", DiagnosticSeverity.Error, DiagnosticCode.UnknownTable, 1, 8, 17, []string{"ActiveMeasurements"}},
+		{"FILTER ActiveMeasurements WHERE SignalTyp = 'FREQ'", DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, 1, 33, 9, []string{"SignalType"}},
+		{"FILTER ActiveMeasurements\nWHERE ID > 1 AND\n  Signal = 'FREQ'", DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, 3, 3, 6, []string{"SignalID", "SignalType"}},
+		{"FILTER ActiveMeasurements WHERE ID > 1 ORDER BY PointTg", DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, 1, 49, 7, []string{"PointTag"}},
+		{"FILTER ActiveMeasurements WHERE Device.Acronym = 'A'", DiagnosticSeverity.Error, DiagnosticCode.UnknownRelation, 1, 33, 14, nil},
+		{"FILTER ActiveMeasurements WHERE Uper(PointTag) = 'A'", DiagnosticSeverity.Error, DiagnosticCode.UnknownFunction, 1, 33, 4, []string{"Upper"}},
+		{"FILTER ActiveMeasurements WHERE Len(PointTag, 1) > 1", DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, 1, 33, 16, nil},
+		{"FILTER ActiveMeasurements WHERE Replace(PointTag) = ''", DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, 1, 33, 17, nil},
+		{"SELECT PointTag, Value AS Total FROM ActiveMeasurements ORDER BY Totl", DiagnosticSeverity.Error, DiagnosticCode.UnknownColumn, 1, 66, 4, []string{"Total"}},
+		{"SELECT SUM(*) FROM ActiveMeasurements", DiagnosticSeverity.Error, DiagnosticCode.ArgumentCount, 1, 8, 6, nil},
+		{"FILTER ActiveMeasurements WHERE Len(ID) > 1", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 37, 2, nil},
+		{"FILTER ActiveMeasurements WHERE SignalID > 10", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 33, 13, nil},
+		{"FILTER ActiveMeasurements WHERE ID LIKE '1%'", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 33, 12, nil},
+		{"FILTER ActiveMeasurements WHERE PointTag", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 33, 8, nil},
+		{"FILTER ActiveMeasurements WHERE SubStr(PointTag, 1.5) = ''", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 50, 3, nil},
+		{"FILTER ActiveMeasurements WHERE Convert(ID, 'Money') = 1", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 45, 7, nil},
+		{"SELECT AVG(PointTag) FROM ActiveMeasurements", DiagnosticSeverity.Error, DiagnosticCode.TypeMismatch, 1, 8, 13, nil},
+		{"FILTER ActiveMeasurements WHERE ID > 1 OR 1 = 1", DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, 1, 43, 5, nil},
+		{"FILTER ActiveMeasurements WHERE ID > 1 AND False", DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, 1, 33, 16, nil},
+		{"FILTER ActiveMeasurements WHERE SignalType = NULL", DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, 1, 33, 17, nil},
+		{"FILTER ActiveMeasurements WHERE Value BETWEEN 10 AND 5", DiagnosticSeverity.Warning, DiagnosticCode.ConstantPredicate, 1, 33, 22, nil},
+		{"FILTER ActiveMeasurements WHERE ID = ", DiagnosticSeverity.Error, DiagnosticCode.Syntax, 1, 38, 0, nil},
+		{"FILTER ActiveMeasurements WHERE ID = 1 ORDER", DiagnosticSeverity.Error, DiagnosticCode.Syntax, 1, 45, 0, nil},
+	}
+
+	for _, test := range tests {
+		diagnostics := Validate(test.expression, dataSet)
+		diagnostic := findDiagnostic(diagnostics, test.code)
+
+		if diagnostic == nil {
+			t.Fatalf("TestValidateDiagnostics: expected %s diagnostic for \"%s\", received %v", test.code, test.expression, diagnostics)
+		}
+
+		if diagnostic.Severity != test.severity {
+			t.Fatalf("TestValidateDiagnostics: expected %s severity for \"%s\", received %s", test.severity, test.expression, diagnostic)
+		}
+
+		if diagnostic.Line != test.line || diagnostic.Column != test.column || diagnostic.Length != test.length {
+			t.Fatalf("TestValidateDiagnostics: unexpected position for \"%s\": expected %d:%d length %d, received %d:%d length %d", test.expression, test.line, test.column, test.length, diagnostic.Line, diagnostic.Column, diagnostic.Length)
+		}
+
+		if diagnostic.Offset+diagnostic.Length > len([]rune(test.expression)) {
+			t.Fatal("TestValidateDiagnostics: diagnostic text exceeds expression for \"" + test.expression + "\"")
+		}
+
+		if strings.Join(diagnostic.Suggestions, ",") != strings.Join(test.suggestions, ",") {
+			t.Fatalf("TestValidateDiagnostics: unexpected suggestions for \"%s\": %v", test.expression, diagnostic.Suggestions)
+		}
+
+		if test.severity == DiagnosticSeverity.Warning && HasErrors(diagnostics) {
+			t.Fatalf("TestValidateDiagnostics: unexpected error for \"%s\": %v", test.expression, diagnostics)
+		}
+	}
+}
+
+func TestValidateMultipleDiagnostics(t *testing.T) {
+	dataSet, _ := createIndexedDataSet()
+
+	diagnostics := Validate("FILTER ActiveMeasurements WHERE PointTg = 'A' OR SignalTyp = 'B' OR Foo(ID) = 1", dataSet)
+
+	if len(diagnostics) != 3 {
+		t.Fatalf("TestValidateMultipleDiagnostics: expected 3 diagnostics, received %v", diagnostics)
+	}
+
+	expected := []DiagnosticCodeEnum{DiagnosticCode.UnknownColumn, DiagnosticCode.UnknownColumn, DiagnosticCode.UnknownFunction}
+
+	for i, diagnostic := range diagnostics {
+		if diagnostic.Code != expected[i] {
+			t.Fatalf("TestValidateMultipleDiagnostics: expected %s for diagnostic %d, received %s", expected[i], i, diagnostic)
+		}
+	}
+
+	if diagnostics[0].String() != "1:33: Error: unknown column \"PointTg\" in table \"ActiveMeasurements\", did you mean \"PointTag\"?" {
+		t.Fatal("TestValidateMultipleDiagnostics: unexpected diagnostic text: " + diagnostics[0].String())
+	}
+
+	// Each statement resolves its own table
+	diagnostics = Validate("FILTER Missing WHERE A = 1; FILTER ActiveMeasurements WHERE B = 1", dataSet)
+
+	if len(diagnostics) != 2 || diagnostics[0].Code != DiagnosticCode.UnknownTable || diagnostics[1].Code != DiagnosticCode.UnknownColumn {
+		t.Fatalf("TestValidateMultipleDiagnostics: unexpected statement diagnostics %v", diagnostics)
+	}
+
+	// Expressions without a table require a primary table
+	diagnostics = Validate("SignalType = 'FREQ'", dataSet)
+
+	if len(diagnostics) != 1 || diagnostics[0].Code != DiagnosticCode.UnknownTable {
+		t.Fatalf("TestValidateMultipleDiagnostics: unexpected primary table diagnostics %v", diagnostics)
+	}
+}
+
+func TestValidateRelationsAndUserFunctions(t *testing.T) {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("TestValidateRelationsAndUserFunctions: error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("TestValidateRelationsAndUserFunctions: error loading DataSet from XML document: " + err.Error())
+	}
+
+	if diagnostics := Validate("FILTER MeasurementDetail WHERE Device.CompanyAcronym = 'GPA'", dataSet); len(diagnostics) > 0 {
+		t.Fatal("TestValidateRelationsAndUserFunctions: unexpected diagnostic: " + diagnostics[0].String())
+	}
+
+	if diagnostics := Validate("SELECT Device.Name, COUNT(*) AS Total FROM PhasorDetail GROUP BY DeviceAcronym ORDER BY Name", dataSet); len(diagnostics) > 0 {
+		t.Fatal("TestValidateRelationsAndUserFunctions: unexpected select diagnostic: " + diagnostics[0].String())
+	}
+
+	diagnostics := Validate("FILTER MeasurementDetail WHERE Devices.CompanyAcronym = 'GPA'", dataSet)
+
+	if len(diagnostics) != 1 || diagnostics[0].Code != DiagnosticCode.UnknownRelation || strings.Join(diagnostics[0].Suggestions, ",") != "Device" {
+		t.Fatalf("TestValidateRelationsAndUserFunctions: unexpected relation diagnostics %v", diagnostics)
+	}
+
+	diagnostics = Validate("FILTER MeasurementDetail WHERE Device.CompanyAcronm = 'GPA'", dataSet)
+
+	if len(diagnostics) != 1 || diagnostics[0].Code != DiagnosticCode.UnknownColumn || strings.Join(diagnostics[0].Suggestions, ",") != "CompanyAcronym" {
+		t.Fatalf("TestValidateRelationsAndUserFunctions: unexpected related column diagnostics %v", diagnostics)
+	}
+
+	if err := RegisterFunction("ValidatePhase", []DataTypeEnum{DataType.String}, DataType.String, func(arguments []interface{}) (interface{}, error) {
+		return arguments[0], nil
+	}); err != nil {
+		t.Fatal("TestValidateRelationsAndUserFunctions: failed to register function: " + err.Error())
+	}
+
+	defer UnregisterFunction("ValidatePhase")
+
+	if diagnostics = Validate("FILTER MeasurementDetail WHERE ValidatePhase(SignalReference) = 'A'", dataSet); len(diagnostics) > 0 {
+		t.Fatal("TestValidateRelationsAndUserFunctions: unexpected user function diagnostic: " + diagnostics[0].String())
+	}
+
+	diagnostics = Validate("FILTER MeasurementDetail WHERE ValidatePhase(SignalReference, 1) = 'A'", dataSet)
+
+	if len(diagnostics) != 1 || diagnostics[0].Code != DiagnosticCode.ArgumentCount {
+		t.Fatalf("TestValidateRelationsAndUserFunctions: unexpected user function argument diagnostics %v", diagnostics)
+	}
+
+	diagnostics = Validate("FILTER MeasurementDetail WHERE ValidatePhas(SignalReference) = 'A'", dataSet)
+
+	if len(diagnostics) != 1 || strings.Join(diagnostics[0].Suggestions, ",") != "ValidatePhase" {
+		t.Fatalf("TestValidateRelationsAndUserFunctions: unexpected user function name diagnostics %v", diagnostics)
+	}
+}
@@ -26,7 +26,9 @@ package data
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/araddon/dateparse"
@@ -120,7 +122,8 @@ func (ve *ValueExpression) ValueType() ExpressionValueTypeEnum {
 	return ve.valueType
 }
 
-// String gets the ValueExpression value as a string.
+// String gets the ValueExpression value as a string. Use Literal to get the value formatted as
+// filter expression syntax.
 func (ve *ValueExpression) String() string {
 	switch ve.valueType {
 	case ExpressionValueType.Boolean:
@@ -144,6 +147,52 @@ func (ve *ValueExpression) String() string {
 	}
 }
 
+// Literal gets the ValueExpression value formatted as a filter expression literal that can be parsed
+// back into an equivalent value, e.g., string values are quoted and date/time values are enclosed in
+// '#' symbols. Decimal and Double literals are formatted such that their value type is preserved.
+func (ve *ValueExpression) Literal() string {
+	if ve.IsNull() {
+		return "NULL"
+	}
+
+	switch ve.valueType {
+	case ExpressionValueType.Boolean:
+		if ve.booleanValue() {
+			return "TRUE"
+		}
+
+		return "FALSE"
+	case ExpressionValueType.Int32, ExpressionValueType.Int64:
+		return ve.String()
+	case ExpressionValueType.Decimal:
+		literal := ve.decimalValue().String()
+
+		// Numeric literals without a decimal point are parsed as integers
+		if !strings.Contains(literal, ".") {
+			literal += ".0"
+		}
+
+		return literal
+	case ExpressionValueType.Double:
+		value := ve.doubleValue()
+
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return "Convert('" + strconv.FormatFloat(value, 'g', -1, 64) + "', 'System.Double')"
+		}
+
+		// Numeric literals using scientific notation are parsed as Double
+		return strconv.FormatFloat(value, 'E', -1, 64)
+	case ExpressionValueType.String:
+		return "'" + strings.ReplaceAll(ve.stringValue(), "'", "''") + "'"
+	case ExpressionValueType.Guid:
+		return ve.guidValue().String()
+	case ExpressionValueType.DateTime:
+		return "#" + ve.dateTimeValue().Format(time.RFC3339Nano) + "#"
+	default:
+		return "NULL"
+	}
+}
+
 // IsNull gets a flag that determines if the ValueExpression value is null.
 func (ve *ValueExpression) IsNull() bool {
 	return ve.value == nil
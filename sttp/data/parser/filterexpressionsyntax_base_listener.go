@@ -181,3 +181,27 @@ func (s *BaseFilterExpressionSyntaxListener) EnterOrderByColumnName(ctx *OrderBy
 
 // ExitOrderByColumnName is called when production orderByColumnName is exited.
 func (s *BaseFilterExpressionSyntaxListener) ExitOrderByColumnName(ctx *OrderByColumnNameContext) {}
+
+// EnterSelectStatement is called when production selectStatement is entered.
+func (s *BaseFilterExpressionSyntaxListener) EnterSelectStatement(ctx *SelectStatementContext) {}
+
+// ExitSelectStatement is called when production selectStatement is exited.
+func (s *BaseFilterExpressionSyntaxListener) ExitSelectStatement(ctx *SelectStatementContext) {}
+
+// EnterSelectTerm is called when production selectTerm is entered.
+func (s *BaseFilterExpressionSyntaxListener) EnterSelectTerm(ctx *SelectTermContext) {}
+
+// ExitSelectTerm is called when production selectTerm is exited.
+func (s *BaseFilterExpressionSyntaxListener) ExitSelectTerm(ctx *SelectTermContext) {}
+
+// EnterColumnAlias is called when production columnAlias is entered.
+func (s *BaseFilterExpressionSyntaxListener) EnterColumnAlias(ctx *ColumnAliasContext) {}
+
+// ExitColumnAlias is called when production columnAlias is exited.
+func (s *BaseFilterExpressionSyntaxListener) ExitColumnAlias(ctx *ColumnAliasContext) {}
+
+// EnterCaseExpression is called when production caseExpression is entered.
+func (s *BaseFilterExpressionSyntaxListener) EnterCaseExpression(ctx *CaseExpressionContext) {}
+
+// ExitCaseExpression is called when production caseExpression is exited.
+func (s *BaseFilterExpressionSyntaxListener) ExitCaseExpression(ctx *CaseExpressionContext) {}
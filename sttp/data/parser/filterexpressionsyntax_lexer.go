@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 100, 992,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 113, 1094,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -115,8 +115,22 @@ var serializedLexerAtn = []uint16{
 	112, 3, 112, 3, 113, 3, 113, 3, 114, 3, 114, 3, 115, 3, 115, 3, 116, 3,
 	116, 3, 117, 3, 117, 3, 118, 3, 118, 3, 119, 3, 119, 3, 120, 3, 120, 3,
 	121, 3, 121, 3, 122, 3, 122, 3, 123, 3, 123, 3, 124, 3, 124, 3, 125, 3,
-	125, 3, 126, 3, 126, 3, 127, 3, 127, 3, 128, 3, 128, 3, 129, 3, 129, 3,
-	872, 2, 130, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11,
+	125, 3, 126, 3, 126, 3, 127, 3, 127, 3, 128, 3, 128, 3, 129, 3, 129, 4,
+	130, 9, 130, 4, 131, 9, 131, 4, 132, 9, 132, 4, 133, 9, 133, 3, 130, 3,
+	130, 3, 130, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 132, 3, 132, 3,
+	132, 3, 132, 3, 132, 3, 132, 3, 133, 3, 133, 3, 133, 3, 133, 3, 133, 3,
+	133, 3, 133, 4, 134, 9, 134, 3, 134, 3, 134, 3, 134, 3, 134, 3, 134, 7,
+	134, 1029, 10, 134, 12, 134, 14, 134, 1032, 11, 134, 3, 134, 4, 135, 9,
+	135, 3, 135, 3, 135, 3, 135, 7, 135, 1040, 10, 135, 12, 135, 14, 135,
+	1043, 11, 135, 3, 135, 4, 136, 9, 136, 4, 137, 9, 137, 4, 138, 9, 138,
+	4, 139, 9, 139, 4, 140, 9, 140, 4, 141, 9, 141, 3, 136, 3, 136, 3, 136,
+	3, 136, 3, 136, 3, 136, 3, 136, 3, 136, 3, 137, 3, 137, 3, 137, 3, 137,
+	3, 137, 3, 138, 3, 138, 3, 138, 3, 138, 3, 138, 3, 139, 3, 139, 3, 139,
+	3, 139, 3, 140, 3, 140, 3, 140, 3, 140, 3, 140, 3, 141, 3, 141, 3, 141,
+	3, 141, 3, 141, 4, 142, 9, 142, 3, 142, 3, 142, 3, 142, 3, 872, 2, 143,
+	3, 3, 5, 4, 7, 5, 9, 6, 11, 7,
+	13, 8, 15,
+	9, 17, 10, 19, 11,
 	21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20,
 	39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29,
 	57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38,
@@ -130,7 +144,10 @@ var serializedLexerAtn = []uint16{
 	96, 191, 97, 193, 98, 195, 99, 197, 100, 199, 2, 201, 2, 203, 2, 205, 2,
 	207, 2, 209, 2, 211, 2, 213, 2, 215, 2, 217, 2, 219, 2, 221, 2, 223, 2,
 	225, 2, 227, 2, 229, 2, 231, 2, 233, 2, 235, 2, 237, 2, 239, 2, 241, 2,
-	243, 2, 245, 2, 247, 2, 249, 2, 251, 2, 253, 2, 255, 2, 257, 2, 3, 2, 40,
+	243, 2, 245, 2, 247, 2, 249, 2, 251, 2, 253, 2, 255, 2, 257, 2, 992,
+	101, 994, 102, 996, 103, 998, 104, 1021, 105, 1034, 106, 1045, 107,
+	1047, 108, 1049, 109, 1051, 110, 1053, 111, 1055, 112, 1089, 113, 3, 2,
+	42,
 	3, 2, 98, 98, 3, 2, 95, 95, 5, 2, 67, 92, 97, 97, 99, 124, 6, 2, 50, 59,
 	67, 92, 97, 97, 99, 124, 4, 2, 45, 45, 47, 47, 3, 2, 41, 41, 3, 2, 37,
 	37, 4, 2, 12, 12, 15, 15, 5, 2, 11, 13, 15, 15, 34, 34, 3, 2, 50, 59, 5,
@@ -143,7 +160,9 @@ var serializedLexerAtn = []uint16{
 	81, 113, 113, 4, 2, 82, 82, 114, 114, 4, 2, 83, 83, 115, 115, 4, 2, 84,
 	84, 116, 116, 4, 2, 85, 85, 117, 117, 4, 2, 86, 86, 118, 118, 4, 2, 87,
 	87, 119, 119, 4, 2, 88, 88, 120, 120, 4, 2, 89, 89, 121, 121, 4, 2, 90,
-	90, 122, 122, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124, 2, 996, 2,
+	90, 122, 122, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124, 6, 2, 50,
+	59, 67, 92, 97, 97, 99, 124, 5, 2, 67, 92, 97, 97, 99, 124, 2, 1100,
+	2,
 	3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2,
 	11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2,
 	2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2,
@@ -167,7 +186,14 @@ var serializedLexerAtn = []uint16{
 	3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2,
 	2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3,
 	2, 2, 2, 2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2,
-	175, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2,
+	992, 3, 2, 2, 2, 2, 994, 3, 2, 2, 2, 2, 996, 3, 2, 2, 2, 2, 998, 3, 2,
+	2, 2, 2, 1045, 3, 2, 2, 2, 2, 1047, 3, 2, 2, 2, 2, 1049, 3, 2, 2, 2, 2,
+	1051, 3, 2, 2, 2, 2, 1053, 3, 2, 2, 2, 2, 1055, 3, 2, 2, 2, 2, 1089, 3,
+	2,
+	2, 2, 2,
+	175, 3, 2, 2, 2, 2, 1021, 3, 2, 2, 2, 2, 1034, 3, 2, 2, 2, 2, 177, 3, 2,
+	2, 2, 2, 179, 3, 2,
+	2, 2, 2, 181, 3, 2,
 	2, 2, 2, 183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189,
 	3, 2, 2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2,
 	2, 197, 3, 2, 2, 2, 3, 259, 3, 2, 2, 2, 5, 261, 3, 2, 2, 2, 7, 263, 3,
@@ -462,9 +488,44 @@ var serializedLexerAtn = []uint16{
 	2, 2, 2, 982, 983, 9, 35, 2, 2, 983, 250, 3, 2, 2, 2, 984, 985, 9, 36,
 	2, 2, 985, 252, 3, 2, 2, 2, 986, 987, 9, 37, 2, 2, 987, 254, 3, 2, 2, 2,
 	988, 989, 9, 38, 2, 2, 989, 256, 3, 2, 2, 2, 990, 991, 9, 39, 2, 2, 991,
-	258, 3, 2, 2, 2, 37, 2, 723, 729, 736, 743, 746, 751, 758, 760, 765, 771,
+	258, 3, 2, 2, 2, 992, 1000, 3, 2, 2, 2, 994, 1003, 3, 2, 2, 2, 996,
+	1008, 3, 2, 2, 2, 998, 1014, 3, 2, 2, 2, 1000, 1001, 5, 207, 104, 2,
+	1001, 1002, 5, 243, 122, 2, 1002, 993, 3, 2, 2, 2, 1003, 1004, 5, 217,
+	109, 2, 1004, 1005, 5, 241, 121, 2, 1005, 1006, 5, 235, 118, 2, 1006,
+	1007, 5, 231, 116, 2, 1007, 995, 3, 2, 2, 2, 1008, 1009, 5, 219, 110, 2,
+	1009, 1010, 5, 241, 121, 2, 1010, 1011, 5, 235, 118, 2, 1011, 1012, 5,
+	247, 124, 2, 1012, 1013, 5, 237, 119, 2, 1013, 997, 3, 2, 2, 2, 1014,
+	1015, 5, 243, 122, 2, 1015, 1016, 5, 215, 108, 2, 1016, 1017, 5, 229,
+	115, 2, 1017, 1018, 5, 215, 108, 2, 1018, 1019, 5, 211, 106, 2, 1019,
+	1020, 5, 245, 123, 2, 1020, 999, 3, 2, 2, 2, 1021, 1023, 3, 2, 2, 2,
+	1023, 1024, 5, 175, 88, 2, 1024, 1025, 7, 48, 2, 2, 1025, 1030, 5, 175,
+	88, 2, 1026, 1027, 7, 48, 2, 2, 1027, 1029, 5, 175, 88, 2, 1028, 1026,
+	3, 2, 2, 2, 1029, 1032, 3, 2, 2, 2, 1030, 1028, 3, 2, 2, 2, 1030, 1031,
+	3, 2, 2, 2, 1031, 1033, 3, 2, 2, 2, 1032, 1030, 3, 2, 2, 2, 1033, 1022,
+	3, 2, 2, 2, 1034, 1036, 3, 2, 2, 2, 1036, 1037, 7, 66, 2, 2, 1037, 1041,
+	9, 41, 2, 2, 1038, 1040, 9, 40, 2, 2, 1039, 1038, 3, 2, 2, 2, 1040,
+	1043, 3, 2, 2, 2, 1041, 1039, 3, 2, 2, 2, 1041, 1042, 3, 2, 2, 2, 1042,
+	1044, 3, 2, 2, 2, 1043, 1041, 3, 2, 2, 2, 1044, 1035, 3, 2, 2, 2, 1045,
+	1057, 3, 2, 2, 2, 1047, 1065, 3, 2, 2, 2, 1049, 1070, 3, 2, 2, 2, 1051,
+	1075, 3, 2, 2, 2, 1053, 1079, 3, 2, 2, 2, 1055, 1084, 3, 2, 2, 2, 1057,
+	1058, 5, 209, 105, 2, 1058, 1059, 5, 215, 108, 2, 1059, 1060, 5, 245,
+	123, 2, 1060, 1061, 5, 251, 126, 2, 1061, 1062, 5, 215, 108, 2, 1062,
+	1063, 5, 215, 108, 2, 1063, 1064, 5, 233, 117, 2, 1064, 1046, 3, 2, 2,
+	2, 1065, 1066, 5, 211, 106, 2, 1066, 1067, 5, 207, 104, 2, 1067, 1068,
+	5, 243, 122, 2, 1068, 1069, 5, 215, 108, 2, 1069, 1048, 3, 2, 2, 2,
+	1070, 1071, 5, 215, 108, 2, 1071, 1072, 5, 229, 115, 2, 1072, 1073, 5,
+	243, 122, 2, 1073, 1074, 5, 215, 108, 2, 1074, 1050, 3, 2, 2, 2, 1075,
+	1076, 5, 215, 108, 2, 1076, 1077, 5, 233, 117, 2, 1077, 1078, 5, 213,
+	107, 2, 1078, 1052, 3, 2, 2, 2, 1079, 1080, 5, 245, 123, 2, 1080, 1081,
+	5, 221, 111, 2, 1081, 1082, 5, 215, 108, 2, 1082, 1083, 5, 233, 117, 2,
+	1083, 1054, 3, 2, 2, 2, 1084, 1085, 5, 251, 126, 2, 1085, 1086, 5, 221,
+	111, 2, 1086, 1087, 5, 215, 108, 2, 1087, 1088, 5, 233, 117, 2, 1088,
+	1056, 3, 2, 2, 2, 1089, 1091, 3, 2, 2, 2, 1091, 1092, 7, 48, 2, 2, 1092,
+	1093, 7, 48, 2, 2, 1093, 1090, 3, 2, 2, 2, 39, 2,
+	723, 729, 736, 743,
+	746, 751, 758, 760, 765, 771,
 	774, 778, 783, 785, 791, 795, 800, 802, 804, 815, 820, 826, 832, 840, 842,
-	851, 861, 872, 878, 893, 904, 911, 918, 925, 3, 2, 3, 2,
+	851, 861, 872, 878, 893, 904, 911, 918, 925, 1030, 1041, 3, 2, 3, 2,
 }
 
 var lexerChannelNames = []string{
@@ -478,7 +539,12 @@ var lexerModeNames = []string{
 var lexerLiteralNames = []string{
 	"", "';'", "','", "'-'", "'+'", "'('", "')'", "'!'", "'~'", "'==='", "'<'",
 	"'<='", "'>'", "'>='", "'='", "'=='", "'!='", "'!=='", "'<>'", "'&&'",
-	"'||'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "'*'", "'/'", "'%'",
+	"'||'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "'*'", "'/'", "'%'", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "'..'",
 }
 
 var lexerSymbolicNames = []string{
@@ -495,7 +561,9 @@ var lexerSymbolicNames = []string{
 	"K_UPPER", "K_UTCNOW", "K_WHERE", "K_XOR", "BOOLEAN_LITERAL", "IDENTIFIER",
 	"INTEGER_LITERAL", "NUMERIC_LITERAL", "GUID_LITERAL", "MEASUREMENT_KEY_LITERAL",
 	"POINT_TAG_LITERAL", "STRING_LITERAL", "DATETIME_LITERAL", "SINGLE_LINE_COMMENT",
-	"MULTILINE_COMMENT", "SPACES", "UNEXPECTED_CHAR",
+	"MULTILINE_COMMENT", "SPACES", "UNEXPECTED_CHAR", "K_AS", "K_FROM",
+	"K_GROUP", "K_SELECT", "COLUMN_PATH", "PARAMETER", "K_BETWEEN", "K_CASE",
+	"K_ELSE", "K_END", "K_THEN", "K_WHEN", "CONCAT_OPERATOR",
 }
 
 var lexerRuleNames = []string{
@@ -516,7 +584,9 @@ var lexerRuleNames = []string{
 	"STRING_LITERAL", "DATETIME_LITERAL", "SINGLE_LINE_COMMENT", "MULTILINE_COMMENT",
 	"SPACES", "UNEXPECTED_CHAR", "DIGIT", "HEX_DIGIT", "ACRONYM_DIGIT", "GUID_VALUE",
 	"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O",
-	"P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
+	"P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z", "K_AS", "K_FROM",
+	"K_GROUP", "K_SELECT", "COLUMN_PATH", "PARAMETER", "K_BETWEEN", "K_CASE",
+	"K_ELSE", "K_END", "K_THEN", "K_WHEN", "CONCAT_OPERATOR",
 }
 
 type FilterExpressionSyntaxLexer struct {
@@ -654,4 +724,17 @@ const (
 	FilterExpressionSyntaxLexerMULTILINE_COMMENT       = 96
 	FilterExpressionSyntaxLexerSPACES                  = 97
 	FilterExpressionSyntaxLexerUNEXPECTED_CHAR         = 98
+	FilterExpressionSyntaxLexerK_AS                    = 99
+	FilterExpressionSyntaxLexerK_FROM                  = 100
+	FilterExpressionSyntaxLexerK_GROUP                 = 101
+	FilterExpressionSyntaxLexerK_SELECT                = 102
+	FilterExpressionSyntaxLexerCOLUMN_PATH             = 103
+	FilterExpressionSyntaxLexerPARAMETER               = 104
+	FilterExpressionSyntaxLexerK_BETWEEN               = 105
+	FilterExpressionSyntaxLexerK_CASE                  = 106
+	FilterExpressionSyntaxLexerK_ELSE                  = 107
+	FilterExpressionSyntaxLexerK_END                   = 108
+	FilterExpressionSyntaxLexerK_THEN                  = 109
+	FilterExpressionSyntaxLexerK_WHEN                  = 110
+	FilterExpressionSyntaxLexerCONCAT_OPERATOR         = 111
 )
@@ -83,6 +83,18 @@ type FilterExpressionSyntaxListener interface {
 	// EnterOrderByColumnName is called when entering the orderByColumnName production.
 	EnterOrderByColumnName(c *OrderByColumnNameContext)
 
+	// EnterSelectStatement is called when entering the selectStatement production.
+	EnterSelectStatement(c *SelectStatementContext)
+
+	// EnterSelectTerm is called when entering the selectTerm production.
+	EnterSelectTerm(c *SelectTermContext)
+
+	// EnterColumnAlias is called when entering the columnAlias production.
+	EnterColumnAlias(c *ColumnAliasContext)
+
+	// EnterCaseExpression is called when entering the caseExpression production.
+	EnterCaseExpression(c *CaseExpressionContext)
+
 	// ExitParse is called when exiting the parse production.
 	ExitParse(c *ParseContext)
 
@@ -157,4 +169,16 @@ type FilterExpressionSyntaxListener interface {
 
 	// ExitOrderByColumnName is called when exiting the orderByColumnName production.
 	ExitOrderByColumnName(c *OrderByColumnNameContext)
+
+	// ExitSelectStatement is called when exiting the selectStatement production.
+	ExitSelectStatement(c *SelectStatementContext)
+
+	// ExitSelectTerm is called when exiting the selectTerm production.
+	ExitSelectTerm(c *SelectTermContext)
+
+	// ExitColumnAlias is called when exiting the columnAlias production.
+	ExitColumnAlias(c *ColumnAliasContext)
+
+	// ExitCaseExpression is called when exiting the caseExpression production.
+	ExitCaseExpression(c *CaseExpressionContext)
 }
@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 100, 245,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 113, 334,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -42,13 +42,29 @@ var parserATN = []uint16{
 	3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3,
 	19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 5, 22, 233, 10, 22,
 	3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3,
-	26, 2, 5, 20, 22, 24, 27, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26,
-	28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 2, 14, 3, 2, 92, 94, 3,
+	26, 4, 27, 9, 27, 4, 28, 9, 28, 4, 29, 9, 29, 3, 27, 3, 27, 3, 27, 5,
+	27, 255, 10, 27, 3, 27, 3, 27, 3, 27, 7, 27, 260, 10, 27, 12, 27, 14,
+	27, 263, 11, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 269, 10, 27, 3, 27,
+	3, 27, 3, 27, 3, 27, 3, 27, 7, 27, 276, 10, 27, 12, 27, 14, 27, 279, 11,
+	27, 5, 27, 281, 10, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 7, 27, 288,
+	10, 27, 12, 27, 14, 27, 291, 11, 27, 5, 27, 293, 10, 27, 3, 27, 3, 28,
+	3, 28, 3, 28, 5, 28, 299, 10, 28, 3, 28, 3, 29, 3, 29, 3, 5, 3, 22, 4,
+	30, 9, 30, 3, 30, 3, 30, 5, 30, 310, 10, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	6, 30, 316, 10, 30, 13, 30, 14, 30, 317, 3, 30, 3, 30, 5, 30, 322, 10,
+	30, 3, 30, 3, 30, 3, 12, 3, 12, 3, 12, 3, 12, 10, 12, 5, 12, 329, 3, 12,
+	3, 12, 3, 13, 2, 5, 20, 22, 24, 31, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20,
+	22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 245, 247,
+	249, 305, 2, 17, 3, 2,
+	92, 94, 3,
 	2, 5, 6, 4, 2, 33, 33, 43, 43, 4, 2, 9, 9, 62, 62, 5, 2, 5, 6, 9, 10, 62,
 	62, 4, 2, 11, 11, 34, 34, 3, 2, 11, 20, 5, 2, 21, 22, 32, 32, 66, 66, 4,
-	2, 23, 27, 87, 87, 4, 2, 5, 6, 28, 30, 12, 2, 31, 31, 36, 42, 44, 44, 46,
-	47, 49, 49, 51, 57, 59, 61, 63, 64, 68, 79, 81, 85, 6, 2, 65, 65, 88, 88,
-	90, 92, 95, 96, 2, 251, 2, 54, 3, 2, 2, 2, 4, 58, 3, 2, 2, 2, 6, 64, 3,
+	2, 23, 27, 87, 87, 4, 2, 5, 6, 28, 30, 13, 2, 31, 31, 36, 42, 44, 44,
+	46, 47, 49, 49, 51, 57, 59, 61, 63, 64, 68, 79, 81, 85, 89, 89, 6, 2,
+	65, 65, 88, 88,
+	90, 92, 95, 96, 4, 2, 89, 89, 105, 105, 7, 2, 65, 65, 88, 88, 90, 92,
+	95, 96, 106, 106, 5, 2, 5, 6, 28, 30, 113, 113, 2, 352, 2, 54, 3, 2, 2,
+	2, 4,
+	58, 3, 2, 2, 2, 6, 64, 3,
 	2, 2, 2, 8, 88, 3, 2, 2, 2, 10, 90, 3, 2, 2, 2, 12, 92, 3, 2, 2, 2, 14,
 	113, 3, 2, 2, 2, 16, 118, 3, 2, 2, 2, 18, 124, 3, 2, 2, 2, 20, 137, 3,
 	2, 2, 2, 22, 148, 3, 2, 2, 2, 24, 198, 3, 2, 2, 2, 26, 213, 3, 2, 2, 2,
@@ -67,7 +83,8 @@ var parserATN = []uint16{
 	2, 79, 81, 7, 3, 2, 2, 80, 79, 3, 2, 2, 2, 81, 84, 3, 2, 2, 2, 82, 80,
 	3, 2, 2, 2, 82, 83, 3, 2, 2, 2, 83, 7, 3, 2, 2, 2, 84, 82, 3, 2, 2, 2,
 	85, 89, 5, 10, 6, 2, 86, 89, 5, 12, 7, 2, 87, 89, 5, 20, 11, 2, 88, 85,
-	3, 2, 2, 2, 88, 86, 3, 2, 2, 2, 88, 87, 3, 2, 2, 2, 89, 9, 3, 2, 2, 2,
+	3, 2, 2, 2, 88, 86, 3, 2, 2, 2, 88, 303, 3, 2, 2, 2, 88, 87, 3, 2, 2, 2,
+	89, 9, 3, 2, 2, 2,
 	90, 91, 9, 2, 2, 2, 91, 11, 3, 2, 2, 2, 92, 95, 7, 45, 2, 2, 93, 94, 7,
 	80, 2, 2, 94, 96, 5, 14, 8, 2, 95, 93, 3, 2, 2, 2, 95, 96, 3, 2, 2, 2,
 	96, 97, 3, 2, 2, 2, 97, 98, 5, 46, 24, 2, 98, 99, 7, 86, 2, 2, 99, 110,
@@ -101,13 +118,15 @@ var parserATN = []uint16{
 	2, 174, 175, 7, 8, 2, 2, 175, 183, 3, 2, 2, 2, 176, 177, 12, 6, 2, 2, 177,
 	179, 7, 50, 2, 2, 178, 180, 5, 26, 14, 2, 179, 178, 3, 2, 2, 2, 179, 180,
 	3, 2, 2, 2, 180, 181, 3, 2, 2, 2, 181, 183, 7, 65, 2, 2, 182, 151, 3, 2,
-	2, 2, 182, 155, 3, 2, 2, 2, 182, 164, 3, 2, 2, 2, 182, 176, 3, 2, 2, 2,
+	2, 2, 182, 155, 3, 2, 2, 2, 182, 332, 3, 2, 2, 2, 182, 164, 3, 2, 2, 2,
+	182, 176, 3, 2, 2, 2,
 	183, 186, 3, 2, 2, 2, 184, 182, 3, 2, 2, 2, 184, 185, 3, 2, 2, 2, 185,
 	23, 3, 2, 2, 2, 186, 184, 3, 2, 2, 2, 187, 188, 8, 13, 1, 2, 188, 199,
 	5, 44, 23, 2, 189, 199, 5, 48, 25, 2, 190, 199, 5, 42, 22, 2, 191, 192,
 	5, 28, 15, 2, 192, 193, 5, 24, 13, 6, 193, 199, 3, 2, 2, 2, 194, 195, 7,
 	7, 2, 2, 195, 196, 5, 20, 11, 2, 196, 197, 7, 8, 2, 2, 197, 199, 3, 2,
 	2, 2, 198, 187, 3, 2, 2, 2, 198, 189, 3, 2, 2, 2, 198, 190, 3, 2, 2, 2,
+	198, 333, 3, 2, 2, 2,
 	198, 191, 3, 2, 2, 2, 198, 194, 3, 2, 2, 2, 199, 210, 3, 2, 2, 2, 200,
 	201, 12, 4, 2, 2, 201, 202, 5, 38, 20, 2, 202, 203, 5, 24, 13, 5, 203,
 	209, 3, 2, 2, 2, 204, 205, 12, 3, 2, 2, 205, 206, 5, 36, 19, 2, 206, 207,
@@ -117,20 +136,57 @@ var parserATN = []uint16{
 	27, 3, 2, 2, 2, 215, 216, 9, 6, 2, 2, 216, 29, 3, 2, 2, 2, 217, 218, 9,
 	7, 2, 2, 218, 31, 3, 2, 2, 2, 219, 220, 9, 8, 2, 2, 220, 33, 3, 2, 2, 2,
 	221, 222, 9, 9, 2, 2, 222, 35, 3, 2, 2, 2, 223, 224, 9, 10, 2, 2, 224,
-	37, 3, 2, 2, 2, 225, 226, 9, 11, 2, 2, 226, 39, 3, 2, 2, 2, 227, 228, 9,
+	37, 3, 2, 2, 2, 225, 226, 9, 16, 2, 2, 226, 39, 3, 2, 2, 2, 227, 228, 9,
 	12, 2, 2, 228, 41, 3, 2, 2, 2, 229, 230, 5, 40, 21, 2, 230, 232, 7, 7,
-	2, 2, 231, 233, 5, 18, 10, 2, 232, 231, 3, 2, 2, 2, 232, 233, 3, 2, 2,
+	2, 2, 231, 233, 5, 18, 10, 2, 232, 231, 3, 2, 2, 2, 232, 304, 3, 2, 2,
+	2, 232, 233, 3, 2, 2,
 	2, 233, 234, 3, 2, 2, 2, 234, 235, 7, 8, 2, 2, 235, 43, 3, 2, 2, 2, 236,
-	237, 9, 13, 2, 2, 237, 45, 3, 2, 2, 2, 238, 239, 7, 89, 2, 2, 239, 47,
-	3, 2, 2, 2, 240, 241, 7, 89, 2, 2, 241, 49, 3, 2, 2, 2, 242, 243, 7, 89,
-	2, 2, 243, 51, 3, 2, 2, 2, 28, 54, 64, 71, 76, 82, 88, 95, 107, 110, 113,
+	237, 9, 15, 2, 2, 237, 45, 3, 2, 2, 2, 238, 239, 7, 89, 2, 2, 239, 47,
+	3, 2, 2, 2, 240, 241, 9, 14, 2, 2, 241, 49, 3, 2, 2, 2, 242, 243, 7, 89,
+	2, 2, 243, 51, 3, 2, 2, 2, 245, 251, 3, 2, 2, 2, 247, 295, 3, 2, 2, 2,
+	249, 301, 3, 2, 2, 2, 251, 254, 7, 104, 2, 2, 252, 253, 7, 80, 2, 2,
+	253, 255, 5, 14, 8, 2, 254, 252, 3, 2, 2, 2, 254, 255, 3, 2, 2, 2, 255,
+	256, 3, 2, 2, 2, 256, 261, 5, 247, 28, 2, 257, 258, 7, 4, 2, 2, 258,
+	260, 5, 247, 28, 2, 259, 257, 3, 2, 2, 2, 260, 263, 3, 2, 2, 2, 261,
+	259, 3, 2, 2, 2, 261, 262, 3, 2, 2, 2, 262, 264, 3, 2, 2, 2, 263, 261,
+	3, 2, 2, 2, 264, 265, 7, 102, 2, 2, 265, 268, 5, 46, 24, 2, 266, 267, 7,
+	86, 2, 2, 267, 269, 5, 20, 11, 2, 268, 266, 3, 2, 2, 2, 268, 269, 3, 2,
+	2, 2, 269, 280, 3, 2, 2, 2, 270, 271, 7, 103, 2, 2, 271, 272, 7, 35, 2,
+	2, 272, 277, 5, 48, 25, 2, 273, 274, 7, 4, 2, 2, 274, 276, 5, 48, 25, 2,
+	275, 273, 3, 2, 2, 2, 276, 279, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 277,
+	278, 3, 2, 2, 2, 278, 281, 3, 2, 2, 2, 279, 277, 3, 2, 2, 2, 280, 270,
+	3, 2, 2, 2, 280, 281, 3, 2, 2, 2, 281, 292, 3, 2, 2, 2, 282, 283, 7, 67,
+	2, 2, 283, 284, 7, 35, 2, 2, 284, 289, 5, 16, 9, 2, 285, 286, 7, 4, 2,
+	2, 286, 288, 5, 16, 9, 2, 287, 285, 3, 2, 2, 2, 288, 291, 3, 2, 2, 2,
+	289, 287, 3, 2, 2, 2, 289, 290, 3, 2, 2, 2, 290, 293, 3, 2, 2, 2, 291,
+	289, 3, 2, 2, 2, 292, 282, 3, 2, 2, 2, 292, 293, 3, 2, 2, 2, 293, 294,
+	3, 2, 2, 2, 294, 246, 3, 2, 2, 2, 295, 298, 5, 20, 11, 2, 296, 297, 7,
+	101, 2, 2, 297, 299, 5, 249, 29, 2, 298, 296, 3, 2, 2, 2, 298, 299, 3,
+	2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 248, 3, 2, 2, 2, 301, 302, 7, 89, 2,
+	2, 302, 250, 3, 2, 2, 2, 303, 89, 5, 245, 27, 2, 304, 233, 7, 28, 2, 2,
+	305, 307, 3, 2, 2, 2, 307, 309, 7, 108, 2, 2, 308, 310, 5, 20, 11, 2,
+	309, 308, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 315, 3, 2, 2, 2, 311,
+	312, 7, 112, 2, 2, 312, 313, 5, 20, 11, 2, 313, 314, 7, 111, 2, 2, 314,
+	316, 5, 20, 11, 2, 315, 311, 3, 2, 2, 2, 316, 317, 3, 2, 2, 2, 317, 315,
+	3, 2, 2, 2, 317, 318, 3, 2, 2, 2, 318, 321, 3, 2, 2, 2, 319, 320, 7,
+	109, 2, 2, 320, 322, 5, 20, 11, 2, 321, 319, 3, 2, 2, 2, 321, 322, 3, 2,
+	2, 2, 322, 323, 3, 2, 2, 2, 323, 324, 7, 110, 2, 2, 324, 306, 3, 2, 2,
+	2, 325, 183, 5, 24, 13, 2, 326, 325, 7, 32, 2, 2, 327, 326, 5, 24, 13,
+	2, 328, 327, 7, 107, 2, 2, 331, 329, 5, 26, 14, 2, 330, 331, 3, 2, 2, 2,
+	330, 329, 3, 2, 2, 2, 329, 328, 3, 2, 2, 2, 332, 330, 12, 8, 2, 2, 333,
+	199, 5, 305, 30, 2, 40, 54, 64, 71, 76, 82, 88, 95, 107, 110, 113,
 	118, 122, 129, 137, 145, 157, 161, 166, 170, 179, 182, 184, 198, 208, 210,
-	232,
+	232, 254, 261, 268, 277, 280, 289, 292, 298, 309, 317, 321, 330,
 }
 var literalNames = []string{
 	"", "';'", "','", "'-'", "'+'", "'('", "')'", "'!'", "'~'", "'==='", "'<'",
 	"'<='", "'>'", "'>='", "'='", "'=='", "'!='", "'!=='", "'<>'", "'&&'",
-	"'||'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "'*'", "'/'", "'%'",
+	"'||'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "'*'", "'/'", "'%'", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "'..'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
@@ -146,7 +202,9 @@ var symbolicNames = []string{
 	"K_UPPER", "K_UTCNOW", "K_WHERE", "K_XOR", "BOOLEAN_LITERAL", "IDENTIFIER",
 	"INTEGER_LITERAL", "NUMERIC_LITERAL", "GUID_LITERAL", "MEASUREMENT_KEY_LITERAL",
 	"POINT_TAG_LITERAL", "STRING_LITERAL", "DATETIME_LITERAL", "SINGLE_LINE_COMMENT",
-	"MULTILINE_COMMENT", "SPACES", "UNEXPECTED_CHAR",
+	"MULTILINE_COMMENT", "SPACES", "UNEXPECTED_CHAR", "K_AS", "K_FROM",
+	"K_GROUP", "K_SELECT", "COLUMN_PATH", "PARAMETER", "K_BETWEEN", "K_CASE",
+	"K_ELSE", "K_END", "K_THEN", "K_WHEN", "CONCAT_OPERATOR",
 }
 
 var ruleNames = []string{
@@ -156,6 +214,7 @@ var ruleNames = []string{
 	"unaryOperator", "exactMatchModifier", "comparisonOperator", "logicalOperator",
 	"bitwiseOperator", "mathOperator", "functionName", "functionExpression",
 	"literalValue", "tableName", "columnName", "orderByColumnName",
+	"selectStatement", "selectTerm", "columnAlias", "caseExpression",
 }
 
 type FilterExpressionSyntaxParser struct {
@@ -288,6 +347,19 @@ const (
 	FilterExpressionSyntaxParserMULTILINE_COMMENT       = 96
 	FilterExpressionSyntaxParserSPACES                  = 97
 	FilterExpressionSyntaxParserUNEXPECTED_CHAR         = 98
+	FilterExpressionSyntaxParserK_AS                    = 99
+	FilterExpressionSyntaxParserK_FROM                  = 100
+	FilterExpressionSyntaxParserK_GROUP                 = 101
+	FilterExpressionSyntaxParserK_SELECT                = 102
+	FilterExpressionSyntaxParserCOLUMN_PATH             = 103
+	FilterExpressionSyntaxParserPARAMETER               = 104
+	FilterExpressionSyntaxParserK_BETWEEN               = 105
+	FilterExpressionSyntaxParserK_CASE                  = 106
+	FilterExpressionSyntaxParserK_ELSE                  = 107
+	FilterExpressionSyntaxParserK_END                   = 108
+	FilterExpressionSyntaxParserK_THEN                  = 109
+	FilterExpressionSyntaxParserK_WHEN                  = 110
+	FilterExpressionSyntaxParserCONCAT_OPERATOR         = 111
 )
 
 // FilterExpressionSyntaxParser rules.
@@ -317,6 +389,10 @@ const (
 	FilterExpressionSyntaxParserRULE_tableName                     = 22
 	FilterExpressionSyntaxParserRULE_columnName                    = 23
 	FilterExpressionSyntaxParserRULE_orderByColumnName             = 24
+	FilterExpressionSyntaxParserRULE_selectStatement               = 25
+	FilterExpressionSyntaxParserRULE_selectTerm                    = 26
+	FilterExpressionSyntaxParserRULE_columnAlias                   = 27
+	FilterExpressionSyntaxParserRULE_caseExpression                = 28
 )
 
 // IParseContext is an interface to support dynamic dispatch.
@@ -426,7 +502,7 @@ func (p *FilterExpressionSyntaxParser) Parse() (localctx IParseContext) {
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case FilterExpressionSyntaxParserT__0, FilterExpressionSyntaxParserT__2, FilterExpressionSyntaxParserT__3, FilterExpressionSyntaxParserT__4, FilterExpressionSyntaxParserT__6, FilterExpressionSyntaxParserT__7, FilterExpressionSyntaxParserK_ABS, FilterExpressionSyntaxParserK_CEILING, FilterExpressionSyntaxParserK_COALESCE, FilterExpressionSyntaxParserK_CONVERT, FilterExpressionSyntaxParserK_CONTAINS, FilterExpressionSyntaxParserK_DATEADD, FilterExpressionSyntaxParserK_DATEDIFF, FilterExpressionSyntaxParserK_DATEPART, FilterExpressionSyntaxParserK_ENDSWITH, FilterExpressionSyntaxParserK_FILTER, FilterExpressionSyntaxParserK_FLOOR, FilterExpressionSyntaxParserK_IIF, FilterExpressionSyntaxParserK_INDEXOF, FilterExpressionSyntaxParserK_ISDATE, FilterExpressionSyntaxParserK_ISINTEGER, FilterExpressionSyntaxParserK_ISGUID, FilterExpressionSyntaxParserK_ISNULL, FilterExpressionSyntaxParserK_ISNUMERIC, FilterExpressionSyntaxParserK_LASTINDEXOF, FilterExpressionSyntaxParserK_LEN, FilterExpressionSyntaxParserK_LOWER, FilterExpressionSyntaxParserK_MAXOF, FilterExpressionSyntaxParserK_MINOF, FilterExpressionSyntaxParserK_NOT, FilterExpressionSyntaxParserK_NOW, FilterExpressionSyntaxParserK_NTHINDEXOF, FilterExpressionSyntaxParserK_NULL, FilterExpressionSyntaxParserK_POWER, FilterExpressionSyntaxParserK_REGEXMATCH, FilterExpressionSyntaxParserK_REGEXVAL, FilterExpressionSyntaxParserK_REPLACE, FilterExpressionSyntaxParserK_REVERSE, FilterExpressionSyntaxParserK_ROUND, FilterExpressionSyntaxParserK_SQRT, FilterExpressionSyntaxParserK_SPLIT, FilterExpressionSyntaxParserK_STARTSWITH, FilterExpressionSyntaxParserK_STRCOUNT, FilterExpressionSyntaxParserK_STRCMP, FilterExpressionSyntaxParserK_SUBSTR, FilterExpressionSyntaxParserK_TRIM, FilterExpressionSyntaxParserK_TRIMLEFT, FilterExpressionSyntaxParserK_TRIMRIGHT, FilterExpressionSyntaxParserK_UPPER, FilterExpressionSyntaxParserK_UTCNOW, FilterExpressionSyntaxParserBOOLEAN_LITERAL, FilterExpressionSyntaxParserIDENTIFIER, FilterExpressionSyntaxParserINTEGER_LITERAL, FilterExpressionSyntaxParserNUMERIC_LITERAL, FilterExpressionSyntaxParserGUID_LITERAL, FilterExpressionSyntaxParserMEASUREMENT_KEY_LITERAL, FilterExpressionSyntaxParserPOINT_TAG_LITERAL, FilterExpressionSyntaxParserSTRING_LITERAL, FilterExpressionSyntaxParserDATETIME_LITERAL:
+	case FilterExpressionSyntaxParserT__0, FilterExpressionSyntaxParserT__2, FilterExpressionSyntaxParserT__3, FilterExpressionSyntaxParserT__4, FilterExpressionSyntaxParserT__6, FilterExpressionSyntaxParserT__7, FilterExpressionSyntaxParserK_ABS, FilterExpressionSyntaxParserK_CEILING, FilterExpressionSyntaxParserK_COALESCE, FilterExpressionSyntaxParserK_CONVERT, FilterExpressionSyntaxParserK_CONTAINS, FilterExpressionSyntaxParserK_DATEADD, FilterExpressionSyntaxParserK_DATEDIFF, FilterExpressionSyntaxParserK_DATEPART, FilterExpressionSyntaxParserK_ENDSWITH, FilterExpressionSyntaxParserK_FILTER, FilterExpressionSyntaxParserK_FLOOR, FilterExpressionSyntaxParserK_IIF, FilterExpressionSyntaxParserK_INDEXOF, FilterExpressionSyntaxParserK_ISDATE, FilterExpressionSyntaxParserK_ISINTEGER, FilterExpressionSyntaxParserK_ISGUID, FilterExpressionSyntaxParserK_ISNULL, FilterExpressionSyntaxParserK_ISNUMERIC, FilterExpressionSyntaxParserK_LASTINDEXOF, FilterExpressionSyntaxParserK_LEN, FilterExpressionSyntaxParserK_LOWER, FilterExpressionSyntaxParserK_MAXOF, FilterExpressionSyntaxParserK_MINOF, FilterExpressionSyntaxParserK_NOT, FilterExpressionSyntaxParserK_NOW, FilterExpressionSyntaxParserK_NTHINDEXOF, FilterExpressionSyntaxParserK_NULL, FilterExpressionSyntaxParserK_POWER, FilterExpressionSyntaxParserK_REGEXMATCH, FilterExpressionSyntaxParserK_REGEXVAL, FilterExpressionSyntaxParserK_REPLACE, FilterExpressionSyntaxParserK_REVERSE, FilterExpressionSyntaxParserK_ROUND, FilterExpressionSyntaxParserK_SQRT, FilterExpressionSyntaxParserK_SPLIT, FilterExpressionSyntaxParserK_STARTSWITH, FilterExpressionSyntaxParserK_STRCOUNT, FilterExpressionSyntaxParserK_STRCMP, FilterExpressionSyntaxParserK_SUBSTR, FilterExpressionSyntaxParserK_TRIM, FilterExpressionSyntaxParserK_TRIMLEFT, FilterExpressionSyntaxParserK_TRIMRIGHT, FilterExpressionSyntaxParserK_UPPER, FilterExpressionSyntaxParserK_UTCNOW, FilterExpressionSyntaxParserBOOLEAN_LITERAL, FilterExpressionSyntaxParserIDENTIFIER, FilterExpressionSyntaxParserINTEGER_LITERAL, FilterExpressionSyntaxParserNUMERIC_LITERAL, FilterExpressionSyntaxParserGUID_LITERAL, FilterExpressionSyntaxParserMEASUREMENT_KEY_LITERAL, FilterExpressionSyntaxParserPOINT_TAG_LITERAL, FilterExpressionSyntaxParserSTRING_LITERAL, FilterExpressionSyntaxParserDATETIME_LITERAL, FilterExpressionSyntaxParserK_SELECT, FilterExpressionSyntaxParserCOLUMN_PATH, FilterExpressionSyntaxParserPARAMETER, FilterExpressionSyntaxParserK_CASE:
 		{
 			p.SetState(50)
 			p.FilterExpressionStatementList()
@@ -791,6 +867,16 @@ func (s *FilterExpressionStatementContext) FilterStatement() IFilterStatementCon
 	return t.(IFilterStatementContext)
 }
 
+func (s *FilterExpressionStatementContext) SelectStatement() ISelectStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectStatementContext)
+}
+
 func (s *FilterExpressionStatementContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
@@ -860,6 +946,13 @@ func (p *FilterExpressionSyntaxParser) FilterExpressionStatement() (localctx IFi
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(301)
+			p.SelectStatement()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
 			p.SetState(85)
 			p.expression(0)
@@ -1823,8 +1916,21 @@ func NewPredicateExpressionContext(parser antlr.Parser, parent antlr.ParserRuleC
 
 func (s *PredicateExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PredicateExpressionContext) ValueExpression() IValueExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueExpressionContext)(nil)).Elem(), 0)
+func (s *PredicateExpressionContext) AllValueExpression() []IValueExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IValueExpressionContext)(nil)).Elem())
+	var tst = make([]IValueExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IValueExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *PredicateExpressionContext) ValueExpression(i int) IValueExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueExpressionContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -1833,6 +1939,14 @@ func (s *PredicateExpressionContext) ValueExpression() IValueExpressionContext {
 	return t.(IValueExpressionContext)
 }
 
+func (s *PredicateExpressionContext) K_BETWEEN() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_BETWEEN, 0)
+}
+
+func (s *PredicateExpressionContext) K_AND() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_AND, 0)
+}
+
 func (s *PredicateExpressionContext) AllPredicateExpression() []IPredicateExpressionContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPredicateExpressionContext)(nil)).Elem())
 	var tst = make([]IPredicateExpressionContext, len(ts))
@@ -2042,6 +2156,42 @@ func (p *FilterExpressionSyntaxParser) predicateExpression(_p int) (localctx IPr
 				}
 
 			case 3:
+				localctx = NewPredicateExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FilterExpressionSyntaxParserRULE_predicateExpression)
+				p.SetState(330)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				}
+				p.SetState(328)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == FilterExpressionSyntaxParserT__6 || _la == FilterExpressionSyntaxParserK_NOT {
+					{
+						p.SetState(329)
+						p.NotOperator()
+					}
+
+				}
+				{
+					p.SetState(326)
+					p.Match(FilterExpressionSyntaxParserK_BETWEEN)
+				}
+				{
+					p.SetState(325)
+					p.valueExpression(0)
+				}
+				{
+					p.SetState(324)
+					p.Match(FilterExpressionSyntaxParserK_AND)
+				}
+				{
+					p.SetState(323)
+					p.valueExpression(0)
+				}
+
+			case 4:
 				localctx = NewPredicateExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, FilterExpressionSyntaxParserRULE_predicateExpression)
 				p.SetState(162)
@@ -2088,7 +2238,7 @@ func (p *FilterExpressionSyntaxParser) predicateExpression(_p int) (localctx IPr
 					p.Match(FilterExpressionSyntaxParserT__5)
 				}
 
-			case 4:
+			case 5:
 				localctx = NewPredicateExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, FilterExpressionSyntaxParserRULE_predicateExpression)
 				p.SetState(174)
@@ -2195,6 +2345,16 @@ func (s *ValueExpressionContext) FunctionExpression() IFunctionExpressionContext
 	return t.(IFunctionExpressionContext)
 }
 
+func (s *ValueExpressionContext) CaseExpression() ICaseExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICaseExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICaseExpressionContext)
+}
+
 func (s *ValueExpressionContext) UnaryOperator() IUnaryOperatorContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnaryOperatorContext)(nil)).Elem(), 0)
 
@@ -2314,26 +2474,32 @@ func (p *FilterExpressionSyntaxParser) valueExpression(_p int) (localctx IValueE
 	p.SetState(196)
 	p.GetErrorHandler().Sync(p)
 
-	switch p.GetTokenStream().LA(1) {
-	case FilterExpressionSyntaxParserK_NULL, FilterExpressionSyntaxParserBOOLEAN_LITERAL, FilterExpressionSyntaxParserINTEGER_LITERAL, FilterExpressionSyntaxParserNUMERIC_LITERAL, FilterExpressionSyntaxParserGUID_LITERAL, FilterExpressionSyntaxParserSTRING_LITERAL, FilterExpressionSyntaxParserDATETIME_LITERAL:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+	case 1:
 		{
 			p.SetState(186)
 			p.LiteralValue()
 		}
 
-	case FilterExpressionSyntaxParserIDENTIFIER:
+	case 2:
 		{
 			p.SetState(187)
 			p.ColumnName()
 		}
 
-	case FilterExpressionSyntaxParserK_ABS, FilterExpressionSyntaxParserK_CEILING, FilterExpressionSyntaxParserK_COALESCE, FilterExpressionSyntaxParserK_CONVERT, FilterExpressionSyntaxParserK_CONTAINS, FilterExpressionSyntaxParserK_DATEADD, FilterExpressionSyntaxParserK_DATEDIFF, FilterExpressionSyntaxParserK_DATEPART, FilterExpressionSyntaxParserK_ENDSWITH, FilterExpressionSyntaxParserK_FLOOR, FilterExpressionSyntaxParserK_IIF, FilterExpressionSyntaxParserK_INDEXOF, FilterExpressionSyntaxParserK_ISDATE, FilterExpressionSyntaxParserK_ISINTEGER, FilterExpressionSyntaxParserK_ISGUID, FilterExpressionSyntaxParserK_ISNULL, FilterExpressionSyntaxParserK_ISNUMERIC, FilterExpressionSyntaxParserK_LASTINDEXOF, FilterExpressionSyntaxParserK_LEN, FilterExpressionSyntaxParserK_LOWER, FilterExpressionSyntaxParserK_MAXOF, FilterExpressionSyntaxParserK_MINOF, FilterExpressionSyntaxParserK_NOW, FilterExpressionSyntaxParserK_NTHINDEXOF, FilterExpressionSyntaxParserK_POWER, FilterExpressionSyntaxParserK_REGEXMATCH, FilterExpressionSyntaxParserK_REGEXVAL, FilterExpressionSyntaxParserK_REPLACE, FilterExpressionSyntaxParserK_REVERSE, FilterExpressionSyntaxParserK_ROUND, FilterExpressionSyntaxParserK_SQRT, FilterExpressionSyntaxParserK_SPLIT, FilterExpressionSyntaxParserK_STARTSWITH, FilterExpressionSyntaxParserK_STRCOUNT, FilterExpressionSyntaxParserK_STRCMP, FilterExpressionSyntaxParserK_SUBSTR, FilterExpressionSyntaxParserK_TRIM, FilterExpressionSyntaxParserK_TRIMLEFT, FilterExpressionSyntaxParserK_TRIMRIGHT, FilterExpressionSyntaxParserK_UPPER, FilterExpressionSyntaxParserK_UTCNOW:
+	case 3:
 		{
 			p.SetState(188)
 			p.FunctionExpression()
 		}
 
-	case FilterExpressionSyntaxParserT__2, FilterExpressionSyntaxParserT__3, FilterExpressionSyntaxParserT__6, FilterExpressionSyntaxParserT__7, FilterExpressionSyntaxParserK_NOT:
+	case 4:
+		{
+			p.SetState(331)
+			p.CaseExpression()
+		}
+
+	case 5:
 		{
 			p.SetState(189)
 			p.UnaryOperator()
@@ -2343,7 +2509,7 @@ func (p *FilterExpressionSyntaxParser) valueExpression(_p int) (localctx IValueE
 			p.valueExpression(4)
 		}
 
-	case FilterExpressionSyntaxParserT__4:
+	case 6:
 		{
 			p.SetState(192)
 			p.Match(FilterExpressionSyntaxParserT__4)
@@ -2357,8 +2523,6 @@ func (p *FilterExpressionSyntaxParser) valueExpression(_p int) (localctx IValueE
 			p.Match(FilterExpressionSyntaxParserT__5)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
 	p.SetState(208)
@@ -3095,7 +3259,7 @@ func (p *FilterExpressionSyntaxParser) MathOperator() (localctx IMathOperatorCon
 		p.SetState(223)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FilterExpressionSyntaxParserT__2)|(1<<FilterExpressionSyntaxParserT__3)|(1<<FilterExpressionSyntaxParserT__25)|(1<<FilterExpressionSyntaxParserT__26)|(1<<FilterExpressionSyntaxParserT__27))) != 0) {
+		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FilterExpressionSyntaxParserT__2)|(1<<FilterExpressionSyntaxParserT__3)|(1<<FilterExpressionSyntaxParserT__25)|(1<<FilterExpressionSyntaxParserT__26)|(1<<FilterExpressionSyntaxParserT__27))) != 0 || _la == FilterExpressionSyntaxParserCONCAT_OPERATOR) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3308,6 +3472,10 @@ func (s *FunctionNameContext) K_UTCNOW() antlr.TerminalNode {
 	return s.GetToken(FilterExpressionSyntaxParserK_UTCNOW, 0)
 }
 
+func (s *FunctionNameContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserIDENTIFIER, 0)
+}
+
 func (s *FunctionNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3354,7 +3522,7 @@ func (p *FilterExpressionSyntaxParser) FunctionName() (localctx IFunctionNameCon
 		p.SetState(225)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la-29)&-(0x1f+1)) == 0 && ((1<<uint((_la-29)))&((1<<(FilterExpressionSyntaxParserK_ABS-29))|(1<<(FilterExpressionSyntaxParserK_CEILING-29))|(1<<(FilterExpressionSyntaxParserK_COALESCE-29))|(1<<(FilterExpressionSyntaxParserK_CONVERT-29))|(1<<(FilterExpressionSyntaxParserK_CONTAINS-29))|(1<<(FilterExpressionSyntaxParserK_DATEADD-29))|(1<<(FilterExpressionSyntaxParserK_DATEDIFF-29))|(1<<(FilterExpressionSyntaxParserK_DATEPART-29))|(1<<(FilterExpressionSyntaxParserK_ENDSWITH-29))|(1<<(FilterExpressionSyntaxParserK_FLOOR-29))|(1<<(FilterExpressionSyntaxParserK_IIF-29))|(1<<(FilterExpressionSyntaxParserK_INDEXOF-29))|(1<<(FilterExpressionSyntaxParserK_ISDATE-29))|(1<<(FilterExpressionSyntaxParserK_ISINTEGER-29))|(1<<(FilterExpressionSyntaxParserK_ISGUID-29))|(1<<(FilterExpressionSyntaxParserK_ISNULL-29))|(1<<(FilterExpressionSyntaxParserK_ISNUMERIC-29))|(1<<(FilterExpressionSyntaxParserK_LASTINDEXOF-29))|(1<<(FilterExpressionSyntaxParserK_LEN-29))|(1<<(FilterExpressionSyntaxParserK_LOWER-29))|(1<<(FilterExpressionSyntaxParserK_MAXOF-29))|(1<<(FilterExpressionSyntaxParserK_MINOF-29)))) != 0) || (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(FilterExpressionSyntaxParserK_NOW-61))|(1<<(FilterExpressionSyntaxParserK_NTHINDEXOF-61))|(1<<(FilterExpressionSyntaxParserK_POWER-61))|(1<<(FilterExpressionSyntaxParserK_REGEXMATCH-61))|(1<<(FilterExpressionSyntaxParserK_REGEXVAL-61))|(1<<(FilterExpressionSyntaxParserK_REPLACE-61))|(1<<(FilterExpressionSyntaxParserK_REVERSE-61))|(1<<(FilterExpressionSyntaxParserK_ROUND-61))|(1<<(FilterExpressionSyntaxParserK_SQRT-61))|(1<<(FilterExpressionSyntaxParserK_SPLIT-61))|(1<<(FilterExpressionSyntaxParserK_STARTSWITH-61))|(1<<(FilterExpressionSyntaxParserK_STRCOUNT-61))|(1<<(FilterExpressionSyntaxParserK_STRCMP-61))|(1<<(FilterExpressionSyntaxParserK_SUBSTR-61))|(1<<(FilterExpressionSyntaxParserK_TRIM-61))|(1<<(FilterExpressionSyntaxParserK_TRIMLEFT-61))|(1<<(FilterExpressionSyntaxParserK_TRIMRIGHT-61))|(1<<(FilterExpressionSyntaxParserK_UPPER-61))|(1<<(FilterExpressionSyntaxParserK_UTCNOW-61)))) != 0)) {
+		if !((((_la-29)&-(0x1f+1)) == 0 && ((1<<uint((_la-29)))&((1<<(FilterExpressionSyntaxParserK_ABS-29))|(1<<(FilterExpressionSyntaxParserK_CEILING-29))|(1<<(FilterExpressionSyntaxParserK_COALESCE-29))|(1<<(FilterExpressionSyntaxParserK_CONVERT-29))|(1<<(FilterExpressionSyntaxParserK_CONTAINS-29))|(1<<(FilterExpressionSyntaxParserK_DATEADD-29))|(1<<(FilterExpressionSyntaxParserK_DATEDIFF-29))|(1<<(FilterExpressionSyntaxParserK_DATEPART-29))|(1<<(FilterExpressionSyntaxParserK_ENDSWITH-29))|(1<<(FilterExpressionSyntaxParserK_FLOOR-29))|(1<<(FilterExpressionSyntaxParserK_IIF-29))|(1<<(FilterExpressionSyntaxParserK_INDEXOF-29))|(1<<(FilterExpressionSyntaxParserK_ISDATE-29))|(1<<(FilterExpressionSyntaxParserK_ISINTEGER-29))|(1<<(FilterExpressionSyntaxParserK_ISGUID-29))|(1<<(FilterExpressionSyntaxParserK_ISNULL-29))|(1<<(FilterExpressionSyntaxParserK_ISNUMERIC-29))|(1<<(FilterExpressionSyntaxParserK_LASTINDEXOF-29))|(1<<(FilterExpressionSyntaxParserK_LEN-29))|(1<<(FilterExpressionSyntaxParserK_LOWER-29))|(1<<(FilterExpressionSyntaxParserK_MAXOF-29))|(1<<(FilterExpressionSyntaxParserK_MINOF-29)))) != 0) || (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(FilterExpressionSyntaxParserK_NOW-61))|(1<<(FilterExpressionSyntaxParserK_NTHINDEXOF-61))|(1<<(FilterExpressionSyntaxParserK_POWER-61))|(1<<(FilterExpressionSyntaxParserK_REGEXMATCH-61))|(1<<(FilterExpressionSyntaxParserK_REGEXVAL-61))|(1<<(FilterExpressionSyntaxParserK_REPLACE-61))|(1<<(FilterExpressionSyntaxParserK_REVERSE-61))|(1<<(FilterExpressionSyntaxParserK_ROUND-61))|(1<<(FilterExpressionSyntaxParserK_SQRT-61))|(1<<(FilterExpressionSyntaxParserK_SPLIT-61))|(1<<(FilterExpressionSyntaxParserK_STARTSWITH-61))|(1<<(FilterExpressionSyntaxParserK_STRCOUNT-61))|(1<<(FilterExpressionSyntaxParserK_STRCMP-61))|(1<<(FilterExpressionSyntaxParserK_SUBSTR-61))|(1<<(FilterExpressionSyntaxParserK_TRIM-61))|(1<<(FilterExpressionSyntaxParserK_TRIMLEFT-61))|(1<<(FilterExpressionSyntaxParserK_TRIMRIGHT-61))|(1<<(FilterExpressionSyntaxParserK_UPPER-61))|(1<<(FilterExpressionSyntaxParserK_UTCNOW-61))|(1<<(FilterExpressionSyntaxParserIDENTIFIER-61)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3372,13 +3540,20 @@ type IFunctionExpressionContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
+	// GetWildcard returns the wildcard token.
+	GetWildcard() antlr.Token
+
+	// SetWildcard sets the wildcard token.
+	SetWildcard(antlr.Token)
+
 	// IsFunctionExpressionContext differentiates from other interfaces.
 	IsFunctionExpressionContext()
 }
 
 type FunctionExpressionContext struct {
 	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	parser   antlr.Parser
+	wildcard antlr.Token
 }
 
 func NewEmptyFunctionExpressionContext() *FunctionExpressionContext {
@@ -3403,6 +3578,10 @@ func NewFunctionExpressionContext(parser antlr.Parser, parent antlr.ParserRuleCo
 
 func (s *FunctionExpressionContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *FunctionExpressionContext) GetWildcard() antlr.Token { return s.wildcard }
+
+func (s *FunctionExpressionContext) SetWildcard(v antlr.Token) { s.wildcard = v }
+
 func (s *FunctionExpressionContext) FunctionName() IFunctionNameContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionNameContext)(nil)).Elem(), 0)
 
@@ -3477,12 +3656,21 @@ func (p *FilterExpressionSyntaxParser) FunctionExpression() (localctx IFunctionE
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FilterExpressionSyntaxParserT__2)|(1<<FilterExpressionSyntaxParserT__3)|(1<<FilterExpressionSyntaxParserT__4)|(1<<FilterExpressionSyntaxParserT__6)|(1<<FilterExpressionSyntaxParserT__7)|(1<<FilterExpressionSyntaxParserK_ABS))) != 0) || (((_la-34)&-(0x1f+1)) == 0 && ((1<<uint((_la-34)))&((1<<(FilterExpressionSyntaxParserK_CEILING-34))|(1<<(FilterExpressionSyntaxParserK_COALESCE-34))|(1<<(FilterExpressionSyntaxParserK_CONVERT-34))|(1<<(FilterExpressionSyntaxParserK_CONTAINS-34))|(1<<(FilterExpressionSyntaxParserK_DATEADD-34))|(1<<(FilterExpressionSyntaxParserK_DATEDIFF-34))|(1<<(FilterExpressionSyntaxParserK_DATEPART-34))|(1<<(FilterExpressionSyntaxParserK_ENDSWITH-34))|(1<<(FilterExpressionSyntaxParserK_FLOOR-34))|(1<<(FilterExpressionSyntaxParserK_IIF-34))|(1<<(FilterExpressionSyntaxParserK_INDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_ISDATE-34))|(1<<(FilterExpressionSyntaxParserK_ISINTEGER-34))|(1<<(FilterExpressionSyntaxParserK_ISGUID-34))|(1<<(FilterExpressionSyntaxParserK_ISNULL-34))|(1<<(FilterExpressionSyntaxParserK_ISNUMERIC-34))|(1<<(FilterExpressionSyntaxParserK_LASTINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_LEN-34))|(1<<(FilterExpressionSyntaxParserK_LOWER-34))|(1<<(FilterExpressionSyntaxParserK_MAXOF-34))|(1<<(FilterExpressionSyntaxParserK_MINOF-34))|(1<<(FilterExpressionSyntaxParserK_NOT-34))|(1<<(FilterExpressionSyntaxParserK_NOW-34))|(1<<(FilterExpressionSyntaxParserK_NTHINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_NULL-34)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(FilterExpressionSyntaxParserK_POWER-66))|(1<<(FilterExpressionSyntaxParserK_REGEXMATCH-66))|(1<<(FilterExpressionSyntaxParserK_REGEXVAL-66))|(1<<(FilterExpressionSyntaxParserK_REPLACE-66))|(1<<(FilterExpressionSyntaxParserK_REVERSE-66))|(1<<(FilterExpressionSyntaxParserK_ROUND-66))|(1<<(FilterExpressionSyntaxParserK_SQRT-66))|(1<<(FilterExpressionSyntaxParserK_SPLIT-66))|(1<<(FilterExpressionSyntaxParserK_STARTSWITH-66))|(1<<(FilterExpressionSyntaxParserK_STRCOUNT-66))|(1<<(FilterExpressionSyntaxParserK_STRCMP-66))|(1<<(FilterExpressionSyntaxParserK_SUBSTR-66))|(1<<(FilterExpressionSyntaxParserK_TRIM-66))|(1<<(FilterExpressionSyntaxParserK_TRIMLEFT-66))|(1<<(FilterExpressionSyntaxParserK_TRIMRIGHT-66))|(1<<(FilterExpressionSyntaxParserK_UPPER-66))|(1<<(FilterExpressionSyntaxParserK_UTCNOW-66))|(1<<(FilterExpressionSyntaxParserBOOLEAN_LITERAL-66))|(1<<(FilterExpressionSyntaxParserIDENTIFIER-66))|(1<<(FilterExpressionSyntaxParserINTEGER_LITERAL-66))|(1<<(FilterExpressionSyntaxParserNUMERIC_LITERAL-66))|(1<<(FilterExpressionSyntaxParserGUID_LITERAL-66))|(1<<(FilterExpressionSyntaxParserSTRING_LITERAL-66))|(1<<(FilterExpressionSyntaxParserDATETIME_LITERAL-66)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FilterExpressionSyntaxParserT__2)|(1<<FilterExpressionSyntaxParserT__3)|(1<<FilterExpressionSyntaxParserT__4)|(1<<FilterExpressionSyntaxParserT__6)|(1<<FilterExpressionSyntaxParserT__7)|(1<<FilterExpressionSyntaxParserK_ABS))) != 0) || (((_la-34)&-(0x1f+1)) == 0 && ((1<<uint((_la-34)))&((1<<(FilterExpressionSyntaxParserK_CEILING-34))|(1<<(FilterExpressionSyntaxParserK_COALESCE-34))|(1<<(FilterExpressionSyntaxParserK_CONVERT-34))|(1<<(FilterExpressionSyntaxParserK_CONTAINS-34))|(1<<(FilterExpressionSyntaxParserK_DATEADD-34))|(1<<(FilterExpressionSyntaxParserK_DATEDIFF-34))|(1<<(FilterExpressionSyntaxParserK_DATEPART-34))|(1<<(FilterExpressionSyntaxParserK_ENDSWITH-34))|(1<<(FilterExpressionSyntaxParserK_FLOOR-34))|(1<<(FilterExpressionSyntaxParserK_IIF-34))|(1<<(FilterExpressionSyntaxParserK_INDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_ISDATE-34))|(1<<(FilterExpressionSyntaxParserK_ISINTEGER-34))|(1<<(FilterExpressionSyntaxParserK_ISGUID-34))|(1<<(FilterExpressionSyntaxParserK_ISNULL-34))|(1<<(FilterExpressionSyntaxParserK_ISNUMERIC-34))|(1<<(FilterExpressionSyntaxParserK_LASTINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_LEN-34))|(1<<(FilterExpressionSyntaxParserK_LOWER-34))|(1<<(FilterExpressionSyntaxParserK_MAXOF-34))|(1<<(FilterExpressionSyntaxParserK_MINOF-34))|(1<<(FilterExpressionSyntaxParserK_NOT-34))|(1<<(FilterExpressionSyntaxParserK_NOW-34))|(1<<(FilterExpressionSyntaxParserK_NTHINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_NULL-34)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(FilterExpressionSyntaxParserK_POWER-66))|(1<<(FilterExpressionSyntaxParserK_REGEXMATCH-66))|(1<<(FilterExpressionSyntaxParserK_REGEXVAL-66))|(1<<(FilterExpressionSyntaxParserK_REPLACE-66))|(1<<(FilterExpressionSyntaxParserK_REVERSE-66))|(1<<(FilterExpressionSyntaxParserK_ROUND-66))|(1<<(FilterExpressionSyntaxParserK_SQRT-66))|(1<<(FilterExpressionSyntaxParserK_SPLIT-66))|(1<<(FilterExpressionSyntaxParserK_STARTSWITH-66))|(1<<(FilterExpressionSyntaxParserK_STRCOUNT-66))|(1<<(FilterExpressionSyntaxParserK_STRCMP-66))|(1<<(FilterExpressionSyntaxParserK_SUBSTR-66))|(1<<(FilterExpressionSyntaxParserK_TRIM-66))|(1<<(FilterExpressionSyntaxParserK_TRIMLEFT-66))|(1<<(FilterExpressionSyntaxParserK_TRIMRIGHT-66))|(1<<(FilterExpressionSyntaxParserK_UPPER-66))|(1<<(FilterExpressionSyntaxParserK_UTCNOW-66))|(1<<(FilterExpressionSyntaxParserBOOLEAN_LITERAL-66))|(1<<(FilterExpressionSyntaxParserIDENTIFIER-66))|(1<<(FilterExpressionSyntaxParserINTEGER_LITERAL-66))|(1<<(FilterExpressionSyntaxParserNUMERIC_LITERAL-66))|(1<<(FilterExpressionSyntaxParserGUID_LITERAL-66))|(1<<(FilterExpressionSyntaxParserSTRING_LITERAL-66))|(1<<(FilterExpressionSyntaxParserDATETIME_LITERAL-66)))) != 0) || _la == FilterExpressionSyntaxParserCOLUMN_PATH || _la == FilterExpressionSyntaxParserPARAMETER || _la == FilterExpressionSyntaxParserK_CASE {
 		{
 			p.SetState(229)
 			p.ExpressionList()
 		}
 
+	} else if _la == FilterExpressionSyntaxParserT__25 {
+		{
+			p.SetState(302)
+
+			var _m = p.Match(FilterExpressionSyntaxParserT__25)
+
+			localctx.(*FunctionExpressionContext).wildcard = _m
+		}
+
 	}
 	{
 		p.SetState(232)
@@ -3546,6 +3734,10 @@ func (s *LiteralValueContext) DATETIME_LITERAL() antlr.TerminalNode {
 	return s.GetToken(FilterExpressionSyntaxParserDATETIME_LITERAL, 0)
 }
 
+func (s *LiteralValueContext) PARAMETER() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserPARAMETER, 0)
+}
+
 func (s *LiteralValueContext) GUID_LITERAL() antlr.TerminalNode {
 	return s.GetToken(FilterExpressionSyntaxParserGUID_LITERAL, 0)
 }
@@ -3604,7 +3796,7 @@ func (p *FilterExpressionSyntaxParser) LiteralValue() (localctx ILiteralValueCon
 		p.SetState(234)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-63)&-(0x1f+1)) == 0 && ((1<<uint((_la-63)))&((1<<(FilterExpressionSyntaxParserK_NULL-63))|(1<<(FilterExpressionSyntaxParserBOOLEAN_LITERAL-63))|(1<<(FilterExpressionSyntaxParserINTEGER_LITERAL-63))|(1<<(FilterExpressionSyntaxParserNUMERIC_LITERAL-63))|(1<<(FilterExpressionSyntaxParserGUID_LITERAL-63))|(1<<(FilterExpressionSyntaxParserSTRING_LITERAL-63))|(1<<(FilterExpressionSyntaxParserDATETIME_LITERAL-63)))) != 0) {
+		if !(((_la-63)&-(0x1f+1)) == 0 && ((1<<uint((_la-63)))&((1<<(FilterExpressionSyntaxParserK_NULL-63))|(1<<(FilterExpressionSyntaxParserBOOLEAN_LITERAL-63))|(1<<(FilterExpressionSyntaxParserINTEGER_LITERAL-63))|(1<<(FilterExpressionSyntaxParserNUMERIC_LITERAL-63))|(1<<(FilterExpressionSyntaxParserGUID_LITERAL-63))|(1<<(FilterExpressionSyntaxParserSTRING_LITERAL-63))|(1<<(FilterExpressionSyntaxParserDATETIME_LITERAL-63)))) != 0 || _la == FilterExpressionSyntaxParserPARAMETER) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3748,6 +3940,10 @@ func (s *ColumnNameContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(FilterExpressionSyntaxParserIDENTIFIER, 0)
 }
 
+func (s *ColumnNameContext) COLUMN_PATH() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserCOLUMN_PATH, 0)
+}
+
 func (s *ColumnNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3771,6 +3967,7 @@ func (s *ColumnNameContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *FilterExpressionSyntaxParser) ColumnName() (localctx IColumnNameContext) {
 	localctx = NewColumnNameContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 46, FilterExpressionSyntaxParserRULE_columnName)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3791,7 +3988,14 @@ func (p *FilterExpressionSyntaxParser) ColumnName() (localctx IColumnNameContext
 	p.EnterOuterAlt(localctx, 1)
 	{
 		p.SetState(238)
-		p.Match(FilterExpressionSyntaxParserIDENTIFIER)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FilterExpressionSyntaxParserIDENTIFIER || _la == FilterExpressionSyntaxParserCOLUMN_PATH) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
@@ -3888,69 +4092,838 @@ func (p *FilterExpressionSyntaxParser) OrderByColumnName() (localctx IOrderByCol
 	return localctx
 }
 
-func (p *FilterExpressionSyntaxParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
-	switch ruleIndex {
-	case 9:
-		var t *ExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*ExpressionContext)
-		}
-		return p.Expression_Sempred(t, predIndex)
+// ISelectStatementContext is an interface to support dynamic dispatch.
+type ISelectStatementContext interface {
+	antlr.ParserRuleContext
 
-	case 10:
-		var t *PredicateExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*PredicateExpressionContext)
-		}
-		return p.PredicateExpression_Sempred(t, predIndex)
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	case 11:
-		var t *ValueExpressionContext = nil
-		if localctx != nil {
-			t = localctx.(*ValueExpressionContext)
+	// IsSelectStatementContext differentiates from other interfaces.
+	IsSelectStatementContext()
+}
+
+type SelectStatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectStatementContext() *SelectStatementContext {
+	var p = new(SelectStatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_selectStatement
+	return p
+}
+
+func (*SelectStatementContext) IsSelectStatementContext() {}
+
+func NewSelectStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStatementContext {
+	var p = new(SelectStatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_selectStatement
+
+	return p
+}
+
+func (s *SelectStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectStatementContext) K_SELECT() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_SELECT, 0)
+}
+
+func (s *SelectStatementContext) AllSelectTerm() []ISelectTermContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISelectTermContext)(nil)).Elem())
+	var tst = make([]ISelectTermContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISelectTermContext)
 		}
-		return p.ValueExpression_Sempred(t, predIndex)
+	}
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
+	return tst
+}
+
+func (s *SelectStatementContext) SelectTerm(i int) ISelectTermContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectTermContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(ISelectTermContext)
 }
 
-func (p *FilterExpressionSyntaxParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+func (s *SelectStatementContext) K_FROM() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_FROM, 0)
+}
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+func (s *SelectStatementContext) TableName() ITableNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITableNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(ITableNameContext)
 }
 
-func (p *FilterExpressionSyntaxParser) PredicateExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+func (s *SelectStatementContext) K_TOP() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_TOP, 0)
+}
 
-	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+func (s *SelectStatementContext) TopLimit() ITopLimitContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITopLimitContext)(nil)).Elem(), 0)
 
-	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+	if t == nil {
+		return nil
+	}
 
-	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+	return t.(ITopLimitContext)
+}
 
-	default:
-		panic("No predicate with index: " + fmt.Sprint(predIndex))
+func (s *SelectStatementContext) K_WHERE() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_WHERE, 0)
+}
+
+func (s *SelectStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
 	}
+
+	return t.(IExpressionContext)
 }
 
-func (p *FilterExpressionSyntaxParser) ValueExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
-	switch predIndex {
-	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+func (s *SelectStatementContext) K_GROUP() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_GROUP, 0)
+}
 
-	case 6:
+func (s *SelectStatementContext) AllK_BY() []antlr.TerminalNode {
+	return s.GetTokens(FilterExpressionSyntaxParserK_BY)
+}
+
+func (s *SelectStatementContext) K_BY(i int) antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_BY, i)
+}
+
+func (s *SelectStatementContext) AllColumnName() []IColumnNameContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IColumnNameContext)(nil)).Elem())
+	var tst = make([]IColumnNameContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IColumnNameContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) ColumnName(i int) IColumnNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnNameContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnNameContext)
+}
+
+func (s *SelectStatementContext) K_ORDER() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_ORDER, 0)
+}
+
+func (s *SelectStatementContext) AllOrderingTerm() []IOrderingTermContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOrderingTermContext)(nil)).Elem())
+	var tst = make([]IOrderingTermContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOrderingTermContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectStatementContext) OrderingTerm(i int) IOrderingTermContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderingTermContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderingTermContext)
+}
+
+func (s *SelectStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.EnterSelectStatement(s)
+	}
+}
+
+func (s *SelectStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.ExitSelectStatement(s)
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) SelectStatement() (localctx ISelectStatementContext) {
+	localctx = NewSelectStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 243, FilterExpressionSyntaxParserRULE_selectStatement)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(249)
+		p.Match(FilterExpressionSyntaxParserK_SELECT)
+	}
+	p.SetState(252)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_TOP {
+		{
+			p.SetState(250)
+			p.Match(FilterExpressionSyntaxParserK_TOP)
+		}
+		{
+			p.SetState(251)
+			p.TopLimit()
+		}
+
+	}
+	{
+		p.SetState(254)
+		p.SelectTerm()
+	}
+	p.SetState(259)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FilterExpressionSyntaxParserT__1 {
+		{
+			p.SetState(255)
+			p.Match(FilterExpressionSyntaxParserT__1)
+		}
+		{
+			p.SetState(256)
+			p.SelectTerm()
+		}
+
+		p.SetState(261)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(262)
+		p.Match(FilterExpressionSyntaxParserK_FROM)
+	}
+	{
+		p.SetState(263)
+		p.TableName()
+	}
+	p.SetState(266)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_WHERE {
+		{
+			p.SetState(264)
+			p.Match(FilterExpressionSyntaxParserK_WHERE)
+		}
+		{
+			p.SetState(265)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(278)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_GROUP {
+		{
+			p.SetState(268)
+			p.Match(FilterExpressionSyntaxParserK_GROUP)
+		}
+		{
+			p.SetState(269)
+			p.Match(FilterExpressionSyntaxParserK_BY)
+		}
+		{
+			p.SetState(270)
+			p.ColumnName()
+		}
+		p.SetState(275)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == FilterExpressionSyntaxParserT__1 {
+			{
+				p.SetState(271)
+				p.Match(FilterExpressionSyntaxParserT__1)
+			}
+			{
+				p.SetState(272)
+				p.ColumnName()
+			}
+
+			p.SetState(277)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	p.SetState(290)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_ORDER {
+		{
+			p.SetState(280)
+			p.Match(FilterExpressionSyntaxParserK_ORDER)
+		}
+		{
+			p.SetState(281)
+			p.Match(FilterExpressionSyntaxParserK_BY)
+		}
+		{
+			p.SetState(282)
+			p.OrderingTerm()
+		}
+		p.SetState(287)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == FilterExpressionSyntaxParserT__1 {
+			{
+				p.SetState(283)
+				p.Match(FilterExpressionSyntaxParserT__1)
+			}
+			{
+				p.SetState(284)
+				p.OrderingTerm()
+			}
+
+			p.SetState(289)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+
+	return localctx
+}
+
+// ISelectTermContext is an interface to support dynamic dispatch.
+type ISelectTermContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectTermContext differentiates from other interfaces.
+	IsSelectTermContext()
+}
+
+type SelectTermContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectTermContext() *SelectTermContext {
+	var p = new(SelectTermContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_selectTerm
+	return p
+}
+
+func (*SelectTermContext) IsSelectTermContext() {}
+
+func NewSelectTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectTermContext {
+	var p = new(SelectTermContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_selectTerm
+
+	return p
+}
+
+func (s *SelectTermContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectTermContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SelectTermContext) K_AS() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_AS, 0)
+}
+
+func (s *SelectTermContext) ColumnAlias() IColumnAliasContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnAliasContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnAliasContext)
+}
+
+func (s *SelectTermContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectTermContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.EnterSelectTerm(s)
+	}
+}
+
+func (s *SelectTermContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.ExitSelectTerm(s)
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) SelectTerm() (localctx ISelectTermContext) {
+	localctx = NewSelectTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 245, FilterExpressionSyntaxParserRULE_selectTerm)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(293)
+		p.expression(0)
+	}
+	p.SetState(296)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_AS {
+		{
+			p.SetState(294)
+			p.Match(FilterExpressionSyntaxParserK_AS)
+		}
+		{
+			p.SetState(295)
+			p.ColumnAlias()
+		}
+
+	}
+
+	return localctx
+}
+
+// IColumnAliasContext is an interface to support dynamic dispatch.
+type IColumnAliasContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsColumnAliasContext differentiates from other interfaces.
+	IsColumnAliasContext()
+}
+
+type ColumnAliasContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyColumnAliasContext() *ColumnAliasContext {
+	var p = new(ColumnAliasContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_columnAlias
+	return p
+}
+
+func (*ColumnAliasContext) IsColumnAliasContext() {}
+
+func NewColumnAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ColumnAliasContext {
+	var p = new(ColumnAliasContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_columnAlias
+
+	return p
+}
+
+func (s *ColumnAliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ColumnAliasContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserIDENTIFIER, 0)
+}
+
+func (s *ColumnAliasContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ColumnAliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ColumnAliasContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.EnterColumnAlias(s)
+	}
+}
+
+func (s *ColumnAliasContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.ExitColumnAlias(s)
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) ColumnAlias() (localctx IColumnAliasContext) {
+	localctx = NewColumnAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 247, FilterExpressionSyntaxParserRULE_columnAlias)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(299)
+		p.Match(FilterExpressionSyntaxParserIDENTIFIER)
+	}
+
+	return localctx
+}
+
+// ICaseExpressionContext is an interface to support dynamic dispatch.
+type ICaseExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCaseExpressionContext differentiates from other interfaces.
+	IsCaseExpressionContext()
+}
+
+type CaseExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCaseExpressionContext() *CaseExpressionContext {
+	var p = new(CaseExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_caseExpression
+	return p
+}
+
+func (*CaseExpressionContext) IsCaseExpressionContext() {}
+
+func NewCaseExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CaseExpressionContext {
+	var p = new(CaseExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FilterExpressionSyntaxParserRULE_caseExpression
+
+	return p
+}
+
+func (s *CaseExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CaseExpressionContext) K_CASE() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_CASE, 0)
+}
+
+func (s *CaseExpressionContext) K_END() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_END, 0)
+}
+
+func (s *CaseExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CaseExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *CaseExpressionContext) AllK_WHEN() []antlr.TerminalNode {
+	return s.GetTokens(FilterExpressionSyntaxParserK_WHEN)
+}
+
+func (s *CaseExpressionContext) K_WHEN(i int) antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_WHEN, i)
+}
+
+func (s *CaseExpressionContext) AllK_THEN() []antlr.TerminalNode {
+	return s.GetTokens(FilterExpressionSyntaxParserK_THEN)
+}
+
+func (s *CaseExpressionContext) K_THEN(i int) antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_THEN, i)
+}
+
+func (s *CaseExpressionContext) K_ELSE() antlr.TerminalNode {
+	return s.GetToken(FilterExpressionSyntaxParserK_ELSE, 0)
+}
+
+func (s *CaseExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CaseExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CaseExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.EnterCaseExpression(s)
+	}
+}
+
+func (s *CaseExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FilterExpressionSyntaxListener); ok {
+		listenerT.ExitCaseExpression(s)
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) CaseExpression() (localctx ICaseExpressionContext) {
+	localctx = NewCaseExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 303, FilterExpressionSyntaxParserRULE_caseExpression)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(305)
+		p.Match(FilterExpressionSyntaxParserK_CASE)
+	}
+	p.SetState(307)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FilterExpressionSyntaxParserT__2)|(1<<FilterExpressionSyntaxParserT__3)|(1<<FilterExpressionSyntaxParserT__4)|(1<<FilterExpressionSyntaxParserT__6)|(1<<FilterExpressionSyntaxParserT__7)|(1<<FilterExpressionSyntaxParserK_ABS))) != 0) || (((_la-34)&-(0x1f+1)) == 0 && ((1<<uint((_la-34)))&((1<<(FilterExpressionSyntaxParserK_CEILING-34))|(1<<(FilterExpressionSyntaxParserK_COALESCE-34))|(1<<(FilterExpressionSyntaxParserK_CONVERT-34))|(1<<(FilterExpressionSyntaxParserK_CONTAINS-34))|(1<<(FilterExpressionSyntaxParserK_DATEADD-34))|(1<<(FilterExpressionSyntaxParserK_DATEDIFF-34))|(1<<(FilterExpressionSyntaxParserK_DATEPART-34))|(1<<(FilterExpressionSyntaxParserK_ENDSWITH-34))|(1<<(FilterExpressionSyntaxParserK_FLOOR-34))|(1<<(FilterExpressionSyntaxParserK_IIF-34))|(1<<(FilterExpressionSyntaxParserK_INDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_ISDATE-34))|(1<<(FilterExpressionSyntaxParserK_ISINTEGER-34))|(1<<(FilterExpressionSyntaxParserK_ISGUID-34))|(1<<(FilterExpressionSyntaxParserK_ISNULL-34))|(1<<(FilterExpressionSyntaxParserK_ISNUMERIC-34))|(1<<(FilterExpressionSyntaxParserK_LASTINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_LEN-34))|(1<<(FilterExpressionSyntaxParserK_LOWER-34))|(1<<(FilterExpressionSyntaxParserK_MAXOF-34))|(1<<(FilterExpressionSyntaxParserK_MINOF-34))|(1<<(FilterExpressionSyntaxParserK_NOT-34))|(1<<(FilterExpressionSyntaxParserK_NOW-34))|(1<<(FilterExpressionSyntaxParserK_NTHINDEXOF-34))|(1<<(FilterExpressionSyntaxParserK_NULL-34)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(FilterExpressionSyntaxParserK_POWER-66))|(1<<(FilterExpressionSyntaxParserK_REGEXMATCH-66))|(1<<(FilterExpressionSyntaxParserK_REGEXVAL-66))|(1<<(FilterExpressionSyntaxParserK_REPLACE-66))|(1<<(FilterExpressionSyntaxParserK_REVERSE-66))|(1<<(FilterExpressionSyntaxParserK_ROUND-66))|(1<<(FilterExpressionSyntaxParserK_SQRT-66))|(1<<(FilterExpressionSyntaxParserK_SPLIT-66))|(1<<(FilterExpressionSyntaxParserK_STARTSWITH-66))|(1<<(FilterExpressionSyntaxParserK_STRCOUNT-66))|(1<<(FilterExpressionSyntaxParserK_STRCMP-66))|(1<<(FilterExpressionSyntaxParserK_SUBSTR-66))|(1<<(FilterExpressionSyntaxParserK_TRIM-66))|(1<<(FilterExpressionSyntaxParserK_TRIMLEFT-66))|(1<<(FilterExpressionSyntaxParserK_TRIMRIGHT-66))|(1<<(FilterExpressionSyntaxParserK_UPPER-66))|(1<<(FilterExpressionSyntaxParserK_UTCNOW-66))|(1<<(FilterExpressionSyntaxParserBOOLEAN_LITERAL-66))|(1<<(FilterExpressionSyntaxParserIDENTIFIER-66))|(1<<(FilterExpressionSyntaxParserINTEGER_LITERAL-66))|(1<<(FilterExpressionSyntaxParserNUMERIC_LITERAL-66))|(1<<(FilterExpressionSyntaxParserGUID_LITERAL-66))|(1<<(FilterExpressionSyntaxParserSTRING_LITERAL-66))|(1<<(FilterExpressionSyntaxParserDATETIME_LITERAL-66)))) != 0) || _la == FilterExpressionSyntaxParserCOLUMN_PATH || _la == FilterExpressionSyntaxParserPARAMETER || _la == FilterExpressionSyntaxParserK_CASE {
+		{
+			p.SetState(306)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(313)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == FilterExpressionSyntaxParserK_WHEN {
+		{
+			p.SetState(309)
+			p.Match(FilterExpressionSyntaxParserK_WHEN)
+		}
+		{
+			p.SetState(310)
+			p.expression(0)
+		}
+		{
+			p.SetState(311)
+			p.Match(FilterExpressionSyntaxParserK_THEN)
+		}
+		{
+			p.SetState(312)
+			p.expression(0)
+		}
+
+		p.SetState(315)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(319)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FilterExpressionSyntaxParserK_ELSE {
+		{
+			p.SetState(317)
+			p.Match(FilterExpressionSyntaxParserK_ELSE)
+		}
+		{
+			p.SetState(318)
+			p.expression(0)
+		}
+
+	}
+	{
+		p.SetState(321)
+		p.Match(FilterExpressionSyntaxParserK_END)
+	}
+
+	return localctx
+}
+
+func (p *FilterExpressionSyntaxParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 9:
+		var t *ExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*ExpressionContext)
+		}
+		return p.Expression_Sempred(t, predIndex)
+
+	case 10:
+		var t *PredicateExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*PredicateExpressionContext)
+		}
+		return p.PredicateExpression_Sempred(t, predIndex)
+
+	case 11:
+		var t *ValueExpressionContext = nil
+		if localctx != nil {
+			t = localctx.(*ValueExpressionContext)
+		}
+		return p.ValueExpression_Sempred(t, predIndex)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 0:
+		return p.Precpred(p.GetParserRuleContext(), 2)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) PredicateExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 1:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 2:
+		return p.Precpred(p.GetParserRuleContext(), 2)
+
+	case 3:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *FilterExpressionSyntaxParser) ValueExpression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 2)
+
+	case 7:
 		return p.Precpred(p.GetParserRuleContext(), 1)
 
 	default:
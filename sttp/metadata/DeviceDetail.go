@@ -0,0 +1,102 @@
+//******************************************************************************************************
+//  DeviceDetail.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// DeviceDetail defines a record from the standard STTP DeviceDetail metadata table,
+// i.e., a device, such as a PMU or PDC, that is the source of measurements.
+type DeviceDetail struct {
+	// NodeID defines the identifier of the node that owns the device.
+	NodeID guid.Guid `sttp:"NodeID"`
+
+	// UniqueID defines the globally unique identifier of the device.
+	UniqueID guid.Guid `sttp:"UniqueID"`
+
+	// OriginalSource defines the original acronym of the device when it was re-published
+	// from another source.
+	OriginalSource string `sttp:"OriginalSource"`
+
+	// IsConcentrator determines if the device is a concentrator, e.g., a PDC.
+	IsConcentrator bool `sttp:"IsConcentrator"`
+
+	// Acronym defines the unique acronym of the device.
+	Acronym string `sttp:"Acronym"`
+
+	// Name defines the free-form name of the device.
+	Name string `sttp:"Name"`
+
+	// AccessID defines the protocol specific identifier of the device, e.g., an IEEE C37.118 ID code.
+	AccessID int32 `sttp:"AccessID"`
+
+	// ParentAcronym defines the acronym of the concentrator that contains the device, if any.
+	ParentAcronym string `sttp:"ParentAcronym"`
+
+	// ProtocolName defines the name of the protocol used to receive device data.
+	ProtocolName string `sttp:"ProtocolName"`
+
+	// FramesPerSecond defines the nominal data rate of the device.
+	FramesPerSecond int32 `sttp:"FramesPerSecond"`
+
+	// CompanyAcronym defines the acronym of the company that owns the device.
+	CompanyAcronym string `sttp:"CompanyAcronym"`
+
+	// VendorAcronym defines the acronym of the device vendor.
+	VendorAcronym string `sttp:"VendorAcronym"`
+
+	// VendorDeviceName defines the vendor model name of the device.
+	VendorDeviceName string `sttp:"VendorDeviceName"`
+
+	// Longitude defines the longitude of the device location, nil when undefined.
+	Longitude *decimal.Decimal `sttp:"Longitude"`
+
+	// Latitude defines the latitude of the device location, nil when undefined.
+	Latitude *decimal.Decimal `sttp:"Latitude"`
+
+	// InterconnectionName defines the name of the interconnection where the device is located.
+	InterconnectionName string `sttp:"InterconnectionName"`
+
+	// ContactList defines contact information for the device.
+	ContactList string `sttp:"ContactList"`
+
+	// Enabled determines if the device is enabled.
+	Enabled bool `sttp:"Enabled"`
+
+	// UpdatedOn defines the timestamp of when the device record was last updated.
+	UpdatedOn time.Time `sttp:"UpdatedOn"`
+
+	// Parent defines the resolved concentrator DeviceDetail for ParentAcronym, if any.
+	Parent *DeviceDetail `sttp:"-"`
+
+	// Measurements defines the resolved measurements associated with the device.
+	Measurements []*MeasurementDetail `sttp:"-"`
+
+	// Phasors defines the resolved phasors associated with the device.
+	Phasors []*PhasorDetail `sttp:"-"`
+}
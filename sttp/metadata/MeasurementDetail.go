@@ -0,0 +1,116 @@
+//******************************************************************************************************
+//  MeasurementDetail.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// MeasurementDetail defines a record from the standard STTP MeasurementDetail metadata table.
+type MeasurementDetail struct {
+	// DeviceAcronym defines the acronym of the device that is the source of the measurement.
+	DeviceAcronym string `sttp:"DeviceAcronym"`
+
+	// ID defines the human-readable measurement key, e.g., "PPA:15".
+	ID string `sttp:"ID"`
+
+	// SignalID defines the globally unique identifier of the measurement.
+	SignalID guid.Guid `sttp:"SignalID"`
+
+	// PointTag defines the human-readable tag name of the measurement.
+	PointTag string `sttp:"PointTag"`
+
+	// SignalReference defines reference info about a signal based on measurement original
+	// source, e.g., "SHELBY-PA1".
+	SignalReference string `sttp:"SignalReference"`
+
+	// SignalAcronym defines the signal type acronym of the measurement, e.g., "FREQ".
+	SignalAcronym string `sttp:"SignalAcronym"`
+
+	// PhasorSourceIndex defines the source index of the associated phasor, nil when the
+	// measurement is not part of a phasor.
+	PhasorSourceIndex *int32 `sttp:"PhasorSourceIndex"`
+
+	// Description defines a general description for the measurement.
+	Description string `sttp:"Description"`
+
+	// Internal determines if the measurement is defined by the local system.
+	Internal bool `sttp:"Internal"`
+
+	// Enabled determines if the measurement is enabled.
+	Enabled bool `sttp:"Enabled"`
+
+	// UpdatedOn defines the timestamp of when the measurement record was last updated.
+	UpdatedOn time.Time `sttp:"UpdatedOn"`
+
+	// Device defines the resolved DeviceDetail for DeviceAcronym, if any.
+	Device *DeviceDetail `sttp:"-"`
+
+	// Phasor defines the resolved PhasorDetail for an angle or magnitude measurement, if any.
+	Phasor *PhasorDetail `sttp:"-"`
+}
+
+// Key parses the source and numeric identifier of the human-readable measurement key defined
+// by the ID field. Return tuple includes boolean value that determines if parse was successful.
+func (md *MeasurementDetail) Key() (source string, id uint64, ok bool) {
+	parts := strings.Split(md.ID, ":")
+
+	if len(parts) != 2 {
+		return "", 0, false
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+
+	if err != nil {
+		return "", 0, false
+	}
+
+	return parts[0], id, true
+}
+
+// ParseSignalReference attempts to parse the SignalReference into a signal kind and position
+// representing original source protocol details. Returns SignalKind.Unknown for signal
+// references that are not normally formatted.
+func (md *MeasurementDetail) ParseSignalReference() (source string, signalKind transport.SignalKindEnum, position int) {
+	lastDash := strings.LastIndex(md.SignalReference, "-")
+
+	// Guard against malformed references that are too short to contain a signal kind acronym
+	if lastDash < 0 || len(md.SignalReference)-lastDash-1 < 2 {
+		return "", transport.SignalKind.Unknown, 0
+	}
+
+	metadata := transport.MeasurementMetadata{SignalReference: md.SignalReference}
+	return metadata.ParseSignalReference()
+}
+
+// SignalKind gets the kind of signal the measurement represents as parsed from the SignalReference.
+func (md *MeasurementDetail) SignalKind() transport.SignalKindEnum {
+	_, signalKind, _ := md.ParseSignalReference()
+	return signalKind
+}
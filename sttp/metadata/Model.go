@@ -0,0 +1,226 @@
+//******************************************************************************************************
+//  Model.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// Package metadata defines a strongly typed model for the standard STTP metadata tables.
+package metadata
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+const (
+	// DeviceDetailTable defines the name of the standard STTP device metadata table.
+	DeviceDetailTable = "DeviceDetail"
+
+	// MeasurementDetailTable defines the name of the standard STTP measurement metadata table.
+	MeasurementDetailTable = "MeasurementDetail"
+
+	// PhasorDetailTable defines the name of the standard STTP phasor metadata table.
+	PhasorDetailTable = "PhasorDetail"
+
+	// SchemaVersionTable defines the name of the standard STTP metadata schema version table.
+	SchemaVersionTable = "SchemaVersion"
+)
+
+// Model represents the standard STTP metadata tables as Go structs with the relationships
+// between devices, measurements and phasors resolved. Lookups by acronym, point tag and
+// signal reference are case-insensitive. A Model is read-only once created.
+type Model struct {
+	// SchemaVersion defines the metadata schema version number, 0 when undefined.
+	SchemaVersion int32
+
+	// Devices defines the records of the DeviceDetail table.
+	Devices []*DeviceDetail
+
+	// Measurements defines the records of the MeasurementDetail table.
+	Measurements []*MeasurementDetail
+
+	// Phasors defines the records of the PhasorDetail table.
+	Phasors []*PhasorDetail
+
+	devicesByAcronym           map[string]*DeviceDetail
+	measurementsBySignalID     map[guid.Guid]*MeasurementDetail
+	measurementsByPointTag     map[string]*MeasurementDetail
+	measurementsBySignalRef    map[string]*MeasurementDetail
+	phasorsByDeviceSourceIndex map[phasorKey]*PhasorDetail
+}
+
+type phasorKey struct {
+	deviceAcronym string
+	sourceIndex   int32
+}
+
+// NewModel creates a new Model from the standard STTP metadata tables in the specified DataSet.
+// Tables that do not exist in the DataSet are treated as empty; columns that do not exist in a
+// table leave the associated struct fields at their zero values. An error is returned if a table
+// column cannot be converted to its associated struct field type.
+func NewModel(dataSet *data.DataSet) (*Model, error) {
+	if dataSet == nil {
+		return nil, errors.New("cannot create metadata model, DataSet is nil")
+	}
+
+	model := &Model{
+		Devices:      make([]*DeviceDetail, 0),
+		Measurements: make([]*MeasurementDetail, 0),
+		Phasors:      make([]*PhasorDetail, 0),
+	}
+
+	if err := scanTable(dataSet, DeviceDetailTable, &model.Devices); err != nil {
+		return nil, err
+	}
+
+	if err := scanTable(dataSet, MeasurementDetailTable, &model.Measurements); err != nil {
+		return nil, err
+	}
+
+	if err := scanTable(dataSet, PhasorDetailTable, &model.Phasors); err != nil {
+		return nil, err
+	}
+
+	if schemaVersion := dataSet.Table(SchemaVersionTable); schemaVersion != nil && schemaVersion.RowCount() > 0 {
+		var version struct {
+			VersionNumber int32
+		}
+
+		if err := data.Unmarshal(schemaVersion.Row(0), &version); err != nil {
+			return nil, err
+		}
+
+		model.SchemaVersion = version.VersionNumber
+	}
+
+	model.resolve()
+
+	return model, nil
+}
+
+func scanTable(dataSet *data.DataSet, tableName string, target interface{}) error {
+	table := dataSet.Table(tableName)
+
+	if table == nil {
+		return nil
+	}
+
+	return table.ScanAll(target)
+}
+
+//gocyclo:ignore
+func (m *Model) resolve() {
+	m.devicesByAcronym = make(map[string]*DeviceDetail, len(m.Devices))
+	m.measurementsBySignalID = make(map[guid.Guid]*MeasurementDetail, len(m.Measurements))
+	m.measurementsByPointTag = make(map[string]*MeasurementDetail, len(m.Measurements))
+	m.measurementsBySignalRef = make(map[string]*MeasurementDetail, len(m.Measurements))
+	m.phasorsByDeviceSourceIndex = make(map[phasorKey]*PhasorDetail, len(m.Phasors))
+
+	for _, device := range m.Devices {
+		m.devicesByAcronym[strings.ToUpper(device.Acronym)] = device
+	}
+
+	for _, device := range m.Devices {
+		if len(device.ParentAcronym) > 0 {
+			device.Parent = m.Device(device.ParentAcronym)
+		}
+	}
+
+	for _, phasor := range m.Phasors {
+		m.phasorsByDeviceSourceIndex[phasorKey{strings.ToUpper(phasor.DeviceAcronym), phasor.SourceIndex}] = phasor
+
+		if phasor.Device = m.Device(phasor.DeviceAcronym); phasor.Device != nil {
+			phasor.Device.Phasors = append(phasor.Device.Phasors, phasor)
+		}
+	}
+
+	for _, measurement := range m.Measurements {
+		m.measurementsBySignalID[measurement.SignalID] = measurement
+
+		if len(measurement.PointTag) > 0 {
+			m.measurementsByPointTag[strings.ToUpper(measurement.PointTag)] = measurement
+		}
+
+		if len(measurement.SignalReference) > 0 {
+			m.measurementsBySignalRef[strings.ToUpper(measurement.SignalReference)] = measurement
+		}
+
+		if measurement.Device = m.Device(measurement.DeviceAcronym); measurement.Device != nil {
+			measurement.Device.Measurements = append(measurement.Device.Measurements, measurement)
+		}
+
+		// Pair phasor angle and magnitude measurements using signal reference, e.g., SHELBY-PA1,
+		// falling back on device acronym and phasor source index when reference is not parsable
+		source, signalKind, position := measurement.ParseSignalReference()
+
+		if signalKind != transport.SignalKind.Angle && signalKind != transport.SignalKind.Magnitude {
+			continue
+		}
+
+		phasor := m.Phasor(source, int32(position))
+
+		if phasor == nil && measurement.PhasorSourceIndex != nil {
+			phasor = m.Phasor(measurement.DeviceAcronym, *measurement.PhasorSourceIndex)
+		}
+
+		if phasor == nil {
+			continue
+		}
+
+		measurement.Phasor = phasor
+
+		if signalKind == transport.SignalKind.Angle {
+			phasor.Angle = measurement
+		} else {
+			phasor.Magnitude = measurement
+		}
+	}
+}
+
+// Device gets the DeviceDetail for the specified acronym; otherwise, nil is returned.
+func (m *Model) Device(acronym string) *DeviceDetail {
+	return m.devicesByAcronym[strings.ToUpper(acronym)]
+}
+
+// Measurement gets the MeasurementDetail for the specified signalID; otherwise, nil is returned.
+func (m *Model) Measurement(signalID guid.Guid) *MeasurementDetail {
+	return m.measurementsBySignalID[signalID]
+}
+
+// MeasurementByPointTag gets the MeasurementDetail for the specified pointTag; otherwise, nil is returned.
+func (m *Model) MeasurementByPointTag(pointTag string) *MeasurementDetail {
+	return m.measurementsByPointTag[strings.ToUpper(pointTag)]
+}
+
+// MeasurementBySignalReference gets the MeasurementDetail for the specified signalReference;
+// otherwise, nil is returned.
+func (m *Model) MeasurementBySignalReference(signalReference string) *MeasurementDetail {
+	return m.measurementsBySignalRef[strings.ToUpper(signalReference)]
+}
+
+// Phasor gets the PhasorDetail for the specified device acronym and one-based phasor source index;
+// otherwise, nil is returned.
+func (m *Model) Phasor(deviceAcronym string, sourceIndex int32) *PhasorDetail {
+	return m.phasorsByDeviceSourceIndex[phasorKey{strings.ToUpper(deviceAcronym), sourceIndex}]
+}
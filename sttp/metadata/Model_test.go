@@ -0,0 +1,119 @@
+//******************************************************************************************************
+//  Model_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+)
+
+func loadModel(t *testing.T) (*data.DataSet, *Model) {
+	buffer, err := os.ReadFile("../../test/MetadataSample1.xml")
+
+	if err != nil {
+		t.Fatal("failed to read metadata sample: " + err.Error())
+	}
+
+	dataSet := data.FromXml(buffer)
+	model, err := NewModel(dataSet)
+
+	if err != nil {
+		t.Fatal("failed to create metadata model: " + err.Error())
+	}
+
+	return dataSet, model
+}
+
+func TestNewModel(t *testing.T) {
+	dataSet, model := loadModel(t)
+
+	if model.SchemaVersion != 9 {
+		t.Fatal("TestNewModel: expected schema version of 9, received: " + strconv.Itoa(int(model.SchemaVersion)))
+	}
+
+	if len(model.Measurements) != dataSet.Table(MeasurementDetailTable).RowCount() {
+		t.Fatal("TestNewModel: unexpected measurement count: " + strconv.Itoa(len(model.Measurements)))
+	}
+
+	if len(model.Phasors) != dataSet.Table(PhasorDetailTable).RowCount() {
+		t.Fatal("TestNewModel: unexpected phasor count: " + strconv.Itoa(len(model.Phasors)))
+	}
+
+	measurement := model.MeasurementBySignalReference("shelby-pa1")
+
+	if measurement == nil {
+		t.Fatal("TestNewModel: failed to lookup measurement by signal reference")
+	}
+
+	if model.Measurement(measurement.SignalID) != measurement || model.MeasurementByPointTag(measurement.PointTag) != measurement {
+		t.Fatal("TestNewModel: measurement lookups do not match")
+	}
+
+	if measurement.Device == nil || measurement.Device.Acronym != "SHELBY" {
+		t.Fatal("TestNewModel: expected measurement to resolve SHELBY device")
+	}
+
+	if source, id, ok := measurement.Key(); !ok || source != "PPA" || id != 6 {
+		t.Fatal("TestNewModel: unexpected measurement key: " + measurement.ID)
+	}
+}
+
+func TestModelPhasorPairing(t *testing.T) {
+	_, model := loadModel(t)
+
+	for _, phasor := range model.Phasors {
+		if phasor.Angle == nil || phasor.Magnitude == nil {
+			t.Fatal("TestModelPhasorPairing: expected phasor " + phasor.Label + " to resolve angle and magnitude measurements")
+		}
+
+		if phasor.Angle.Phasor != phasor || phasor.Magnitude.Phasor != phasor {
+			t.Fatal("TestModelPhasorPairing: expected measurements to reference phasor " + phasor.Label)
+		}
+
+		if phasor.Device == nil || phasor.Device.Acronym != phasor.DeviceAcronym {
+			t.Fatal("TestModelPhasorPairing: expected phasor " + phasor.Label + " to resolve device")
+		}
+	}
+
+	phasor := model.Phasor("SHELBY", 1)
+
+	if phasor == nil || phasor.Angle.SignalReference != "SHELBY-PA1" || phasor.Magnitude.SignalReference != "SHELBY-PM1" {
+		t.Fatal("TestModelPhasorPairing: unexpected phasor for SHELBY source index 1")
+	}
+}
+
+func TestNewModelEmptyDataSet(t *testing.T) {
+	model, err := NewModel(data.NewDataSet())
+
+	if err != nil {
+		t.Fatal("TestNewModelEmptyDataSet: unexpected error: " + err.Error())
+	}
+
+	if len(model.Devices) != 0 || len(model.Measurements) != 0 || model.SchemaVersion != 0 {
+		t.Fatal("TestNewModelEmptyDataSet: expected empty model")
+	}
+}
@@ -0,0 +1,77 @@
+//******************************************************************************************************
+//  PhasorDetail.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"time"
+)
+
+// PhasorDetail defines a record from the standard STTP PhasorDetail metadata table,
+// i.e., a voltage or current phasor defined by a device. The phasor magnitude and angle
+// are each received as separate measurements.
+type PhasorDetail struct {
+	// ID defines the identifier of the phasor.
+	ID int32 `sttp:"ID"`
+
+	// DeviceAcronym defines the acronym of the device that defines the phasor.
+	DeviceAcronym string `sttp:"DeviceAcronym"`
+
+	// Label defines the free-form label of the phasor.
+	Label string `sttp:"Label"`
+
+	// Type defines the phasor type, i.e., "V" for voltage or "I" for current.
+	Type string `sttp:"Type"`
+
+	// Phase defines the phasor phase, e.g., "+" for positive sequence or "A" for phase A.
+	Phase string `sttp:"Phase"`
+
+	// DestinationPhasorID defines the identifier of the associated voltage phasor for a
+	// current phasor, nil when undefined.
+	DestinationPhasorID *int32 `sttp:"DestinationPhasorID"`
+
+	// SourceIndex defines the one-based index of the phasor within the device.
+	SourceIndex int32 `sttp:"SourceIndex"`
+
+	// UpdatedOn defines the timestamp of when the phasor record was last updated.
+	UpdatedOn time.Time `sttp:"UpdatedOn"`
+
+	// Device defines the resolved DeviceDetail for DeviceAcronym, if any.
+	Device *DeviceDetail `sttp:"-"`
+
+	// Angle defines the resolved phase angle measurement of the phasor, if any.
+	Angle *MeasurementDetail `sttp:"-"`
+
+	// Magnitude defines the resolved magnitude measurement of the phasor, if any.
+	Magnitude *MeasurementDetail `sttp:"-"`
+}
+
+// IsVoltage determines if the phasor represents a voltage.
+func (pd *PhasorDetail) IsVoltage() bool {
+	return pd.Type == "V" || pd.Type == "v"
+}
+
+// IsCurrent determines if the phasor represents a current.
+func (pd *PhasorDetail) IsCurrent() bool {
+	return pd.Type == "I" || pd.Type == "i"
+}
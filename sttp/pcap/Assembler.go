@@ -0,0 +1,249 @@
+//******************************************************************************************************
+//  Assembler.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package pcap
+
+import (
+	"net/netip"
+	"sort"
+	"time"
+)
+
+// Flow defines one direction of a TCP connection.
+type Flow struct {
+	// Source defines the address and port of the sender.
+	Source netip.AddrPort
+	// Destination defines the address and port of the receiver.
+	Destination netip.AddrPort
+}
+
+// Reverse gets the flow for the opposite direction of the connection.
+func (f Flow) Reverse() Flow {
+	return Flow{Source: f.Destination, Destination: f.Source}
+}
+
+// String gets the flow as a string.
+func (f Flow) String() string {
+	return f.Source.String() + " -> " + f.Destination.String()
+}
+
+// DefaultMaxPendingSegments defines the default maximum number of out-of-order segments buffered per flow.
+const DefaultMaxPendingSegments = 1024
+
+type pendingSegment struct {
+	sequence  uint32
+	timestamp time.Time
+	payload   []byte
+}
+
+type stream struct {
+	initialized bool
+	next        uint32
+	pending     []pendingSegment
+}
+
+// Assembler reassembles the payload data of captured TCP segments into ordered streams, one per Flow.
+// Retransmitted and overlapping data is trimmed and out-of-order segments are buffered until the missing
+// data arrives. When data was never captured, the gap is skipped once too many segments are pending
+// or when Flush is called.
+type Assembler struct {
+	streams map[Flow]*stream
+
+	// DataCallback is called with the in-order payload data of a flow.
+	DataCallback func(flow Flow, timestamp time.Time, data []byte)
+
+	// GapCallback is called when data missing from the capture is skipped with the number of bytes skipped.
+	// Size is zero when a flow is first seen after its connection was established, i.e., the capture
+	// started mid-stream, so that data starts at an arbitrary position in the stream.
+	GapCallback func(flow Flow, timestamp time.Time, size int)
+
+	// ResetCallback is called when a new connection starts on a flow or a connection is reset.
+	ResetCallback func(flow Flow, timestamp time.Time)
+
+	// MaxPendingSegments defines the maximum number of out-of-order segments buffered per flow
+	// before missing data is skipped.
+	MaxPendingSegments int
+}
+
+// NewAssembler creates a new TCP stream Assembler.
+func NewAssembler() *Assembler {
+	return &Assembler{
+		streams:            make(map[Flow]*stream),
+		MaxPendingSegments: DefaultMaxPendingSegments,
+	}
+}
+
+// AddSegment adds a captured TCP segment to the assembler. Segments that are not TCP are ignored.
+func (a *Assembler) AddSegment(segment *Segment) {
+	if segment.Protocol != Protocol.TCP {
+		return
+	}
+
+	flow := Flow{Source: segment.Source, Destination: segment.Destination}
+	st := a.streams[flow]
+
+	if st == nil {
+		st = &stream{}
+		a.streams[flow] = st
+	}
+
+	if segment.Flags&TCPFlags.RST != 0 {
+		delete(a.streams, flow)
+		a.reset(flow, segment.Timestamp)
+		return
+	}
+
+	if segment.Flags&TCPFlags.SYN != 0 {
+		if st.initialized {
+			a.reset(flow, segment.Timestamp)
+		}
+
+		st.initialized = true
+		st.next = segment.Sequence + 1
+		st.pending = nil
+		return
+	}
+
+	payload := segment.Payload
+
+	if len(payload) == 0 {
+		return
+	}
+
+	if !st.initialized {
+		st.initialized = true
+		st.next = segment.Sequence
+
+		if a.GapCallback != nil {
+			a.GapCallback(flow, segment.Timestamp, 0)
+		}
+	}
+
+	if int32(segment.Sequence-st.next) > 0 {
+		// Copy payload since packet data may be reused by caller
+		st.pending = append(st.pending, pendingSegment{
+			sequence:  segment.Sequence,
+			timestamp: segment.Timestamp,
+			payload:   append([]byte(nil), payload...),
+		})
+
+		if a.MaxPendingSegments > 0 && len(st.pending) > a.MaxPendingSegments {
+			a.skipGap(flow, st)
+		}
+
+		return
+	}
+
+	a.deliver(flow, st, segment.Sequence, segment.Timestamp, payload)
+	a.drain(flow, st)
+}
+
+// Flush delivers all buffered out-of-order data, skipping any data missing from the capture.
+// Call Flush after the last segment of a capture has been added.
+func (a *Assembler) Flush() {
+	flows := make([]Flow, 0, len(a.streams))
+
+	for flow := range a.streams {
+		flows = append(flows, flow)
+	}
+
+	sort.Slice(flows, func(i, j int) bool {
+		return flows[i].String() < flows[j].String()
+	})
+
+	for _, flow := range flows {
+		st := a.streams[flow]
+
+		for len(st.pending) > 0 {
+			a.skipGap(flow, st)
+		}
+	}
+}
+
+func (a *Assembler) reset(flow Flow, timestamp time.Time) {
+	if a.ResetCallback != nil {
+		a.ResetCallback(flow, timestamp)
+	}
+}
+
+// deliver trims data already delivered from the payload and passes the remainder to the data callback.
+func (a *Assembler) deliver(flow Flow, st *stream, sequence uint32, timestamp time.Time, payload []byte) {
+	if overlap := int(int32(st.next - sequence)); overlap > 0 {
+		if overlap >= len(payload) {
+			return
+		}
+
+		payload = payload[overlap:]
+	}
+
+	st.next += uint32(len(payload))
+
+	if a.DataCallback != nil {
+		a.DataCallback(flow, timestamp, payload)
+	}
+}
+
+// drain delivers pending segments that are now in order.
+func (a *Assembler) drain(flow Flow, st *stream) {
+	for {
+		index := -1
+
+		for i, segment := range st.pending {
+			if int32(segment.sequence-st.next) <= 0 {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			return
+		}
+
+		segment := st.pending[index]
+		st.pending = append(st.pending[:index], st.pending[index+1:]...)
+		a.deliver(flow, st, segment.sequence, segment.timestamp, segment.payload)
+	}
+}
+
+// skipGap skips missing data up to the earliest pending segment.
+func (a *Assembler) skipGap(flow Flow, st *stream) {
+	earliest := 0
+
+	for i, segment := range st.pending {
+		if int32(segment.sequence-st.pending[earliest].sequence) < 0 {
+			earliest = i
+		}
+	}
+
+	segment := st.pending[earliest]
+
+	if size := int(int32(segment.sequence - st.next)); size > 0 {
+		st.next = segment.sequence
+
+		if a.GapCallback != nil {
+			a.GapCallback(flow, segment.timestamp, size)
+		}
+	}
+
+	a.drain(flow, st)
+}
@@ -0,0 +1,332 @@
+//******************************************************************************************************
+//  Pcap_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var (
+	testClient = netip.MustParseAddrPort("192.168.1.10:50000")
+	testServer = netip.MustParseAddrPort("192.168.1.20:7165")
+)
+
+// tcpPacket builds an Ethernet frame carrying an IPv4 TCP segment.
+func tcpPacket(source, destination netip.AddrPort, sequence uint32, flags TCPFlagsEnum, payload []byte) []byte {
+	tcp := make([]byte, 20, 20+len(payload))
+	binary.BigEndian.PutUint16(tcp, source.Port())
+	binary.BigEndian.PutUint16(tcp[2:], destination.Port())
+	binary.BigEndian.PutUint32(tcp[4:], sequence)
+	tcp[12] = 5 << 4
+	tcp[13] = byte(flags)
+	tcp = append(tcp, payload...)
+
+	ip := make([]byte, 20, 20+len(tcp))
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+len(tcp)))
+	ip[8] = 64
+	ip[9] = byte(Protocol.TCP)
+	copy(ip[12:], source.Addr().AsSlice())
+	copy(ip[16:], destination.Addr().AsSlice())
+	ip = append(ip, tcp...)
+
+	frame := make([]byte, 14, 14+len(ip))
+	binary.BigEndian.PutUint16(frame[12:], etherTypeIPv4)
+	frame = append(frame, ip...)
+
+	// Minimum Ethernet frame size padding must be ignored by decoder
+	for len(frame) < 60 {
+		frame = append(frame, 0)
+	}
+
+	return frame
+}
+
+// udpPacket builds a raw IPv6 packet carrying a UDP datagram.
+func udpPacket(source, destination netip.AddrPort, payload []byte) []byte {
+	packet := make([]byte, 48, 48+len(payload))
+	packet[0] = 0x60
+	binary.BigEndian.PutUint16(packet[4:], uint16(8+len(payload)))
+	packet[6] = byte(Protocol.UDP)
+	copy(packet[8:], source.Addr().AsSlice())
+	copy(packet[24:], destination.Addr().AsSlice())
+	binary.BigEndian.PutUint16(packet[40:], source.Port())
+	binary.BigEndian.PutUint16(packet[42:], destination.Port())
+	binary.BigEndian.PutUint16(packet[44:], uint16(8+len(payload)))
+	return append(packet, payload...)
+}
+
+func writePcap(byteOrder binary.ByteOrder, nanoseconds bool, linkType LinkTypeEnum, timestamps []time.Time, packets [][]byte) []byte {
+	var buffer bytes.Buffer
+
+	header := make([]byte, 24)
+	magic := uint32(pcapMagicMicroseconds)
+
+	if nanoseconds {
+		magic = pcapMagicNanoseconds
+	}
+
+	byteOrder.PutUint32(header, magic)
+	byteOrder.PutUint16(header[4:], 2)
+	byteOrder.PutUint16(header[6:], 4)
+	byteOrder.PutUint32(header[16:], 65535)
+	byteOrder.PutUint32(header[20:], uint32(linkType))
+	buffer.Write(header)
+
+	for i, packet := range packets {
+		record := make([]byte, 16)
+		fraction := timestamps[i].Nanosecond()
+
+		if !nanoseconds {
+			fraction /= 1000
+		}
+
+		byteOrder.PutUint32(record, uint32(timestamps[i].Unix()))
+		byteOrder.PutUint32(record[4:], uint32(fraction))
+		byteOrder.PutUint32(record[8:], uint32(len(packet)))
+		byteOrder.PutUint32(record[12:], uint32(len(packet)))
+		buffer.Write(record)
+		buffer.Write(packet)
+	}
+
+	return buffer.Bytes()
+}
+
+func pcapngBlock(blockType uint32, body []byte) []byte {
+	for len(body)%4 != 0 {
+		body = append(body, 0)
+	}
+
+	block := make([]byte, 8, 12+len(body))
+	binary.LittleEndian.PutUint32(block, blockType)
+	binary.LittleEndian.PutUint32(block[4:], uint32(12+len(body)))
+	block = append(block, body...)
+	return binary.LittleEndian.AppendUint32(block, uint32(12+len(body)))
+}
+
+func writePcapng(timestamps []time.Time, packets [][]byte) []byte {
+	var buffer bytes.Buffer
+
+	section := make([]byte, 16)
+	binary.LittleEndian.PutUint32(section, pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(section[4:], 1)
+	binary.LittleEndian.PutUint64(section[8:], ^uint64(0))
+	buffer.Write(pcapngBlock(pcapngSectionHeader, section))
+
+	// Interface 0 is Ethernet with default microsecond resolution, interface 1 is raw IP with nanosecond resolution
+	buffer.Write(pcapngBlock(pcapngInterfaceDescription, []byte{1, 0, 0, 0, 0, 0, 1, 0}))
+	buffer.Write(pcapngBlock(pcapngInterfaceDescription, []byte{101, 0, 0, 0, 0, 0, 1, 0, 9, 0, 1, 0, 9, 0, 0, 0, 0, 0, 0, 0}))
+
+	// Unknown blocks are skipped
+	buffer.Write(pcapngBlock(0x00000005, make([]byte, 8)))
+
+	for i, packet := range packets {
+		interfaceID := uint32(0)
+		value := uint64(timestamps[i].UnixMicro())
+
+		if packet[0]>>4 == 6 {
+			interfaceID = 1
+			value = uint64(timestamps[i].UnixNano())
+		}
+
+		body := make([]byte, 20, 20+len(packet))
+		binary.LittleEndian.PutUint32(body, interfaceID)
+		binary.LittleEndian.PutUint32(body[4:], uint32(value>>32))
+		binary.LittleEndian.PutUint32(body[8:], uint32(value))
+		binary.LittleEndian.PutUint32(body[12:], uint32(len(packet)))
+		binary.LittleEndian.PutUint32(body[16:], uint32(len(packet)))
+		buffer.Write(pcapngBlock(pcapngEnhancedPacket, append(body, packet...)))
+	}
+
+	return buffer.Bytes()
+}
+
+func readSegments(t *testing.T, name string, capture []byte) []*Segment {
+	reader, err := NewReader(bytes.NewReader(capture))
+
+	if err != nil {
+		t.Fatal(name + ": failed to open capture: " + err.Error())
+	}
+
+	var segments []*Segment
+
+	for {
+		packet, err := reader.ReadPacket()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(name + ": failed to read packet: " + err.Error())
+		}
+
+		segment, err := packet.Decode()
+
+		if err != nil {
+			t.Fatal(name + ": failed to decode packet: " + err.Error())
+		}
+
+		segment.Timestamp = packet.Timestamp
+		segments = append(segments, segment)
+	}
+
+	return segments
+}
+
+func TestReaderFormats(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC)
+	timestamps := []time.Time{start, start.Add(time.Millisecond)}
+
+	ethernet := [][]byte{
+		tcpPacket(testClient, testServer, 1000, TCPFlags.PSH|TCPFlags.ACK, []byte("hello")),
+		tcpPacket(testServer, testClient, 5000, TCPFlags.ACK, []byte("world!")),
+	}
+
+	for _, test := range []struct {
+		name    string
+		capture []byte
+	}{
+		{"pcap-le-us", writePcap(binary.LittleEndian, false, LinkType.Ethernet, timestamps, ethernet)},
+		{"pcap-be-ns", writePcap(binary.BigEndian, true, LinkType.Ethernet, timestamps, ethernet)},
+		{"pcapng", writePcapng(timestamps, ethernet)},
+	} {
+		segments := readSegments(t, "TestReaderFormats", test.capture)
+
+		if len(segments) != 2 {
+			t.Fatal("TestReaderFormats: " + test.name + ": expected 2 segments, got " + strconv.Itoa(len(segments)))
+		}
+
+		for i, segment := range segments {
+			if !segment.Timestamp.Equal(timestamps[i]) {
+				t.Fatal("TestReaderFormats: " + test.name + ": unexpected timestamp " + segment.Timestamp.String())
+			}
+		}
+
+		if segments[0].Source != testClient || segments[0].Destination != testServer || segments[0].Sequence != 1000 ||
+			segments[0].Flags != TCPFlags.PSH|TCPFlags.ACK || string(segments[0].Payload) != "hello" {
+			t.Fatal("TestReaderFormats: " + test.name + ": unexpected first segment")
+		}
+
+		if segments[1].Source != testServer || string(segments[1].Payload) != "world!" {
+			t.Fatal("TestReaderFormats: " + test.name + ": unexpected second segment")
+		}
+	}
+
+	source := netip.MustParseAddrPort("[fe80::1]:6165")
+	destination := netip.MustParseAddrPort("[fe80::2]:9600")
+	segments := readSegments(t, "TestReaderFormats", writePcapng([]time.Time{start.Add(789)}, [][]byte{udpPacket(source, destination, []byte{1, 2, 3})}))
+
+	if len(segments) != 1 || segments[0].Protocol != Protocol.UDP || segments[0].Source != source ||
+		segments[0].Destination != destination || !bytes.Equal(segments[0].Payload, []byte{1, 2, 3}) {
+		t.Fatal("TestReaderFormats: unexpected IPv6 UDP segment")
+	}
+
+	if !segments[0].Timestamp.Equal(start.Add(789)) {
+		t.Fatal("TestReaderFormats: unexpected nanosecond timestamp " + segments[0].Timestamp.String())
+	}
+
+	if _, err := NewReader(bytes.NewReader(make([]byte, 24))); err == nil {
+		t.Fatal("TestReaderFormats: expected error for unrecognized capture format")
+	}
+}
+
+func TestAssembler(t *testing.T) {
+	var received bytes.Buffer
+	var gaps []int
+
+	assembler := NewAssembler()
+
+	assembler.DataCallback = func(flow Flow, _ time.Time, data []byte) {
+		if flow.Source != testClient {
+			t.Fatal("TestAssembler: unexpected flow " + flow.String())
+		}
+
+		received.Write(data)
+	}
+
+	assembler.GapCallback = func(_ Flow, _ time.Time, size int) {
+		gaps = append(gaps, size)
+	}
+
+	add := func(sequence uint32, flags TCPFlagsEnum, payload string) {
+		assembler.AddSegment(&Segment{
+			Protocol:    Protocol.TCP,
+			Source:      testClient,
+			Destination: testServer,
+			Sequence:    sequence,
+			Flags:       flags,
+			Payload:     []byte(payload),
+		})
+	}
+
+	// Sequence numbers start near wrap-around
+	var isn uint32 = 0xFFFFFFF0
+
+	add(isn, TCPFlags.SYN, "")
+	add(isn+1, TCPFlags.ACK, "abcd")
+	add(isn+9, TCPFlags.ACK, "ijkl")    // Out of order
+	add(isn+5, TCPFlags.ACK, "efgh")    // Fills hole, crosses wrap-around
+	add(isn+3, TCPFlags.ACK, "cdefgh")  // Retransmission
+	add(isn+11, TCPFlags.ACK, "klmnop") // Overlapping retransmission
+	add(isn+21, TCPFlags.ACK, "uvwx")   // After missing data
+	add(isn+25, TCPFlags.FIN, "yz")
+
+	if received.String() != "abcdefghijklmnop" {
+		t.Fatal("TestAssembler: unexpected data before flush: " + received.String())
+	}
+
+	assembler.Flush()
+
+	if received.String() != "abcdefghijklmnopuvwxyz" {
+		t.Fatal("TestAssembler: unexpected data after flush: " + received.String())
+	}
+
+	if len(gaps) != 1 || gaps[0] != 4 {
+		t.Fatal("TestAssembler: expected single gap of 4 bytes")
+	}
+
+	// Stream picked up mid-stream reports zero size gap
+	received.Reset()
+	gaps = nil
+
+	assembler = NewAssembler()
+	assembler.MaxPendingSegments = 1
+	assembler.DataCallback = func(_ Flow, _ time.Time, data []byte) { received.Write(data) }
+	assembler.GapCallback = func(_ Flow, _ time.Time, size int) { gaps = append(gaps, size) }
+
+	add(100, TCPFlags.ACK, "12")
+	add(110, TCPFlags.ACK, "56")
+	add(120, TCPFlags.ACK, "78")
+
+	if received.String() != "1256" || len(gaps) != 2 || gaps[0] != 0 || gaps[1] != 8 {
+		t.Fatal("TestAssembler: unexpected mid-stream data " + received.String())
+	}
+}
@@ -0,0 +1,421 @@
+//******************************************************************************************************
+//  Reader.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// Package pcap reads network packet captures in the pcap and pcapng file formats, decodes TCP and UDP
+// segments from captured IPv4 and IPv6 packets and reassembles TCP streams. The package has no external
+// dependencies and is used to decode STTP sessions from captures taken when diagnosing field issues.
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+)
+
+// LinkTypeEnum defines the type of the LinkType enumeration.
+type LinkTypeEnum uint16
+
+// LinkType is an enumeration of the supported link-layer header types of captured packets.
+var LinkType = struct {
+	// Null defines BSD loopback encapsulation with a host byte order protocol family header.
+	Null LinkTypeEnum
+	// Ethernet defines IEEE 802.3 Ethernet, including 802.1Q VLAN tagged frames.
+	Ethernet LinkTypeEnum
+	// Raw defines raw IPv4 or IPv6 packets with no link-layer header.
+	Raw LinkTypeEnum
+	// Loop defines OpenBSD loopback encapsulation with a network byte order protocol family header.
+	Loop LinkTypeEnum
+	// LinuxSLL defines Linux "cooked" capture encapsulation, e.g., captures on the "any" interface.
+	LinuxSLL LinkTypeEnum
+	// IPv4 defines raw IPv4 packets with no link-layer header.
+	IPv4 LinkTypeEnum
+	// IPv6 defines raw IPv6 packets with no link-layer header.
+	IPv6 LinkTypeEnum
+	// LinuxSLL2 defines Linux "cooked" capture encapsulation version 2.
+	LinuxSLL2 LinkTypeEnum
+}{
+	Null:      0,
+	Ethernet:  1,
+	Raw:       101,
+	Loop:      108,
+	LinuxSLL:  113,
+	IPv4:      228,
+	IPv6:      229,
+	LinuxSLL2: 276,
+}
+
+// String gets the LinkType enumeration value as a string.
+func (lte LinkTypeEnum) String() string {
+	switch lte {
+	case LinkType.Null:
+		return "Null"
+	case LinkType.Ethernet:
+		return "Ethernet"
+	case LinkType.Raw:
+		return "Raw"
+	case LinkType.Loop:
+		return "Loop"
+	case LinkType.LinuxSLL:
+		return "LinuxSLL"
+	case LinkType.IPv4:
+		return "IPv4"
+	case LinkType.IPv6:
+		return "IPv6"
+	case LinkType.LinuxSLL2:
+		return "LinuxSLL2"
+	default:
+		return "0x" + strconv.FormatInt(int64(lte), 16)
+	}
+}
+
+// Packet defines a captured network packet.
+type Packet struct {
+	// Timestamp defines the time the packet was captured.
+	Timestamp time.Time
+
+	// LinkType defines the link-layer header type of the packet data.
+	LinkType LinkTypeEnum
+
+	// Data defines the captured packet data, starting with the link-layer header.
+	Data []byte
+
+	// Length defines the original length of the packet, which is greater than the length
+	// of Data when the packet was truncated by the capture.
+	Length int
+}
+
+const (
+	pcapMagicMicroseconds = 0xA1B2C3D4
+	pcapMagicNanoseconds  = 0xA1B23C4D
+	pcapngSectionHeader   = 0x0A0D0D0A
+	pcapngByteOrderMagic  = 0x1A2B3C4D
+
+	pcapngInterfaceDescription = 0x00000001
+	pcapngObsoletePacket       = 0x00000002
+	pcapngSimplePacket         = 0x00000003
+	pcapngEnhancedPacket       = 0x00000006
+
+	// maxBlockSize defines the maximum size of a packet record or block accepted from a capture
+	// so that a corrupt length does not cause a huge allocation.
+	maxBlockSize = 1 << 24
+)
+
+// pcapngInterface defines the properties of a pcapng capture interface.
+type pcapngInterface struct {
+	linkType LinkTypeEnum
+	units    uint64 // Timestamp units per second
+	offset   int64  // Timestamp offset in seconds
+}
+
+// Reader reads packets from a pcap or pcapng capture file.
+type Reader struct {
+	reader     *bufio.Reader
+	byteOrder  binary.ByteOrder
+	pcapng     bool
+	linkType   LinkTypeEnum
+	units      uint64
+	interfaces []pcapngInterface
+}
+
+// NewReader creates a new Reader for the pcap or pcapng capture read from reader. The capture format and
+// byte order are detected from the file header; an error is returned when the header is not recognized.
+func NewReader(reader io.Reader) (*Reader, error) {
+	pr := &Reader{reader: bufio.NewReaderSize(reader, 65536)}
+	header, err := pr.reader.Peek(4)
+
+	if err != nil {
+		return nil, errors.New("failed to read capture file header: " + err.Error())
+	}
+
+	// Section header block type is a palindrome, so byte order is not needed to detect pcapng
+	if binary.LittleEndian.Uint32(header) == pcapngSectionHeader {
+		pr.pcapng = true
+
+		if err := pr.readSectionHeader(); err != nil {
+			return nil, err
+		}
+
+		return pr, nil
+	}
+
+	if header, err = pr.read(24); err != nil {
+		return nil, errors.New("failed to read pcap file header: " + err.Error())
+	}
+
+	for _, byteOrder := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		switch byteOrder.Uint32(header) {
+		case pcapMagicMicroseconds:
+			pr.units = 1000000
+		case pcapMagicNanoseconds:
+			pr.units = 1000000000
+		default:
+			continue
+		}
+
+		pr.byteOrder = byteOrder
+		pr.linkType = LinkTypeEnum(byteOrder.Uint32(header[20:]))
+		return pr, nil
+	}
+
+	return nil, errors.New("file is not a pcap or pcapng capture")
+}
+
+func (pr *Reader) read(length int) ([]byte, error) {
+	buffer := make([]byte, length)
+
+	if _, err := io.ReadFull(pr.reader, buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer, nil
+}
+
+// ReadPacket reads the next packet from the capture. Returns io.EOF when there are no more packets.
+// Blocks of a pcapng capture that do not contain packets are skipped.
+func (pr *Reader) ReadPacket() (*Packet, error) {
+	if pr.pcapng {
+		return pr.readBlockPacket()
+	}
+
+	header, err := pr.read(16)
+
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.New("truncated pcap packet record header")
+		}
+
+		return nil, err
+	}
+
+	capturedLength := pr.byteOrder.Uint32(header[8:])
+
+	if capturedLength > maxBlockSize {
+		return nil, errors.New("invalid pcap packet record length " + strconv.FormatUint(uint64(capturedLength), 10))
+	}
+
+	data, err := pr.read(int(capturedLength))
+
+	if err != nil {
+		return nil, errors.New("truncated pcap packet record")
+	}
+
+	return &Packet{
+		Timestamp: timestamp(uint64(pr.byteOrder.Uint32(header))*pr.units+uint64(pr.byteOrder.Uint32(header[4:])), pr.units, 0),
+		LinkType:  pr.linkType,
+		Data:      data,
+		Length:    int(pr.byteOrder.Uint32(header[12:])),
+	}, nil
+}
+
+// timestamp converts a count of timestamp units, with the specified units per second, to a time.
+func timestamp(value uint64, units uint64, offset int64) time.Time {
+	seconds := value / units
+	fraction := value % units
+
+	var nanoseconds uint64
+
+	if units <= 1000000000 {
+		nanoseconds = fraction * (1000000000 / units)
+	} else {
+		nanoseconds = fraction / (units / 1000000000)
+	}
+
+	return time.Unix(int64(seconds)+offset, int64(nanoseconds)).UTC()
+}
+
+// readSectionHeader reads a pcapng section header block, which defines the byte order of the section.
+func (pr *Reader) readSectionHeader() error {
+	header, err := pr.read(12)
+
+	if err != nil {
+		return errors.New("failed to read pcapng section header: " + err.Error())
+	}
+
+	switch {
+	case binary.LittleEndian.Uint32(header[8:]) == pcapngByteOrderMagic:
+		pr.byteOrder = binary.LittleEndian
+	case binary.BigEndian.Uint32(header[8:]) == pcapngByteOrderMagic:
+		pr.byteOrder = binary.BigEndian
+	default:
+		return errors.New("invalid pcapng section header byte order magic")
+	}
+
+	length := pr.byteOrder.Uint32(header[4:])
+
+	if length < 28 || length > maxBlockSize || length%4 != 0 {
+		return errors.New("invalid pcapng section header length " + strconv.FormatUint(uint64(length), 10))
+	}
+
+	if _, err := pr.reader.Discard(int(length) - 12); err != nil {
+		return errors.New("truncated pcapng section header")
+	}
+
+	// Interface identifiers are scoped to their section
+	pr.interfaces = pr.interfaces[:0]
+	return nil
+}
+
+func (pr *Reader) readBlockPacket() (*Packet, error) {
+	for {
+		header, err := pr.reader.Peek(8)
+
+		if err != nil {
+			if err == io.EOF && len(header) == 0 {
+				return nil, io.EOF
+			}
+
+			return nil, errors.New("truncated pcapng block header")
+		}
+
+		if binary.LittleEndian.Uint32(header) == pcapngSectionHeader {
+			if err := pr.readSectionHeader(); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		blockType := pr.byteOrder.Uint32(header)
+		length := pr.byteOrder.Uint32(header[4:])
+
+		if length < 12 || length > maxBlockSize || length%4 != 0 {
+			return nil, errors.New("invalid pcapng block length " + strconv.FormatUint(uint64(length), 10))
+		}
+
+		block, err := pr.read(int(length))
+
+		if err != nil {
+			return nil, errors.New("truncated pcapng block")
+		}
+
+		body := block[8 : length-4]
+
+		switch blockType {
+		case pcapngInterfaceDescription:
+			if err := pr.addInterface(body); err != nil {
+				return nil, err
+			}
+		case pcapngEnhancedPacket, pcapngObsoletePacket:
+			return pr.decodePacketBlock(blockType, body)
+		case pcapngSimplePacket:
+			if len(body) < 4 || len(pr.interfaces) == 0 {
+				return nil, errors.New("invalid pcapng simple packet block")
+			}
+
+			packetLength := int(pr.byteOrder.Uint32(body))
+			data := body[4:]
+
+			if packetLength < len(data) {
+				data = data[:packetLength]
+			}
+
+			return &Packet{LinkType: pr.interfaces[0].linkType, Data: data, Length: packetLength}, nil
+		}
+	}
+}
+
+func (pr *Reader) addInterface(body []byte) error {
+	if len(body) < 8 {
+		return errors.New("invalid pcapng interface description block")
+	}
+
+	pi := pcapngInterface{linkType: LinkTypeEnum(pr.byteOrder.Uint16(body)), units: 1000000}
+
+	// Parse options for timestamp resolution and offset
+	for options := body[8:]; len(options) >= 4; {
+		code := pr.byteOrder.Uint16(options)
+		length := int(pr.byteOrder.Uint16(options[2:]))
+
+		if code == 0 || 4+length > len(options) {
+			break
+		}
+
+		value := options[4 : 4+length]
+
+		switch {
+		case code == 9 && length == 1:
+			exponent := uint64(value[0] & 0x7F)
+
+			if value[0]&0x80 == 0 {
+				if exponent > 19 {
+					return errors.New("unsupported pcapng interface timestamp resolution")
+				}
+
+				pi.units = 1
+
+				for i := uint64(0); i < exponent; i++ {
+					pi.units *= 10
+				}
+			} else {
+				if exponent > 63 {
+					return errors.New("unsupported pcapng interface timestamp resolution")
+				}
+
+				pi.units = 1 << exponent
+			}
+		case code == 14 && length == 8:
+			pi.offset = int64(pr.byteOrder.Uint64(value))
+		}
+
+		options = options[4+(length+3)/4*4:]
+	}
+
+	pr.interfaces = append(pr.interfaces, pi)
+	return nil
+}
+
+func (pr *Reader) decodePacketBlock(blockType uint32, body []byte) (*Packet, error) {
+	if len(body) < 20 {
+		return nil, errors.New("invalid pcapng packet block")
+	}
+
+	var interfaceID int
+
+	if blockType == pcapngEnhancedPacket {
+		interfaceID = int(pr.byteOrder.Uint32(body))
+	} else {
+		interfaceID = int(pr.byteOrder.Uint16(body))
+	}
+
+	if interfaceID >= len(pr.interfaces) {
+		return nil, errors.New("pcapng packet block references undefined interface " + strconv.Itoa(interfaceID))
+	}
+
+	pi := pr.interfaces[interfaceID]
+	value := uint64(pr.byteOrder.Uint32(body[4:]))<<32 | uint64(pr.byteOrder.Uint32(body[8:]))
+	capturedLength := int(pr.byteOrder.Uint32(body[12:]))
+
+	if 20+capturedLength > len(body) {
+		return nil, errors.New("invalid pcapng packet block captured length")
+	}
+
+	return &Packet{
+		Timestamp: timestamp(value, pi.units, pi.offset),
+		LinkType:  pi.linkType,
+		Data:      body[20 : 20+capturedLength],
+		Length:    int(pr.byteOrder.Uint32(body[16:])),
+	}, nil
+}
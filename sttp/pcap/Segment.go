@@ -0,0 +1,366 @@
+//******************************************************************************************************
+//  Segment.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package pcap
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProtocolEnum defines the type of the Protocol enumeration.
+type ProtocolEnum byte
+
+// Protocol is an enumeration of the transport protocols decoded from captured packets.
+var Protocol = struct {
+	// TCP defines the Transmission Control Protocol.
+	TCP ProtocolEnum
+	// UDP defines the User Datagram Protocol.
+	UDP ProtocolEnum
+}{
+	TCP: 6,
+	UDP: 17,
+}
+
+// String gets the Protocol enumeration value as a string.
+func (pe ProtocolEnum) String() string {
+	switch pe {
+	case Protocol.TCP:
+		return "TCP"
+	case Protocol.UDP:
+		return "UDP"
+	default:
+		return "0x" + strconv.FormatInt(int64(pe), 16)
+	}
+}
+
+// TCPFlagsEnum defines the type of the TCPFlags enumeration.
+type TCPFlagsEnum byte
+
+// TCPFlags is an enumeration of the control flags of a TCP segment.
+var TCPFlags = struct {
+	// FIN defines that the sender has finished sending data.
+	FIN TCPFlagsEnum
+	// SYN defines that the segment synchronizes sequence numbers, i.e., starts a connection.
+	SYN TCPFlagsEnum
+	// RST defines that the connection is reset.
+	RST TCPFlagsEnum
+	// PSH defines that buffered data should be pushed to the receiving application.
+	PSH TCPFlagsEnum
+	// ACK defines that the acknowledgment number is valid.
+	ACK TCPFlagsEnum
+}{
+	FIN: 0x01,
+	SYN: 0x02,
+	RST: 0x04,
+	PSH: 0x08,
+	ACK: 0x10,
+}
+
+// String gets the TCPFlags enumeration value as a string.
+func (tfe TCPFlagsEnum) String() string {
+	var names []string
+
+	for _, flag := range []struct {
+		value TCPFlagsEnum
+		name  string
+	}{
+		{TCPFlags.FIN, "FIN"},
+		{TCPFlags.SYN, "SYN"},
+		{TCPFlags.RST, "RST"},
+		{TCPFlags.PSH, "PSH"},
+		{TCPFlags.ACK, "ACK"},
+	} {
+		if tfe&flag.value != 0 {
+			names = append(names, flag.name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "0x" + strconv.FormatInt(int64(tfe), 16)
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Segment defines a TCP segment or UDP datagram decoded from a captured packet.
+type Segment struct {
+	// Timestamp defines the time the packet was captured.
+	Timestamp time.Time
+
+	// Protocol defines the transport protocol of the segment.
+	Protocol ProtocolEnum
+
+	// Source defines the source address and port of the segment.
+	Source netip.AddrPort
+
+	// Destination defines the destination address and port of the segment.
+	Destination netip.AddrPort
+
+	// Sequence defines the sequence number of a TCP segment.
+	Sequence uint32
+
+	// Flags defines the control flags of a TCP segment.
+	Flags TCPFlagsEnum
+
+	// Payload defines the data carried by the segment.
+	Payload []byte
+}
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86DD
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88A8
+)
+
+// Decode decodes the TCP segment or UDP datagram carried by the packet. Nil is returned, with no
+// error, for packets that do not carry TCP or UDP over IPv4 or IPv6, e.g., ARP or ICMP packets.
+// An error is returned for malformed, truncated or fragmented packets.
+func (p *Packet) Decode() (*Segment, error) {
+	data := p.Data
+
+	var etherType uint16
+
+	switch p.LinkType {
+	case LinkType.Ethernet:
+		if len(data) < 14 {
+			return nil, errors.New("truncated Ethernet header")
+		}
+
+		etherType = binary.BigEndian.Uint16(data[12:])
+		data = data[14:]
+
+		for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+			if len(data) < 4 {
+				return nil, errors.New("truncated VLAN tag")
+			}
+
+			etherType = binary.BigEndian.Uint16(data[2:])
+			data = data[4:]
+		}
+	case LinkType.LinuxSLL:
+		if len(data) < 16 {
+			return nil, errors.New("truncated Linux cooked capture header")
+		}
+
+		etherType = binary.BigEndian.Uint16(data[14:])
+		data = data[16:]
+	case LinkType.LinuxSLL2:
+		if len(data) < 20 {
+			return nil, errors.New("truncated Linux cooked capture v2 header")
+		}
+
+		etherType = binary.BigEndian.Uint16(data)
+		data = data[20:]
+	case LinkType.Null, LinkType.Loop:
+		if len(data) < 4 {
+			return nil, errors.New("truncated loopback header")
+		}
+
+		family := binary.BigEndian.Uint32(data)
+
+		// Null encapsulation uses host byte order of the capturing system
+		if p.LinkType == LinkType.Null && family > 0xFFFF {
+			family = binary.LittleEndian.Uint32(data)
+		}
+
+		switch family {
+		case 2:
+			etherType = etherTypeIPv4
+		case 10, 24, 28, 30:
+			etherType = etherTypeIPv6
+		default:
+			return nil, nil
+		}
+
+		data = data[4:]
+	case LinkType.Raw, LinkType.IPv4, LinkType.IPv6:
+		if len(data) == 0 {
+			return nil, errors.New("empty raw IP packet")
+		}
+
+		switch data[0] >> 4 {
+		case 4:
+			etherType = etherTypeIPv4
+		case 6:
+			etherType = etherTypeIPv6
+		default:
+			return nil, errors.New("invalid raw IP packet version " + strconv.Itoa(int(data[0]>>4)))
+		}
+	default:
+		return nil, errors.New("unsupported link type " + p.LinkType.String())
+	}
+
+	segment := &Segment{Timestamp: p.Timestamp}
+
+	var protocol byte
+	var err error
+
+	switch etherType {
+	case etherTypeIPv4:
+		protocol, data, err = segment.decodeIPv4(data)
+	case etherTypeIPv6:
+		protocol, data, err = segment.decodeIPv6(data)
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch ProtocolEnum(protocol) {
+	case Protocol.TCP:
+		err = segment.decodeTCP(data)
+	case Protocol.UDP:
+		err = segment.decodeUDP(data)
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return segment, nil
+}
+
+func (s *Segment) decodeIPv4(data []byte) (byte, []byte, error) {
+	if len(data) < 20 || data[0]>>4 != 4 {
+		return 0, nil, errors.New("invalid IPv4 header")
+	}
+
+	headerLength := int(data[0]&0x0F) * 4
+	totalLength := int(binary.BigEndian.Uint16(data[2:]))
+
+	if headerLength < 20 || totalLength < headerLength || len(data) < headerLength {
+		return 0, nil, errors.New("invalid IPv4 header length")
+	}
+
+	if binary.BigEndian.Uint16(data[6:])&0x3FFF != 0 {
+		return 0, nil, errors.New("fragmented IPv4 packets are not supported")
+	}
+
+	// Trim link-layer padding, total length can exceed captured length when packet was truncated
+	if totalLength < len(data) {
+		data = data[:totalLength]
+	}
+
+	s.Source = netip.AddrPortFrom(netip.AddrFrom4([4]byte(data[12:16])), 0)
+	s.Destination = netip.AddrPortFrom(netip.AddrFrom4([4]byte(data[16:20])), 0)
+
+	return data[9], data[headerLength:], nil
+}
+
+func (s *Segment) decodeIPv6(data []byte) (byte, []byte, error) {
+	if len(data) < 40 || data[0]>>4 != 6 {
+		return 0, nil, errors.New("invalid IPv6 header")
+	}
+
+	payloadLength := int(binary.BigEndian.Uint16(data[4:]))
+	nextHeader := data[6]
+
+	s.Source = netip.AddrPortFrom(netip.AddrFrom16([16]byte(data[8:24])), 0)
+	s.Destination = netip.AddrPortFrom(netip.AddrFrom16([16]byte(data[24:40])), 0)
+
+	data = data[40:]
+
+	if payloadLength < len(data) {
+		data = data[:payloadLength]
+	}
+
+	// Skip extension headers
+	for {
+		switch nextHeader {
+		case 0, 43, 60: // Hop-by-hop, routing and destination options
+			if len(data) < 8 || len(data) < (int(data[1])+1)*8 {
+				return 0, nil, errors.New("truncated IPv6 extension header")
+			}
+
+			nextHeader = data[0]
+			data = data[(int(data[1])+1)*8:]
+		case 44: // Fragment
+			if len(data) < 8 {
+				return 0, nil, errors.New("truncated IPv6 fragment header")
+			}
+
+			if binary.BigEndian.Uint16(data[2:])&0xFFF9 != 0 {
+				return 0, nil, errors.New("fragmented IPv6 packets are not supported")
+			}
+
+			nextHeader = data[0]
+			data = data[8:]
+		default:
+			return nextHeader, data, nil
+		}
+	}
+}
+
+func (s *Segment) decodeTCP(data []byte) error {
+	if len(data) < 20 {
+		return errors.New("truncated TCP header")
+	}
+
+	headerLength := int(data[12]>>4) * 4
+
+	if headerLength < 20 || headerLength > len(data) {
+		return errors.New("invalid TCP header length")
+	}
+
+	s.Protocol = Protocol.TCP
+	s.Source = netip.AddrPortFrom(s.Source.Addr(), binary.BigEndian.Uint16(data))
+	s.Destination = netip.AddrPortFrom(s.Destination.Addr(), binary.BigEndian.Uint16(data[2:]))
+	s.Sequence = binary.BigEndian.Uint32(data[4:])
+	s.Flags = TCPFlagsEnum(data[13])
+	s.Payload = data[headerLength:]
+
+	return nil
+}
+
+func (s *Segment) decodeUDP(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("truncated UDP header")
+	}
+
+	length := int(binary.BigEndian.Uint16(data[4:]))
+
+	if length < 8 {
+		return errors.New("invalid UDP length")
+	}
+
+	if length < len(data) {
+		data = data[:length]
+	}
+
+	s.Protocol = Protocol.UDP
+	s.Source = netip.AddrPortFrom(s.Source.Addr(), binary.BigEndian.Uint16(data))
+	s.Destination = netip.AddrPortFrom(s.Destination.Addr(), binary.BigEndian.Uint16(data[2:]))
+	s.Payload = data[8:]
+
+	return nil
+}
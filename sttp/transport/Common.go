@@ -65,6 +65,21 @@ func decompressGZip(data []byte) ([]byte, error) {
 	return data, nil
 }
 
+func compressGZip(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
 func resolveDNSName(addr string) string {
 	if strings.Contains(addr, ":") {
 		host, _, err := net.SplitHostPort(addr)
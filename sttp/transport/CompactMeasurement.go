@@ -301,7 +301,26 @@ func (cm *CompactMeasurement) Decode(buffer []byte) (int, error) {
 	return index, nil
 }
 
-//// Encode serializes a CompactMeasurement to a byte buffer for publication to a DataSubscriber.
-//func (cm *CompactMeasurement) Encode(buffer []byte) {
-//	// TODO: This will be needed by DataPublisher implementation
-//}
+// encode serializes a CompactMeasurement for publication to a DataSubscriber, appending to the specified buffer.
+func (cm *CompactMeasurement) encode(buffer []byte) []byte {
+	// Evaluate base time offset usage for timestamp
+	cm.GetBinaryLength()
+
+	buffer = append(buffer, cm.GetCompactStateFlags())
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(cm.GetRuntimeID()))
+	buffer = binary.BigEndian.AppendUint32(buffer, math.Float32bits(float32(cm.Value)))
+
+	if !cm.includeTime {
+		return buffer
+	}
+
+	if !cm.usingBaseTimeOffset {
+		return binary.BigEndian.AppendUint64(buffer, uint64(cm.Timestamp))
+	}
+
+	if cm.useMillisecondResolution {
+		return binary.BigEndian.AppendUint16(buffer, cm.GetTimestampC2())
+	}
+
+	return binary.BigEndian.AppendUint32(buffer, cm.GetTimestampC4())
+}
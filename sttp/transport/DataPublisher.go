@@ -0,0 +1,266 @@
+//******************************************************************************************************
+//  DataPublisher.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"errors"
+	"hash/fnv"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// DataPublisher represents a minimal STTP data publisher that accepts DataSubscriber connections over TCP and
+// publishes measurements to each subscriber using the compact or TSSC encoding the subscriber requests.
+// Metadata filters, the UDP data channel, throttled and temporal subscriptions and payload encryption are
+// not supported. Define callbacks before calling Start.
+type DataPublisher struct {
+	// StatusMessageCallback is called when a informational message should be logged.
+	StatusMessageCallback func(string)
+
+	// ErrorMessageCallback is called when an error message should be logged.
+	ErrorMessageCallback func(string)
+
+	// ClientConnectedCallback is called when a DataSubscriber connects.
+	ClientConnectedCallback func(connection *SubscriberConnection)
+
+	// ClientDisconnectedCallback is called when a DataSubscriber disconnects.
+	ClientDisconnectedCallback func(connection *SubscriberConnection)
+
+	// SubscriptionRequestedCallback is called to resolve the filter expression of a subscription request to
+	// the metadata of the subscribed signals, in signal index order. The SignalID, Source and ID of the
+	// metadata records define the signal index cache sent to the subscriber. A returned error fails the
+	// subscription request. When undefined, all subscription requests fail.
+	SubscriptionRequestedCallback func(connection *SubscriberConnection, filterExpression string) ([]*MeasurementMetadata, error)
+
+	listener    net.Listener
+	metadata    atomic.Value
+	connections map[*SubscriberConnection]struct{}
+	mutex       sync.Mutex
+	waitGroup   sync.WaitGroup
+
+	totalMeasurementsSent      uint64
+	totalMeasurementsDiscarded uint64
+	totalBytesSent             uint64
+}
+
+// NewDataPublisher creates a new DataPublisher.
+func NewDataPublisher() *DataPublisher {
+	return &DataPublisher{
+		connections: make(map[*SubscriberConnection]struct{}),
+	}
+}
+
+// Start begins listening for DataSubscriber connections on the specified TCP address, e.g.,
+// ":7165" or "127.0.0.1:0" to listen on an available loopback port, see Address.
+func (dp *DataPublisher) Start(address string) error {
+	if dp.listener != nil {
+		return errors.New("data publisher is already started")
+	}
+
+	listener, err := net.Listen("tcp", address)
+
+	if err != nil {
+		return errors.New("failed to listen on \"" + address + "\": " + err.Error())
+	}
+
+	dp.listener = listener
+
+	dp.waitGroup.Add(1)
+	go dp.acceptConnections()
+
+	return nil
+}
+
+// Stop closes the listener and all DataSubscriber connections.
+func (dp *DataPublisher) Stop() {
+	if dp.listener == nil {
+		return
+	}
+
+	dp.listener.Close()
+
+	for _, connection := range dp.Connections() {
+		connection.Disconnect()
+	}
+
+	dp.waitGroup.Wait()
+	dp.listener = nil
+}
+
+// Address gets the TCP address the DataPublisher is listening on. Returns an empty string when not started.
+func (dp *DataPublisher) Address() string {
+	if dp.listener == nil {
+		return ""
+	}
+
+	return dp.listener.Addr().String()
+}
+
+// Connections gets the currently connected DataSubscribers.
+func (dp *DataPublisher) Connections() []*SubscriberConnection {
+	dp.mutex.Lock()
+	defer dp.mutex.Unlock()
+
+	connections := make([]*SubscriberConnection, 0, len(dp.connections))
+
+	for connection := range dp.connections {
+		connections = append(connections, connection)
+	}
+
+	return connections
+}
+
+// Metadata gets the XML metadata sent to DataSubscribers in response to a metadata refresh,
+// or nil when no metadata has been defined.
+func (dp *DataPublisher) Metadata() []byte {
+	metadata, _ := dp.metadata.Load().([]byte)
+	return metadata
+}
+
+// SetMetadata defines the XML metadata sent to DataSubscribers in response to a metadata refresh.
+// Use SendConfigurationChanged to notify connected DataSubscribers of changed metadata.
+func (dp *DataPublisher) SetMetadata(metadata []byte) {
+	dp.metadata.Store(metadata)
+}
+
+// PublishMeasurements publishes the measurements to each DataSubscriber subscribed to their signals.
+func (dp *DataPublisher) PublishMeasurements(measurements []Measurement) {
+	for _, connection := range dp.Connections() {
+		connection.publishMeasurements(measurements)
+	}
+}
+
+// SendNotification sends a notification message to all connected DataSubscribers.
+func (dp *DataPublisher) SendNotification(message string) {
+	// Notification is prefixed with a hash that subscribers use to confirm receipt
+	hash := fnv.New32a()
+	hash.Write([]byte(message))
+
+	for _, connection := range dp.Connections() {
+		connection.sendResponse(ServerResponse.Notify, ServerCommand.Subscribe, append(hash.Sum(nil), connection.EncodeString(message)...))
+	}
+}
+
+// SendConfigurationChanged notifies all connected DataSubscribers that the publisher configuration, i.e.,
+// its metadata, has changed. Subscribers will typically refresh metadata and resubscribe.
+func (dp *DataPublisher) SendConfigurationChanged() {
+	for _, connection := range dp.Connections() {
+		connection.sendResponse(ServerResponse.ConfigurationChanged, ServerCommand.Subscribe, nil)
+	}
+}
+
+// TotalMeasurementsSent gets the total number of measurements sent to all DataSubscribers.
+func (dp *DataPublisher) TotalMeasurementsSent() uint64 {
+	return atomic.LoadUint64(&dp.totalMeasurementsSent)
+}
+
+// TotalMeasurementsDiscarded gets the total number of measurements discarded by DataSubscriber
+// requested time reasonability checks.
+func (dp *DataPublisher) TotalMeasurementsDiscarded() uint64 {
+	return atomic.LoadUint64(&dp.totalMeasurementsDiscarded)
+}
+
+// TotalBytesSent gets the total number of bytes sent to all DataSubscribers.
+func (dp *DataPublisher) TotalBytesSent() uint64 {
+	return atomic.LoadUint64(&dp.totalBytesSent)
+}
+
+func (dp *DataPublisher) acceptConnections() {
+	defer dp.waitGroup.Done()
+
+	for {
+		conn, err := dp.listener.Accept()
+
+		if err != nil {
+			return
+		}
+
+		connection := newSubscriberConnection(dp, conn)
+
+		dp.mutex.Lock()
+		dp.connections[connection] = struct{}{}
+		dp.mutex.Unlock()
+
+		dp.dispatchStatusMessage("Client connected from \"" + connection.connectionID + "\"")
+
+		if dp.ClientConnectedCallback != nil {
+			dp.ClientConnectedCallback(connection)
+		}
+
+		dp.waitGroup.Add(1)
+
+		go func() {
+			defer dp.waitGroup.Done()
+			connection.run()
+
+			dp.mutex.Lock()
+			delete(dp.connections, connection)
+			dp.mutex.Unlock()
+
+			dp.dispatchStatusMessage("Client disconnected from \"" + connection.connectionID + "\"")
+
+			if dp.ClientDisconnectedCallback != nil {
+				dp.ClientDisconnectedCallback(connection)
+			}
+		}()
+	}
+}
+
+// resolveSubscription creates the signal index cache for a subscription request.
+func (dp *DataPublisher) resolveSubscription(connection *SubscriberConnection, filterExpression string) (*SignalIndexCache, error) {
+	if dp.SubscriptionRequestedCallback == nil {
+		return nil, errors.New("no subscription handler is defined")
+	}
+
+	signals, err := dp.SubscriptionRequestedCallback(connection, filterExpression)
+
+	if err != nil {
+		return nil, err
+	}
+
+	signalIndexCache := NewSignalIndexCache()
+
+	for _, metadata := range signals {
+		if metadata == nil || signalIndexCache.SignalIndex(metadata.SignalID) > -1 {
+			continue
+		}
+
+		signalIndexCache.addRecord(nil, int32(signalIndexCache.Count()), metadata.SignalID, metadata.Source, metadata.ID, 1)
+	}
+
+	return signalIndexCache, nil
+}
+
+func (dp *DataPublisher) dispatchStatusMessage(message string) {
+	if dp.StatusMessageCallback != nil {
+		dp.StatusMessageCallback(message)
+	}
+}
+
+func (dp *DataPublisher) dispatchErrorMessage(message string) {
+	if dp.ErrorMessageCallback != nil {
+		dp.ErrorMessageCallback(message)
+	}
+}
@@ -83,6 +83,12 @@ type DataSubscriber struct {
 	totalDataChannelBytesReceived    uint64
 	totalMeasurementsReceived        uint64
 
+	// Session capture, when active
+	captureWriter atomic.Pointer[CaptureWriter]
+
+	// Health metrics, when enabled
+	metrics atomic.Pointer[SubscriberMetrics]
+
 	// StatusMessageCallback is called when a informational message should be logged.
 	StatusMessageCallback func(string)
 
@@ -350,6 +356,7 @@ func (ds *DataSubscriber) connect(hostName string, port uint16, autoReconnecting
 
 	// Initialize connection state
 	ds.setupConnection()
+	ds.metrics.Load().addConnectAttempt(autoReconnecting)
 
 	if !autoReconnecting {
 		ds.connector.ResetConnection()
@@ -361,12 +368,49 @@ func (ds *DataSubscriber) connect(hostName string, port uint16, autoReconnecting
 	conn, err := net.Dial("tcp", hostName+":"+strconv.Itoa(int(port)))
 
 	if err == nil {
-		ds.establishConnection(conn, false)
+		ds.establishConnection(conn, hostName+":"+strconv.Itoa(int(port)), false)
 	}
 
 	return err
 }
 
+// ConnectConn requests that the DataSubscriber use an established connection to a DataPublisher, e.g.,
+// a ReplayConn used to play back a session capture as if it were a live DataPublisher.
+func (ds *DataSubscriber) ConnectConn(connection net.Conn) error {
+	if ds.connected.IsSet() {
+		return errors.New("subscriber is already connected; disconnect first")
+	}
+
+	if ds.listening.IsSet() {
+		return errors.New("subscriber is listening for connections; direct connections disallowed")
+	}
+
+	// Make sure any pending disconnect has completed to make sure socket is closed
+	ds.disconnectThreadMutex.Lock()
+	disconnectThread := ds.disconnectThread
+	ds.disconnectThreadMutex.Unlock()
+
+	if disconnectThread != nil {
+		disconnectThread.Join()
+	}
+
+	ds.connectActionMutex.Lock()
+	defer ds.connectActionMutex.Unlock()
+
+	// Initialize connection state
+	ds.setupConnection()
+
+	addrName := "<unknown>"
+
+	if addr := connection.RemoteAddr(); addr != nil {
+		addrName = addr.String()
+	}
+
+	ds.establishConnection(connection, addrName, false)
+
+	return nil
+}
+
 func (ds *DataSubscriber) setupConnection() {
 	ds.disconnected.UnSet()
 	ds.subscribed.UnSet()
@@ -380,21 +424,8 @@ func (ds *DataSubscriber) setupConnection() {
 	ds.measurementRegistry = sync.Map{}
 }
 
-func (ds *DataSubscriber) establishConnection(connection net.Conn, listening bool) {
-	addrName := "<unknown>"
-
-	if listening {
-		addr := connection.RemoteAddr()
-
-		if addr != nil {
-			addrName = resolveDNSName(addr.String())
-		}
-	} else {
-		connector := ds.connector
-		addrName = connector.Hostname + ":" + strconv.Itoa(int(connector.Port))
-	}
-
-	ds.connectionID = addrName
+func (ds *DataSubscriber) establishConnection(connection net.Conn, connectionID string, listening bool) {
+	ds.connectionID = connectionID
 
 	if listening {
 		ds.dispatchStatusMessage("Processing connection attempt from \"" + ds.connectionID + "\" ...")
@@ -405,6 +436,7 @@ func (ds *DataSubscriber) establishConnection(connection net.Conn, listening boo
 
 	ds.connected.Set()
 	ds.lastMissingCacheWarning = 0
+	ds.metrics.Load().addConnection()
 
 	ds.commandChannelResponseThread.Start()
 	ds.sendOperationalModes()
@@ -691,6 +723,13 @@ func (ds *DataSubscriber) runDisconnectThread(autoReconnecting bool, includeList
 		ds.dataChannelResponseThread = nil
 	}
 
+	// Make sure captured frames are written before consumers are notified of disconnect
+	if captureWriter := ds.captureWriter.Load(); captureWriter != nil {
+		if err := captureWriter.Flush(); err != nil {
+			ds.dispatchErrorMessage("Failed to write session capture: " + err.Error())
+		}
+	}
+
 	// Notify consumers of disconnect
 	ds.BeginCallbackSync()
 
@@ -791,8 +830,15 @@ func (ds *DataSubscriber) runListeningSocketAcceptThread() {
 		// Initialize connection state
 		ds.setupConnection()
 
+		addrName := "<unknown>"
+		addr := conn.RemoteAddr()
+
+		if addr != nil {
+			addrName = resolveDNSName(addr.String())
+		}
+
 		// Create new command channel
-		ds.establishConnection(conn, true)
+		ds.establishConnection(conn, addrName, true)
 
 		ds.connectActionMutex.Unlock()
 	}
@@ -819,6 +865,7 @@ func (ds *DataSubscriber) readPayloadHeader(bytesTransferred int, err error) {
 
 	// Gather statistics
 	atomic.AddUint64(&ds.totalCommandChannelBytesReceived, uint64(bytesTransferred))
+	ds.metrics.Load().addCommandChannelBytes(bytesTransferred)
 
 	packetSize := binary.BigEndian.Uint32(ds.readBuffer)
 
@@ -869,8 +916,10 @@ func (ds *DataSubscriber) readPacket(bytesTransferred int, err error) {
 
 	// Gather statistics
 	atomic.AddUint64(&ds.totalCommandChannelBytesReceived, uint64(bytesTransferred))
+	ds.metrics.Load().addCommandChannelBytes(bytesTransferred)
 
 	// Process response
+	ds.captureFrame(CaptureDirection.Received, CaptureChannel.CommandChannel, ds.readBuffer[:bytesTransferred])
 	ds.processServerResponse(ds.readBuffer[:bytesTransferred])
 }
 
@@ -890,8 +939,10 @@ func (ds *DataSubscriber) runDataChannelResponseThread() {
 
 		// Gather statistics
 		atomic.AddUint64(&ds.totalDataChannelBytesReceived, uint64(length))
+		ds.metrics.Load().addDataChannelBytes(length)
 
 		// Process response
+		ds.captureFrame(CaptureDirection.Received, CaptureChannel.DataChannel, buffer[:length])
 		ds.processServerResponse(buffer[:length])
 	}
 }
@@ -1003,6 +1054,8 @@ func (ds *DataSubscriber) handleFailed(commandCode ServerCommandEnum, data []byt
 }
 
 func (ds *DataSubscriber) handleMetadataRefresh(data []byte) {
+	ds.metrics.Load().addMetadataRefresh(time.Since(ds.metadataRequested))
+
 	ds.BeginCallbackSync()
 	metadataReceivedCallback := ds.MetadataReceivedCallback
 	ds.EndCallbackSync()
@@ -1128,53 +1181,49 @@ func (ds *DataSubscriber) handleUpdateBaseTimes(data []byte) {
 
 func (ds *DataSubscriber) handleUpdateCipherKeys(data []byte) {
 	// Deserialize new cipher keys
-	keyIVs := make([][][]byte, 2)
-	keyIVs[evenKey] = make([][]byte, 2)
-	keyIVs[oddKey] = make([][]byte, 2)
+	keyIVs, err := decodeCipherKeys(data)
 
-	// Move past active cipher index (not currently used anywhere else)
-	var index uint32 = 1
-
-	// Read even key size
-	bufferLen := binary.BigEndian.Uint32(data[index:])
-	index += 4
+	if err != nil {
+		ds.dispatchErrorMessage("Failed to parse cipher keys: " + err.Error())
+		return
+	}
 
-	// Read even key
-	keyIVs[evenKey][keyIndex] = make([]byte, bufferLen)
-	copy(keyIVs[evenKey][keyIndex], data[index:])
-	index += bufferLen
+	// Exchange keys
+	ds.keyIVs = keyIVs
 
-	// Read even initialization vector size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+	ds.dispatchStatusMessage("Successfully established new cipher keys for UDP data packet transmissions.")
+}
 
-	// Read even initialization vector
-	keyIVs[evenKey][ivIndex] = make([]byte, bufferLen)
-	copy(keyIVs[evenKey][ivIndex], data[index:])
-	index += bufferLen
+// decodeCipherKeys parses the even and odd key / initialization vector pairs of an update cipher keys response.
+func decodeCipherKeys(data []byte) ([][][]byte, error) {
+	keyIVs := make([][][]byte, 2)
+	keyIVs[evenKey] = make([][]byte, 2)
+	keyIVs[oddKey] = make([][]byte, 2)
 
-	// Read odd key size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+	// Move past active cipher index (not currently used anywhere else)
+	index := 1
 
-	// Read odd key
-	keyIVs[oddKey][keyIndex] = make([]byte, bufferLen)
-	copy(keyIVs[oddKey][keyIndex], data[index:])
-	index += bufferLen
+	// Read even key, even initialization vector, odd key and odd initialization vector
+	for _, key := range []int{evenKey, oddKey} {
+		for _, item := range []int{keyIndex, ivIndex} {
+			if len(data) < index+4 {
+				return nil, errors.New("not enough buffer provided to parse")
+			}
 
-	// Read odd initialization vector size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+			bufferLen := int(binary.BigEndian.Uint32(data[index:]))
+			index += 4
 
-	// Read odd initialization vector
-	keyIVs[oddKey][ivIndex] = make([]byte, bufferLen)
-	copy(keyIVs[oddKey][ivIndex], data[index:])
-	//index += bufferLen
+			if bufferLen > len(data)-index {
+				return nil, errors.New("not enough buffer provided to parse")
+			}
 
-	// Exchange keys
-	ds.keyIVs = keyIVs
+			keyIVs[key][item] = make([]byte, bufferLen)
+			copy(keyIVs[key][item], data[index:])
+			index += bufferLen
+		}
+	}
 
-	ds.dispatchStatusMessage("Successfully established new cipher keys for UDP data packet transmissions.")
+	return keyIVs, nil
 }
 
 func (ds *DataSubscriber) handleConfigurationChanged() {
@@ -1215,6 +1264,7 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 		data, err = decipherAES(keyIVs[cipherIndex][keyIndex], keyIVs[cipherIndex][ivIndex], data)
 
 		if err != nil {
+			ds.metrics.Load().addDecryptFailure()
 			ds.dispatchErrorMessage("Failed to decrypt data packet - disconnecting: " + err.Error())
 			ds.dispatchConnectionTerminated()
 			return
@@ -1244,12 +1294,17 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 	signalIndexCache := ds.signalIndexCache[cacheIndex]
 	ds.signalIndexCacheMutex.Unlock()
 
+	var parsed bool
+
 	if compressed {
-		ds.parseTSSCMeasurements(signalIndexCache, data[4:], *measurements)
+		parsed = ds.parseTSSCMeasurements(signalIndexCache, data[4:], *measurements)
 	} else {
-		ds.parseCompactMeasurements(signalIndexCache, data[4:], *measurements)
+		parsed = ds.parseCompactMeasurements(signalIndexCache, data[4:], *measurements)
 	}
 
+	// Record metrics before callback since consumer may return measurements to pool
+	ds.metrics.Load().addDataPacket(*measurements, parsed)
+
 	ds.BeginCallbackSync()
 
 	if ds.NewMeasurementsCallback != nil {
@@ -1263,7 +1318,7 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 	atomic.AddUint64(&ds.totalMeasurementsReceived, uint64(count))
 }
 
-func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCache, data []byte, measurements []Measurement) {
+func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCache, data []byte, measurements []Measurement) bool {
 	decoder := signalIndexCache.tsscDecoder
 	var newDecoder bool
 
@@ -1278,7 +1333,7 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	if data[0] != 85 {
 		ds.dispatchErrorMessage("TSSC version not recognized - disconnecting. Received version: " + strconv.Itoa(int(data[0])))
 		ds.dispatchConnectionTerminated()
-		return
+		return false
 	}
 
 	sequenceNumber := binary.BigEndian.Uint16(data[1:])
@@ -1289,6 +1344,8 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 				ds.dispatchStatusMessage("TSSC algorithm reset before sequence number: " + strconv.Itoa(int(decoder.SequenceNumber)))
 			}
 
+			ds.metrics.Load().addTSSCReset()
+
 			signalIndexCache.tsscDecoder = tssc.NewDecoder()
 			decoder = signalIndexCache.tsscDecoder
 			decoder.SequenceNumber = 0
@@ -1301,6 +1358,8 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	}
 
 	if decoder.SequenceNumber != sequenceNumber {
+		ds.metrics.Load().addTSSCOutOfSequence()
+
 		if ds.tsscResetRequested.IsNotSet() {
 			ds.tsscLastOOSReportMutex.Lock()
 
@@ -1313,7 +1372,7 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 		}
 
 		// Ignore packets until the reset has occurred
-		return
+		return false
 	}
 
 	decoder.SetBuffer(data[3:])
@@ -1343,7 +1402,7 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	if err != nil {
 		ds.dispatchErrorMessage("Failed to parse TSSC measurements - disconnecting: " + err.Error())
 		ds.dispatchConnectionTerminated()
-		return
+		return false
 	}
 
 	decoder.SequenceNumber++
@@ -1352,9 +1411,11 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	if decoder.SequenceNumber == 0 {
 		decoder.SequenceNumber = 1
 	}
+
+	return true
 }
 
-func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndexCache, data []byte, measurements []Measurement) {
+func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndexCache, data []byte, measurements []Measurement) bool {
 	if signalIndexCache.Count() == 0 {
 		if ds.lastMissingCacheWarning+missingCacheWarningInterval < ticks.UtcNow() {
 			// Warning message for missing signal index cache
@@ -1365,7 +1426,7 @@ func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndex
 			ds.lastMissingCacheWarning = ticks.UtcNow()
 		}
 
-		return
+		return false
 	}
 
 	useMillisecondResolution := ds.subscription.UseMillisecondResolution
@@ -1380,12 +1441,14 @@ func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndex
 		if err != nil {
 			ds.dispatchErrorMessage("Failed to parse compact measurements - disconnecting: " + err.Error())
 			ds.dispatchConnectionTerminated()
-			return
+			return false
 		}
 
 		index += bytesDecoded
 		measurements[i] = compactMeasurement.Measurement
 	}
+
+	return true
 }
 
 func (ds *DataSubscriber) handleBufferBlock(data []byte) {
@@ -1464,9 +1527,24 @@ func (ds *DataSubscriber) handleBufferBlock(data []byte) {
 			// Insert this buffer block into the proper location in the list
 			ds.bufferBlockCache[bufferCacheIndex] = bufferBlockMeasurement
 		}
+
+		ds.metrics.Load().addBufferBlock(ds.bufferBlockBacklog())
 	}
 }
 
+// bufferBlockBacklog gets the number of cached buffer blocks awaiting delivery.
+func (ds *DataSubscriber) bufferBlockBacklog() int {
+	var backlog int
+
+	for i := range ds.bufferBlockCache {
+		if ds.bufferBlockCache[i].Buffer != nil {
+			backlog++
+		}
+	}
+
+	return backlog
+}
+
 func (ds *DataSubscriber) handleNotification(data []byte) {
 	// Skip the 4-byte hash and decode notification message
 	message := ds.DecodeString(data[4:])
@@ -1529,6 +1607,59 @@ func (ds *DataSubscriber) SendServerCommandWithPayload(commandCode ServerCommand
 		// Write error, connection may have been closed by peer; terminate connection
 		ds.dispatchErrorMessage("Failed to send server command - disconnecting: " + err.Error())
 		ds.dispatchConnectionTerminated()
+		return
+	}
+
+	ds.captureFrame(CaptureDirection.Sent, CaptureChannel.CommandChannel, ds.writeBuffer[payloadHeaderSize:commandBufferSize])
+}
+
+// StartCapture starts writing all frames received from, and sent to, the DataPublisher to the specified
+// writer as an STTP session capture, see CaptureWriter. Received frames are captured, with their arrival
+// time and channel, before they are processed. A session capture can be played back using a ReplayConn.
+func (ds *DataSubscriber) StartCapture(writer io.Writer) error {
+	captureWriter, err := NewCaptureWriter(writer)
+
+	if err != nil {
+		return err
+	}
+
+	if !ds.captureWriter.CompareAndSwap(nil, captureWriter) {
+		return errors.New("subscriber is already capturing; stop capture first")
+	}
+
+	return nil
+}
+
+// StopCapture stops any active session capture and writes any buffered frames to the capture writer.
+func (ds *DataSubscriber) StopCapture() error {
+	if captureWriter := ds.captureWriter.Swap(nil); captureWriter != nil {
+		return captureWriter.Flush()
+	}
+
+	return nil
+}
+
+// IsCapturing determines if the DataSubscriber is currently capturing session frames.
+func (ds *DataSubscriber) IsCapturing() bool {
+	return ds.captureWriter.Load() != nil
+}
+
+func (ds *DataSubscriber) captureFrame(direction CaptureDirectionEnum, channel CaptureChannelEnum, data []byte) {
+	captureWriter := ds.captureWriter.Load()
+
+	if captureWriter == nil {
+		return
+	}
+
+	err := captureWriter.WriteFrame(&CapturedFrame{
+		Direction: direction,
+		Channel:   channel,
+		Timestamp: ticks.UtcNow(),
+		Data:      data,
+	})
+
+	if err != nil && ds.captureWriter.CompareAndSwap(captureWriter, nil) {
+		ds.dispatchErrorMessage("Failed to write session capture, capture stopped: " + err.Error())
 	}
 }
 
@@ -1589,6 +1720,18 @@ func (ds *DataSubscriber) SubscriberID() guid.Guid {
 	return ds.subscriberID
 }
 
+// EnableMetrics enables collection of SubscriberMetrics, if not already enabled, and returns the metrics.
+// Metrics accumulate across reconnects and can be served to Prometheus, see SubscriberMetrics.
+func (ds *DataSubscriber) EnableMetrics() *SubscriberMetrics {
+	ds.metrics.CompareAndSwap(nil, newSubscriberMetrics(ds))
+	return ds.metrics.Load()
+}
+
+// Metrics gets the SubscriberMetrics of the DataSubscriber, or nil if metrics have not been enabled.
+func (ds *DataSubscriber) Metrics() *SubscriberMetrics {
+	return ds.metrics.Load()
+}
+
 // TotalCommandChannelBytesReceived gets the total number of bytes received via the command channel since last connection.
 func (ds *DataSubscriber) TotalCommandChannelBytesReceived() uint64 {
 	return atomic.LoadUint64(&ds.totalCommandChannelBytesReceived)
@@ -0,0 +1,902 @@
+//******************************************************************************************************
+//  Dissector.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+const (
+	// maxInitialPacketSize mirrors the DataSubscriber validation of the first response from a DataPublisher.
+	maxInitialPacketSize = responseHeaderSize + 8192
+
+	// maxDissectorFrameSize defines the largest frame size considered valid when searching for a frame boundary.
+	maxDissectorFrameSize = 1 << 26
+
+	// maxDissectorCommandSize defines the largest command frame size considered valid when searching for a
+	// frame boundary in data sent to a DataPublisher.
+	maxDissectorCommandSize = 1 << 20
+)
+
+// DissectorEvent represents an STTP frame, or a protocol anomaly not associated with a frame, observed by a Dissector.
+type DissectorEvent struct {
+	// Timestamp defines the capture time of the data that completed the frame.
+	Timestamp ticks.Ticks
+
+	// Direction defines if the frame was received from, or sent to, the DataPublisher.
+	Direction CaptureDirectionEnum
+
+	// Channel defines the channel over which the frame was transferred.
+	Channel CaptureChannelEnum
+
+	// Command defines the server command of a sent frame or the command that a received response refers to.
+	Command ServerCommandEnum
+
+	// Response defines the server response code of a received frame.
+	Response ServerResponseEnum
+
+	// Frame defines the frame bytes, without the payload size header, in the same form as CapturedFrame data.
+	// Frame is nil for events that only report anomalies, e.g., data missing from the capture.
+	Frame []byte
+
+	// Description defines a short, human-readable summary of the frame contents.
+	Description string
+
+	// Measurements defines the measurements decoded from a data packet.
+	Measurements []Measurement
+
+	// Anomalies defines the protocol anomalies detected for the frame.
+	Anomalies []string
+}
+
+func (de *DissectorEvent) addAnomaly(anomaly string) {
+	de.Anomalies = append(de.Anomalies, anomaly)
+}
+
+// dissectorStream defines the frame parsing state of one direction of an STTP command channel.
+type dissectorStream struct {
+	buffer       []byte
+	timestamp    ticks.Ticks
+	initial      bool
+	synchronized bool
+	skipped      int
+}
+
+// Dissector decodes the frames of an STTP session observed by a third party, e.g., read from a network packet
+// capture, into DissectorEvent values. Data sent by the DataSubscriber and data received from the DataPublisher
+// are provided separately; frames split or coalesced across the provided data buffers are reassembled. Session
+// state, i.e., operational modes, subscription settings, signal index caches, base time offsets, cipher keys and
+// TSSC decoder state, is tracked from the observed frames so that data packets can be decoded into measurements.
+// Any deviation from expected protocol behavior is reported as an anomaly on the associated event.
+type Dissector struct {
+	// SwapGuidEndianness determines if Guid wire serialization should swap endianness, see DataSubscriber.
+	SwapGuidEndianness bool
+
+	context                  *DataSubscriber
+	streams                  [2]dissectorStream
+	version                  byte
+	operationalModes         OperationalModesEnum
+	modesDefined             bool
+	includeTime              bool
+	useMillisecondResolution bool
+	dataChannelPort          uint16
+	signalIndexCache         [2]*SignalIndexCache
+	baseTimeOffsets          [2]int64
+	keyIVs                   [][][]byte
+	tsscOutOfSequence        bool
+}
+
+// NewDissector creates a new Dissector for a single STTP session.
+func NewDissector() *Dissector {
+	d := &Dissector{
+		// Signal index cache parsing needs a DataSubscriber for string decoding and metadata registry
+		context:     &DataSubscriber{encoding: OperationalEncoding.UTF8, Version: 2},
+		version:     2,
+		includeTime: true,
+	}
+
+	for i := range d.streams {
+		d.streams[i].initial = true
+		d.streams[i].synchronized = true
+	}
+
+	return d
+}
+
+// Version gets the STTP protocol version defined by the session operational modes.
+// Version 2 is assumed until operational modes have been observed.
+func (d *Dissector) Version() byte {
+	return d.version
+}
+
+// DataChannelPort gets the local UDP port the DataSubscriber requested for its data channel
+// when subscribing, or zero when the session does not use a UDP data channel.
+func (d *Dissector) DataChannelPort() uint16 {
+	return d.dataChannelPort
+}
+
+// SignalIndexCache gets the signal index cache, i.e., 0 or 1, last received for the specified cache index.
+// Returns nil when no signal index cache has been received for the cache index.
+func (d *Dissector) SignalIndexCache(cacheIndex int) *SignalIndexCache {
+	return d.signalIndexCache[cacheIndex&1]
+}
+
+// DissectStream decodes the frames contained in data captured from the STTP command channel in the specified
+// direction. Data is appended to any incomplete frame remaining from previous calls for the same direction.
+// Returns an event for each frame completed by data.
+func (d *Dissector) DissectStream(direction CaptureDirectionEnum, timestamp ticks.Ticks, data []byte) []*DissectorEvent {
+	stream := &d.streams[direction&1]
+	buffer := append(stream.buffer, data...)
+	stream.timestamp = timestamp
+
+	var events []*DissectorEvent
+
+	for {
+		if !stream.synchronized {
+			offset, found := findFrame(direction, buffer)
+			stream.skipped += offset
+			buffer = buffer[offset:]
+
+			if !found {
+				break
+			}
+
+			stream.synchronized = true
+
+			if stream.skipped > 0 {
+				events = append(events, &DissectorEvent{
+					Timestamp:   timestamp,
+					Direction:   direction,
+					Channel:     CaptureChannel.CommandChannel,
+					Description: "frame boundary found after skipping " + strconv.Itoa(stream.skipped) + " bytes",
+				})
+			}
+
+			stream.skipped = 0
+		}
+
+		if len(buffer) < payloadHeaderSize {
+			break
+		}
+
+		size := int(binary.BigEndian.Uint32(buffer))
+		minimumSize := 1
+
+		if direction == CaptureDirection.Received {
+			minimumSize = responseHeaderSize
+		}
+
+		if size < minimumSize || size > maxDissectorFrameSize {
+			event := &DissectorEvent{Timestamp: timestamp, Direction: direction, Channel: CaptureChannel.CommandChannel}
+			event.addAnomaly("invalid frame size " + strconv.Itoa(size) + ", searching for next frame boundary")
+			events = append(events, event)
+
+			stream.synchronized = false
+			stream.skipped = 1
+			buffer = buffer[1:]
+			continue
+		}
+
+		if len(buffer) < payloadHeaderSize+size {
+			break
+		}
+
+		frame := buffer[payloadHeaderSize : payloadHeaderSize+size]
+		buffer = buffer[payloadHeaderSize+size:]
+
+		event := d.dissectFrame(direction, CaptureChannel.CommandChannel, timestamp, frame)
+
+		if stream.initial && direction == CaptureDirection.Received && d.version >= 2 && size > maxInitialPacketSize {
+			event.addAnomaly("initial packet size " + strconv.Itoa(size) + " exceeds " + strconv.Itoa(maxInitialPacketSize) + " byte limit, connection is likely not STTP")
+		}
+
+		stream.initial = false
+		events = append(events, event)
+	}
+
+	// Copy remaining data so that returned frames do not share storage with later appends
+	stream.buffer = append([]byte(nil), buffer...)
+
+	return events
+}
+
+// DissectDatagram decodes a frame received from the DataPublisher over the UDP data channel.
+func (d *Dissector) DissectDatagram(timestamp ticks.Ticks, data []byte) *DissectorEvent {
+	if len(data) < responseHeaderSize {
+		event := &DissectorEvent{Timestamp: timestamp, Direction: CaptureDirection.Received, Channel: CaptureChannel.DataChannel}
+		event.addAnomaly("datagram size " + strconv.Itoa(len(data)) + " is smaller than response header")
+		return event
+	}
+
+	return d.dissectFrame(CaptureDirection.Received, CaptureChannel.DataChannel, timestamp, append([]byte(nil), data...))
+}
+
+// Gap notifies the dissector that size bytes of command channel data in the specified direction are missing
+// from the capture. A size of zero indicates the capture started after the connection was established. Any
+// incomplete frame is discarded and the dissector searches for the next plausible frame boundary.
+func (d *Dissector) Gap(direction CaptureDirectionEnum, timestamp ticks.Ticks, size int) *DissectorEvent {
+	stream := &d.streams[direction&1]
+	event := &DissectorEvent{Timestamp: timestamp, Direction: direction, Channel: CaptureChannel.CommandChannel}
+
+	if size == 0 {
+		event.addAnomaly("capture started after connection was established, searching for frame boundary")
+	} else {
+		event.addAnomaly(strconv.Itoa(size) + " bytes missing from capture, searching for next frame boundary")
+	}
+
+	stream.skipped = 0
+	stream.buffer = nil
+	stream.initial = false
+	stream.synchronized = false
+
+	return event
+}
+
+// Flush reports any incomplete frames remaining at the end of a capture.
+func (d *Dissector) Flush() []*DissectorEvent {
+	var events []*DissectorEvent
+
+	for _, direction := range []CaptureDirectionEnum{CaptureDirection.Sent, CaptureDirection.Received} {
+		stream := &d.streams[direction]
+		remaining := len(stream.buffer)
+
+		if !stream.synchronized {
+			remaining += stream.skipped
+		}
+
+		if remaining == 0 {
+			continue
+		}
+
+		event := &DissectorEvent{Timestamp: stream.timestamp, Direction: direction, Channel: CaptureChannel.CommandChannel}
+		event.addAnomaly("capture ended with " + strconv.Itoa(remaining) + " bytes of incomplete frame data")
+		events = append(events, event)
+
+		stream.buffer = nil
+		stream.skipped = 0
+	}
+
+	return events
+}
+
+// findFrame searches buffer for the start of a plausible frame. When no frame is found, the returned
+// offset is the position of the first byte that could still start a frame once more data arrives.
+func findFrame(direction CaptureDirectionEnum, buffer []byte) (int, bool) {
+	offset := 0
+
+	if direction == CaptureDirection.Received {
+		for ; offset+payloadHeaderSize+responseHeaderSize <= len(buffer); offset++ {
+			size := binary.BigEndian.Uint32(buffer[offset:])
+			header := buffer[offset+payloadHeaderSize:]
+
+			if size >= responseHeaderSize && size <= maxDissectorFrameSize && knownServerResponse(ServerResponseEnum(header[0])) &&
+				binary.BigEndian.Uint32(header[2:]) == size-responseHeaderSize {
+				return offset, true
+			}
+		}
+	} else {
+		for ; offset+payloadHeaderSize+1 <= len(buffer); offset++ {
+			size := binary.BigEndian.Uint32(buffer[offset:])
+
+			if size >= 1 && size <= maxDissectorCommandSize && knownServerCommand(ServerCommandEnum(buffer[offset+payloadHeaderSize])) {
+				return offset, true
+			}
+		}
+	}
+
+	return offset, false
+}
+
+func knownServerCommand(command ServerCommandEnum) bool {
+	return command <= ServerCommand.GetSignalSelectionSchema || command >= ServerCommand.UserCommand00 && command <= ServerCommand.UserCommand15
+}
+
+func knownServerResponse(response ServerResponseEnum) bool {
+	return response >= ServerResponse.Succeeded && response <= ServerResponse.ConfigurationChanged ||
+		response >= ServerResponse.UserResponse00 && response <= ServerResponse.UserResponse15 ||
+		response == ServerResponse.NoOP
+}
+
+// dissectFrame decodes a single frame. Malformed frames can cause the existing decoders to panic, so
+// panics are recovered and reported as anomalies.
+func (d *Dissector) dissectFrame(direction CaptureDirectionEnum, channel CaptureChannelEnum, timestamp ticks.Ticks, frame []byte) (event *DissectorEvent) {
+	event = &DissectorEvent{
+		Timestamp: timestamp,
+		Direction: direction,
+		Channel:   channel,
+		Frame:     frame,
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			event.addAnomaly("failed to decode frame: " + fmt.Sprint(err))
+		}
+	}()
+
+	if direction == CaptureDirection.Sent {
+		d.dissectCommand(event)
+	} else {
+		d.dissectResponse(event)
+	}
+
+	return event
+}
+
+func (d *Dissector) dissectCommand(event *DissectorEvent) {
+	event.Command = ServerCommandEnum(event.Frame[0])
+	payload := event.Frame[1:]
+	stream := &d.streams[CaptureDirection.Sent]
+
+	if stream.initial && d.version >= 2 && event.Command != ServerCommand.DefineOperationalModes {
+		event.addAnomaly("unexpected initial command code " + event.Command.String() + ", expected DefineOperationalModes")
+	}
+
+	switch event.Command {
+	case ServerCommand.DefineOperationalModes:
+		if len(payload) != 4 {
+			event.addAnomaly("DefineOperationalModes payload size " + strconv.Itoa(len(payload)) + " does not match expected size 4")
+
+			if len(payload) < 4 {
+				return
+			}
+		}
+
+		d.operationalModes = OperationalModesEnum(binary.BigEndian.Uint32(payload))
+		d.modesDefined = true
+		d.version = byte(d.operationalModes & OperationalModes.VersionMask)
+		d.context.Version = d.version
+		event.Description = describeOperationalModes(d.operationalModes)
+
+		if OperationalEncodingEnum(d.operationalModes&OperationalModes.EncodingMask) != OperationalEncoding.UTF8 {
+			event.addAnomaly("unsupported string encoding requested, STTP only supports UTF8")
+		}
+	case ServerCommand.Subscribe:
+		d.dissectSubscribe(event, payload)
+	case ServerCommand.Unsubscribe:
+		d.dataChannelPort = 0
+	case ServerCommand.MetadataRefresh:
+		if len(payload) > 0 {
+			event.Description = "filters: " + d.context.DecodeString(payload)
+		}
+	case ServerCommand.UpdateProcessingInterval:
+		if len(payload) != 4 {
+			event.addAnomaly("UpdateProcessingInterval payload size " + strconv.Itoa(len(payload)) + " does not match expected size 4")
+			return
+		}
+
+		event.Description = "processing interval " + strconv.Itoa(int(int32(binary.BigEndian.Uint32(payload)))) + "ms"
+	case ServerCommand.ConfirmNotification, ServerCommand.ConfirmBufferBlock:
+		if len(payload) != 4 {
+			event.addAnomaly(event.Command.String() + " payload size " + strconv.Itoa(len(payload)) + " does not match expected size 4")
+			return
+		}
+
+		event.Description = "sequence " + strconv.FormatUint(uint64(binary.BigEndian.Uint32(payload)), 10)
+	case ServerCommand.Connect, ServerCommand.RotateCipherKeys, ServerCommand.ConfirmUpdateSignalIndexCache, ServerCommand.ConfirmUpdateCipherKeys,
+		ServerCommand.GetPrimaryMetadataSchema, ServerCommand.GetSignalSelectionSchema:
+	default:
+		if !knownServerCommand(event.Command) {
+			event.addAnomaly("unknown server command code " + event.Command.String())
+		}
+
+		event.Description = strconv.Itoa(len(payload)) + " byte payload"
+	}
+}
+
+func (d *Dissector) dissectSubscribe(event *DissectorEvent, payload []byte) {
+	if len(payload) < 5 {
+		event.addAnomaly("Subscribe payload size " + strconv.Itoa(len(payload)) + " is too small for connection string header")
+		return
+	}
+
+	flags := DataPacketFlagsEnum(payload[0])
+	length := int(binary.BigEndian.Uint32(payload[1:]))
+	connectionString := payload[5:]
+
+	if length != len(connectionString) {
+		event.addAnomaly("Subscribe connection string size " + strconv.Itoa(length) + " does not match payload size " + strconv.Itoa(len(connectionString)))
+
+		if length < len(connectionString) {
+			connectionString = connectionString[:length]
+		}
+	}
+
+	if flags&DataPacketFlags.Compact == 0 {
+		event.addAnomaly("Subscribe data packet flags do not request compact measurement format")
+	}
+
+	settings := parseConnectionString(d.context.DecodeString(connectionString))
+
+	d.includeTime = parseBoolSetting(settings, "includetime", true)
+	d.useMillisecondResolution = parseBoolSetting(settings, "usemillisecondresolution", false)
+	d.dataChannelPort = 0
+
+	// New subscription starts a new TSSC session
+	d.tsscOutOfSequence = false
+
+	if dataChannel, ok := settings["datachannel"]; ok {
+		if port, err := strconv.ParseUint(parseConnectionString(dataChannel)["localport"], 10, 16); err == nil {
+			d.dataChannelPort = uint16(port)
+		} else {
+			event.addAnomaly("Subscribe data channel does not define a valid local port")
+		}
+	}
+
+	var description strings.Builder
+
+	description.WriteString("includeTime=")
+	description.WriteString(strconv.FormatBool(d.includeTime))
+	description.WriteString(", useMillisecondResolution=")
+	description.WriteString(strconv.FormatBool(d.useMillisecondResolution))
+
+	if d.dataChannelPort > 0 {
+		description.WriteString(", dataChannel=")
+		description.WriteString(strconv.Itoa(int(d.dataChannelPort)))
+	}
+
+	if filterExpression, ok := settings["filterexpression"]; ok {
+		description.WriteString(", filterExpression=")
+		description.WriteString(filterExpression)
+	}
+
+	event.Description = description.String()
+}
+
+func (d *Dissector) dissectResponse(event *DissectorEvent) {
+	frame := event.Frame
+	event.Response = ServerResponseEnum(frame[0])
+	event.Command = ServerCommandEnum(frame[1])
+	payload := frame[responseHeaderSize:]
+
+	// Note: internal payload size is ignored by DataSubscriber, but should still match frame size
+	if size := int(binary.BigEndian.Uint32(frame[2:])); size != len(payload) {
+		event.addAnomaly("payload size mismatch: response header defines " + strconv.Itoa(size) + " bytes, frame contains " + strconv.Itoa(len(payload)))
+	}
+
+	if stream := &d.streams[CaptureDirection.Received]; stream.initial && event.Channel == CaptureChannel.CommandChannel && d.version >= 2 {
+		if event.Response != ServerResponse.NoOP && (event.Command != ServerCommand.DefineOperationalModes || (event.Response != ServerResponse.Succeeded && event.Response != ServerResponse.Failed)) {
+			event.addAnomaly("unexpected initial command / response code: " + event.Command.String() + " / " + event.Response.String() + ", connection is likely not STTP")
+		}
+	}
+
+	switch event.Response {
+	case ServerResponse.Succeeded, ServerResponse.Failed:
+		d.dissectCommandResponse(event, payload)
+	case ServerResponse.DataPacket:
+		d.dissectDataPacket(event, payload)
+	case ServerResponse.UpdateSignalIndexCache:
+		d.dissectSignalIndexCache(event, payload)
+	case ServerResponse.UpdateBaseTimes:
+		if len(payload) != 20 {
+			event.addAnomaly("UpdateBaseTimes payload size " + strconv.Itoa(len(payload)) + " does not match expected size 20")
+
+			if len(payload) < 20 {
+				return
+			}
+		}
+
+		timeIndex := binary.BigEndian.Uint32(payload)
+		d.baseTimeOffsets[0] = int64(binary.BigEndian.Uint64(payload[4:]))
+		d.baseTimeOffsets[1] = int64(binary.BigEndian.Uint64(payload[12:]))
+
+		event.Description = "time index " + strconv.FormatUint(uint64(timeIndex), 10) + ", base time offsets " +
+			ticks.Ticks(d.baseTimeOffsets[0]).String() + " / " + ticks.Ticks(d.baseTimeOffsets[1]).String()
+	case ServerResponse.UpdateCipherKeys:
+		keyIVs, err := decodeCipherKeys(payload)
+
+		if err != nil {
+			event.addAnomaly("failed to parse cipher keys: " + err.Error())
+			return
+		}
+
+		d.keyIVs = keyIVs
+		event.Description = "cipher index " + strconv.Itoa(int(payload[0])) + ", " + strconv.Itoa(len(keyIVs[evenKey][keyIndex])*8) + "-bit keys"
+	case ServerResponse.DataStartTime:
+		if len(payload) != 8 {
+			event.addAnomaly("DataStartTime payload size " + strconv.Itoa(len(payload)) + " does not match expected size 8")
+
+			if len(payload) < 8 {
+				return
+			}
+		}
+
+		event.Description = ticks.Ticks(binary.BigEndian.Uint64(payload)).String()
+	case ServerResponse.ProcessingComplete:
+		event.Description = d.context.DecodeString(payload)
+	case ServerResponse.BufferBlock:
+		if len(payload) < 8 {
+			event.addAnomaly("BufferBlock payload size " + strconv.Itoa(len(payload)) + " is too small for buffer block header")
+			return
+		}
+
+		event.Description = "sequence " + strconv.FormatUint(uint64(binary.BigEndian.Uint32(payload)), 10) + ", " + strconv.Itoa(len(payload)) + " bytes"
+	case ServerResponse.Notify:
+		if len(payload) < 4 {
+			event.addAnomaly("Notify payload size " + strconv.Itoa(len(payload)) + " is too small for notification hash")
+			return
+		}
+
+		event.Description = d.context.DecodeString(payload[4:])
+	case ServerResponse.ConfigurationChanged, ServerResponse.NoOP:
+	default:
+		if !knownServerResponse(event.Response) {
+			event.addAnomaly("unknown server response code " + event.Response.String())
+		}
+
+		event.Description = strconv.Itoa(len(payload)) + " byte payload"
+	}
+}
+
+func (d *Dissector) dissectCommandResponse(event *DissectorEvent, payload []byte) {
+	if !knownServerCommand(event.Command) {
+		event.addAnomaly(event.Response.String() + " response refers to unknown server command code " + event.Command.String())
+	}
+
+	if event.Command != ServerCommand.MetadataRefresh || event.Response != ServerResponse.Succeeded {
+		event.Description = d.context.DecodeString(payload)
+		return
+	}
+
+	event.Description = strconv.Itoa(len(payload)) + " bytes of metadata"
+
+	compressed := isGZip(payload)
+
+	if d.modesDefined && compressed != (d.operationalModes&OperationalModes.CompressMetadata != 0) {
+		event.addAnomaly("metadata compression does not match operational modes")
+	}
+
+	if compressed {
+		metadata, err := decompressGZip(payload)
+
+		if err != nil {
+			event.addAnomaly("failed to decompress metadata: " + err.Error())
+			return
+		}
+
+		event.Description += ", " + strconv.Itoa(len(metadata)) + " bytes decompressed"
+	}
+}
+
+func (d *Dissector) dissectSignalIndexCache(event *DissectorEvent, payload []byte) {
+	var cacheIndex int
+
+	if d.version > 1 {
+		if len(payload) < 1 {
+			event.addAnomaly("UpdateSignalIndexCache payload is missing cache index")
+			return
+		}
+
+		if payload[0] > 0 {
+			cacheIndex = 1
+		}
+
+		payload = payload[1:]
+	}
+
+	compressed := isGZip(payload)
+
+	if d.modesDefined && compressed != (d.operationalModes&OperationalModes.CompressSignalIndexCache != 0) {
+		event.addAnomaly("signal index cache compression does not match operational modes")
+	}
+
+	if compressed {
+		var err error
+
+		if payload, err = decompressGZip(payload); err != nil {
+			event.addAnomaly("failed to decompress signal index cache: " + err.Error())
+			return
+		}
+	}
+
+	if len(payload) >= 4 {
+		if size := int(binary.BigEndian.Uint32(payload)); size != len(payload) {
+			event.addAnomaly("signal index cache size " + strconv.Itoa(size) + " does not match payload size " + strconv.Itoa(len(payload)))
+		}
+	}
+
+	signalIndexCache := NewSignalIndexCache()
+	var subscriberID guid.Guid
+
+	d.context.SwapGuidEndianness = d.SwapGuidEndianness
+
+	if err := signalIndexCache.decode(d.context, payload, &subscriberID); err != nil {
+		event.addAnomaly("failed to parse signal index cache: " + err.Error())
+		return
+	}
+
+	d.signalIndexCache[cacheIndex] = signalIndexCache
+	d.tsscOutOfSequence = false
+
+	event.Description = "cache index " + strconv.Itoa(cacheIndex) + ", " + strconv.Itoa(int(signalIndexCache.Count())) + " signals, subscriber ID " + subscriberID.String()
+}
+
+func (d *Dissector) dissectDataPacket(event *DissectorEvent, payload []byte) {
+	if len(payload) < 1 {
+		event.addAnomaly("DataPacket payload is missing data packet flags")
+		return
+	}
+
+	flags := DataPacketFlagsEnum(payload[0])
+	compressed := flags&DataPacketFlags.Compressed > 0
+	compact := flags&DataPacketFlags.Compact > 0
+
+	if !compressed && !compact {
+		event.addAnomaly("data packet flags 0x" + strconv.FormatInt(int64(flags), 16) + " define neither compact nor compressed encoding")
+		return
+	}
+
+	data := payload[1:]
+
+	if d.keyIVs != nil {
+		var cipherIndex int
+
+		if flags&DataPacketFlags.CipherIndex > 0 {
+			cipherIndex = 1
+		}
+
+		var err error
+
+		if data, err = decipherAES(d.keyIVs[cipherIndex][keyIndex], d.keyIVs[cipherIndex][ivIndex], data); err != nil {
+			event.addAnomaly("failed to decrypt data packet: " + err.Error())
+			return
+		}
+	}
+
+	if len(data) < 4 {
+		event.addAnomaly("DataPacket payload is missing measurement count")
+		return
+	}
+
+	count := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	var cacheIndex int
+
+	if flags&DataPacketFlags.CacheIndex > 0 {
+		cacheIndex = 1
+	}
+
+	signalIndexCache := d.signalIndexCache[cacheIndex]
+	event.Description = strconv.Itoa(count) + " measurements"
+
+	if signalIndexCache == nil {
+		event.addAnomaly("no signal index cache received for cache index " + strconv.Itoa(cacheIndex))
+		return
+	}
+
+	if compressed {
+		d.decodeTSSCMeasurements(event, signalIndexCache, data, count)
+	} else {
+		d.decodeCompactMeasurements(event, signalIndexCache, data, count)
+	}
+
+	if event.Measurements != nil && len(event.Measurements) != count {
+		event.addAnomaly("data packet defines " + strconv.Itoa(count) + " measurements, decoded " + strconv.Itoa(len(event.Measurements)))
+	}
+
+	// Signal indexes not defined in the signal index cache decode to an empty signal ID
+	unknown := 0
+
+	for i := range event.Measurements {
+		if event.Measurements[i].SignalID == guid.Empty {
+			unknown++
+		}
+	}
+
+	if unknown > 0 {
+		event.addAnomaly(strconv.Itoa(unknown) + " measurements reference signal indexes not defined in signal index cache " + strconv.Itoa(cacheIndex))
+	}
+}
+
+func (d *Dissector) decodeTSSCMeasurements(event *DissectorEvent, signalIndexCache *SignalIndexCache, data []byte, count int) {
+	if len(data) < 3 {
+		event.addAnomaly("TSSC data packet is missing version and sequence number")
+		return
+	}
+
+	if data[0] != 85 {
+		event.addAnomaly("TSSC version not recognized: " + strconv.Itoa(int(data[0])))
+		return
+	}
+
+	sequenceNumber := binary.BigEndian.Uint16(data[1:])
+	decoder := signalIndexCache.tsscDecoder
+	event.Description += ", TSSC sequence " + strconv.Itoa(int(sequenceNumber))
+
+	if decoder == nil || sequenceNumber == 0 {
+		if decoder != nil && decoder.SequenceNumber > 0 {
+			event.Description += ", TSSC reset"
+		}
+
+		signalIndexCache.tsscDecoder = tssc.NewDecoder()
+		decoder = signalIndexCache.tsscDecoder
+
+		if sequenceNumber == 0 {
+			d.tsscOutOfSequence = false
+		}
+	}
+
+	if decoder.SequenceNumber != sequenceNumber {
+		// Like DataSubscriber, packets are ignored until reset, so only report first occurrence
+		if !d.tsscOutOfSequence {
+			event.addAnomaly("TSSC is out of sequence, expecting " + strconv.Itoa(int(decoder.SequenceNumber)) + ", received " + strconv.Itoa(int(sequenceNumber)) + ", packets ignored until reset")
+			d.tsscOutOfSequence = true
+		}
+
+		event.Description += ", ignored"
+		return
+	}
+
+	decoder.SetBuffer(data[3:])
+	event.Measurements = make([]Measurement, 0, min(count, len(data)))
+
+	var id int32
+	var timestamp int64
+	var stateFlags uint32
+	var value float32
+
+	for {
+		ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value)
+
+		if err != nil {
+			event.addAnomaly("failed to decode TSSC measurements: " + err.Error())
+			break
+		}
+
+		if !ok {
+			break
+		}
+
+		event.Measurements = append(event.Measurements, Measurement{
+			SignalID:  signalIndexCache.SignalID(id),
+			Value:     float64(value),
+			Timestamp: ticks.Ticks(timestamp),
+			Flags:     StateFlagsEnum(stateFlags),
+		})
+	}
+
+	decoder.SequenceNumber++
+
+	// Do not increment to 0 on roll-over
+	if decoder.SequenceNumber == 0 {
+		decoder.SequenceNumber = 1
+	}
+}
+
+func (d *Dissector) decodeCompactMeasurements(event *DissectorEvent, signalIndexCache *SignalIndexCache, data []byte, count int) {
+	index := 0
+	event.Measurements = make([]Measurement, 0, min(count, len(data)))
+
+	for i := 0; i < count; i++ {
+		compactMeasurement := NewCompactMeasurement(signalIndexCache, d.includeTime, d.useMillisecondResolution, &d.baseTimeOffsets)
+		bytesDecoded, err := compactMeasurement.Decode(data[index:])
+
+		if err != nil {
+			event.addAnomaly("failed to decode compact measurement " + strconv.Itoa(i) + ": " + err.Error())
+			return
+		}
+
+		index += bytesDecoded
+		event.Measurements = append(event.Measurements, compactMeasurement.Measurement)
+	}
+
+	if index < len(data) {
+		event.addAnomaly(strconv.Itoa(len(data)-index) + " unexpected trailing bytes after compact measurements")
+	}
+}
+
+func isGZip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1F && data[1] == 0x8B
+}
+
+func describeOperationalModes(modes OperationalModesEnum) string {
+	var description strings.Builder
+
+	description.WriteString("version ")
+	description.WriteString(strconv.Itoa(int(modes & OperationalModes.VersionMask)))
+
+	switch OperationalEncodingEnum(modes & OperationalModes.EncodingMask) {
+	case OperationalEncoding.UTF8:
+		description.WriteString(", UTF8")
+	case OperationalEncoding.UTF16BE:
+		description.WriteString(", UTF16BE")
+	default:
+		description.WriteString(", UTF16LE")
+	}
+
+	for _, mode := range []struct {
+		flag OperationalModesEnum
+		name string
+	}{
+		{OperationalModes.CompressPayloadData, "compress payload data"},
+		{OperationalModes.CompressSignalIndexCache, "compress signal index cache"},
+		{OperationalModes.CompressMetadata, "compress metadata"},
+		{OperationalModes.ReceiveExternalMetadata, "receive external metadata"},
+		{OperationalModes.ReceiveInternalMetadata, "receive internal metadata"},
+	} {
+		if modes&mode.flag != 0 {
+			description.WriteString(", ")
+			description.WriteString(mode.name)
+		}
+	}
+
+	return description.String()
+}
+
+// parseConnectionString parses the key/value pairs of an STTP connection string, e.g., "a=1;b={c=2;d=3}",
+// into a map with lowercase keys. Braces surrounding nested values are removed.
+func parseConnectionString(connectionString string) map[string]string {
+	settings := make(map[string]string)
+	depth := 0
+	start := 0
+
+	for i := 0; i <= len(connectionString); i++ {
+		if i < len(connectionString) {
+			switch connectionString[i] {
+			case '{':
+				depth++
+			case '}':
+				if depth > 0 {
+					depth--
+				}
+			}
+
+			if connectionString[i] != ';' || depth > 0 {
+				continue
+			}
+		}
+
+		key, value, _ := strings.Cut(connectionString[start:i], "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		start = i + 1
+
+		if len(key) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+			value = value[1 : len(value)-1]
+		}
+
+		settings[key] = value
+	}
+
+	return settings
+}
+
+func parseBoolSetting(settings map[string]string, key string, defaultValue bool) bool {
+	if value, err := strconv.ParseBool(settings[key]); err == nil {
+		return value
+	}
+
+	return defaultValue
+}
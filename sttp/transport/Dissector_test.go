@@ -0,0 +1,281 @@
+//******************************************************************************************************
+//  Dissector_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+func createCommandFrame(commandCode ServerCommandEnum, data []byte) []byte {
+	frame := make([]byte, payloadHeaderSize+1, payloadHeaderSize+1+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(1+len(data)))
+	frame[payloadHeaderSize] = byte(commandCode)
+	return append(frame, data...)
+}
+
+func createPayloadFrame(responseCode ServerResponseEnum, commandCode ServerCommandEnum, data []byte) []byte {
+	frame := createResponseFrame(responseCode, commandCode, data)
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(frame))), frame...)
+}
+
+func createSignalIndexCache(cacheIndex byte, signalIDs []guid.Guid) []byte {
+	data := make([]byte, 24)
+	copy(data[4:], guid.New().ToBytes(false))
+	binary.BigEndian.PutUint32(data[20:], uint32(len(signalIDs)))
+
+	for i, signalID := range signalIDs {
+		source := "PPA"
+		data = binary.BigEndian.AppendUint32(data, uint32(i))
+		data = append(data, signalID.ToBytes(false)...)
+		data = binary.BigEndian.AppendUint32(data, uint32(len(source)))
+		data = append(data, source...)
+		data = binary.BigEndian.AppendUint64(data, uint64(i+1))
+	}
+
+	binary.BigEndian.PutUint32(data, uint32(len(data)))
+	return append([]byte{cacheIndex}, data...)
+}
+
+func createCompactDataPacket(timestamp ticks.Ticks, indexes []int32, values []float32) []byte {
+	data := binary.BigEndian.AppendUint32([]byte{byte(DataPacketFlags.Compact)}, uint32(len(indexes)))
+
+	for i, index := range indexes {
+		data = append(data, 0)
+		data = binary.BigEndian.AppendUint32(data, uint32(index))
+		data = binary.BigEndian.AppendUint32(data, math.Float32bits(values[i]))
+		data = binary.BigEndian.AppendUint64(data, uint64(timestamp))
+	}
+
+	return data
+}
+
+func createTSSCDataPacket(encoder *tssc.Encoder, sequenceNumber uint16, timestamp ticks.Ticks, values []float32) []byte {
+	buffer := make([]byte, 1024)
+	encoder.SetBuffer(buffer)
+
+	for i, value := range values {
+		encoder.TryAddMeasurement(int32(i), int64(timestamp), 0, value)
+	}
+
+	data := binary.BigEndian.AppendUint32([]byte{byte(DataPacketFlags.Compressed)}, uint32(len(values)))
+	data = append(data, 85)
+	data = binary.BigEndian.AppendUint16(data, sequenceNumber)
+	return append(data, buffer[:encoder.FinishBlock()]...)
+}
+
+func dissectInChunks(dissector *Dissector, direction CaptureDirectionEnum, data []byte, chunkSize int) []*DissectorEvent {
+	var events []*DissectorEvent
+
+	for len(data) > 0 {
+		size := min(chunkSize, len(data))
+		events = append(events, dissector.DissectStream(direction, ticks.UtcNow(), data[:size])...)
+		data = data[size:]
+	}
+
+	return events
+}
+
+func hasAnomaly(event *DissectorEvent, text string) bool {
+	for _, anomaly := range event.Anomalies {
+		if strings.Contains(anomaly, text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestDissectorSession(t *testing.T) {
+	dissector := NewDissector()
+	signalIDs := []guid.Guid{guid.New(), guid.New()}
+	timestamp := ticks.UtcNow()
+
+	modes := binary.BigEndian.AppendUint32(nil, uint32(OperationalModes.CompressPayloadData)|uint32(OperationalEncoding.UTF8)|2)
+	connectionString := "includeTime=true;useMillisecondResolution=false;filterExpression={FILTER ActiveMeasurements WHERE SignalType='FREQ'}"
+	subscribe := binary.BigEndian.AppendUint32([]byte{byte(DataPacketFlags.Compact)}, uint32(len(connectionString)))
+	subscribe = append(subscribe, connectionString...)
+
+	var sent []byte
+	sent = append(sent, createCommandFrame(ServerCommand.DefineOperationalModes, modes)...)
+	sent = append(sent, createCommandFrame(ServerCommand.Subscribe, subscribe)...)
+
+	events := dissectInChunks(dissector, CaptureDirection.Sent, sent, 3)
+
+	if len(events) != 2 || events[0].Command != ServerCommand.DefineOperationalModes || events[1].Command != ServerCommand.Subscribe {
+		t.Fatal("TestDissectorSession: unexpected sent events")
+	}
+
+	if len(events[0].Anomalies) > 0 || len(events[1].Anomalies) > 0 {
+		t.Fatal("TestDissectorSession: unexpected anomalies in sent events: " + strings.Join(append(events[0].Anomalies, events[1].Anomalies...), "; "))
+	}
+
+	if !strings.Contains(events[1].Description, "filterExpression=FILTER ActiveMeasurements") {
+		t.Fatal("TestDissectorSession: unexpected Subscribe description: " + events[1].Description)
+	}
+
+	encoder := tssc.NewEncoder()
+
+	var received []byte
+	received = append(received, createPayloadFrame(ServerResponse.Succeeded, ServerCommand.DefineOperationalModes, []byte("modes defined"))...)
+	received = append(received, createPayloadFrame(ServerResponse.Succeeded, ServerCommand.Subscribe, []byte("subscribed"))...)
+	received = append(received, createPayloadFrame(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe, createSignalIndexCache(0, signalIDs))...)
+	received = append(received, createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, createCompactDataPacket(timestamp, []int32{0, 1}, []float32{59.98, 1.5}))...)
+	received = append(received, createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, createTSSCDataPacket(encoder, 0, timestamp, []float32{60.01, 2.5}))...)
+	received = append(received, createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, createTSSCDataPacket(encoder, 1, timestamp+ticks.PerMillisecond, []float32{60.02, 3.5}))...)
+
+	events = dissectInChunks(dissector, CaptureDirection.Received, received, 7)
+
+	if len(events) != 6 {
+		t.Fatal("TestDissectorSession: expected 6 received events, got " + strconv.Itoa(len(events)))
+	}
+
+	for _, event := range events {
+		if len(event.Anomalies) > 0 {
+			t.Fatal("TestDissectorSession: unexpected anomalies in " + event.Response.String() + " event: " + strings.Join(event.Anomalies, "; "))
+		}
+	}
+
+	if dissector.SignalIndexCache(0).Count() != 2 {
+		t.Fatal("TestDissectorSession: expected signal index cache with 2 signals")
+	}
+
+	expected := [][]float32{{59.98, 1.5}, {60.01, 2.5}, {60.02, 3.5}}
+
+	for i, event := range events[3:] {
+		if len(event.Measurements) != 2 {
+			t.Fatal("TestDissectorSession: expected 2 measurements in data packet " + strconv.Itoa(i))
+		}
+
+		for j, measurement := range event.Measurements {
+			if measurement.SignalID != signalIDs[j] || float32(measurement.Value) != expected[i][j] || measurement.Timestamp < timestamp {
+				t.Fatal("TestDissectorSession: unexpected measurement " + strconv.Itoa(j) + " in data packet " + strconv.Itoa(i))
+			}
+		}
+	}
+
+	// Out of sequence TSSC packet is reported once and ignored until reset
+	for i := 0; i < 2; i++ {
+		events = dissector.DissectStream(CaptureDirection.Received, timestamp, createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, createTSSCDataPacket(encoder, 5, timestamp, []float32{1, 2})))
+
+		if len(events) != 1 || events[0].Measurements != nil || hasAnomaly(events[0], "out of sequence") != (i == 0) {
+			t.Fatal("TestDissectorSession: unexpected out of sequence TSSC handling")
+		}
+	}
+
+	if dissector.Flush() != nil {
+		t.Fatal("TestDissectorSession: unexpected incomplete frame data")
+	}
+}
+
+func TestDissectorAnomalies(t *testing.T) {
+	dissector := NewDissector()
+
+	// Initial command must define operational modes
+	events := dissector.DissectStream(CaptureDirection.Sent, 0, createCommandFrame(ServerCommand.MetadataRefresh, nil))
+
+	if len(events) != 1 || !hasAnomaly(events[0], "unexpected initial command code MetadataRefresh") {
+		t.Fatal("TestDissectorAnomalies: expected unexpected initial command anomaly")
+	}
+
+	// Initial response must be for operational modes and have a reasonable size
+	events = dissector.DissectStream(CaptureDirection.Received, 0, createPayloadFrame(ServerResponse.Succeeded, ServerCommand.MetadataRefresh, make([]byte, 10000)))
+
+	if len(events) != 1 || !hasAnomaly(events[0], "unexpected initial command / response code") || !hasAnomaly(events[0], "initial packet size") {
+		t.Fatal("TestDissectorAnomalies: expected initial response anomalies")
+	}
+
+	// Internal payload size must match frame size
+	frame := createPayloadFrame(ServerResponse.Notify, ServerCommand.Subscribe, append([]byte{0, 0, 0, 1}, "notification"...))
+	binary.BigEndian.PutUint32(frame[payloadHeaderSize+2:], 3)
+	events = dissector.DissectStream(CaptureDirection.Received, 0, frame)
+
+	if len(events) != 1 || !hasAnomaly(events[0], "payload size mismatch") || events[0].Description != "notification" {
+		t.Fatal("TestDissectorAnomalies: expected payload size mismatch anomaly")
+	}
+
+	// Unknown response codes and data packets without a signal index cache are reported
+	events = dissector.DissectStream(CaptureDirection.Received, 0, append(
+		createPayloadFrame(ServerResponseEnum(0x9F), ServerCommand.Subscribe, nil),
+		createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, createCompactDataPacket(0, []int32{0}, []float32{1}))...))
+
+	if len(events) != 2 || !hasAnomaly(events[0], "unknown server response code") || !hasAnomaly(events[1], "no signal index cache") {
+		t.Fatal("TestDissectorAnomalies: expected unknown response and missing signal index cache anomalies")
+	}
+
+	// Truncated compact measurements and undefined signal indexes are reported
+	signalIDs := []guid.Guid{guid.New()}
+	packet := createCompactDataPacket(ticks.UtcNow(), []int32{0, 7}, []float32{1, 2})
+	binary.BigEndian.PutUint32(packet[1:], 3)
+
+	events = dissector.DissectStream(CaptureDirection.Received, 0, append(
+		createPayloadFrame(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe, createSignalIndexCache(0, signalIDs)),
+		createPayloadFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, packet)...))
+
+	if len(events) != 2 || len(events[0].Anomalies) > 0 || len(events[1].Measurements) != 2 ||
+		!hasAnomaly(events[1], "failed to decode compact measurement 2") || !hasAnomaly(events[1], "1 measurements reference signal indexes") {
+		t.Fatal("TestDissectorAnomalies: expected compact measurement anomalies")
+	}
+
+	// Dissector finds next frame boundary after missing data
+	gap := dissector.Gap(CaptureDirection.Received, 0, 100)
+
+	if !hasAnomaly(gap, "100 bytes missing") {
+		t.Fatal("TestDissectorAnomalies: expected missing data anomaly")
+	}
+
+	data := append([]byte{0xFF, 0x00, 0x01, 0x02, 0x03}, createPayloadFrame(ServerResponse.ProcessingComplete, ServerCommand.Subscribe, []byte("complete"))...)
+	events = dissectInChunks(dissector, CaptureDirection.Received, append(data, 0, 0, 0), 4)
+
+	if len(events) != 2 || events[0].Frame != nil || !strings.Contains(events[0].Description, "skipping 5 bytes") || events[1].Description != "complete" {
+		t.Fatal("TestDissectorAnomalies: expected resynchronization after missing data")
+	}
+
+	if events = dissector.Flush(); len(events) != 1 || !hasAnomaly(events[0], "3 bytes of incomplete frame") {
+		t.Fatal("TestDissectorAnomalies: expected incomplete frame anomaly")
+	}
+
+	// UDP data channel datagrams have no payload size header
+	event := dissector.DissectDatagram(0, createResponseFrame(ServerResponse.DataPacket, ServerCommand.Subscribe, []byte{0x01, 0, 0, 0, 0}))
+
+	if event.Channel != CaptureChannel.DataChannel || !hasAnomaly(event, "define neither compact nor compressed") {
+		t.Fatal("TestDissectorAnomalies: expected invalid data packet flags anomaly")
+	}
+}
+
+func TestParseConnectionString(t *testing.T) {
+	settings := parseConnectionString("includeTime=false; dataChannel={localport=9500;interface=0.0.0.0}; filterExpression={FILTER ActiveMeasurements WHERE ID='PPA:1'};;")
+
+	if len(settings) != 3 || settings["includetime"] != "false" || settings["filterexpression"] != "FILTER ActiveMeasurements WHERE ID='PPA:1'" ||
+		parseConnectionString(settings["datachannel"])["localport"] != "9500" {
+		t.Fatal("TestParseConnectionString: unexpected settings")
+	}
+}
@@ -0,0 +1,369 @@
+//******************************************************************************************************
+//  LoadGenerator.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// WaveformEnum defines the type of the Waveform enumeration.
+type WaveformEnum byte
+
+// Waveform is an enumeration of the signal shapes published by a LoadGenerator.
+var Waveform = struct {
+	// Sine defines phasor magnitude and angle pairs of a sinusoidal signal with an oscillating frequency
+	// deviation. When the signal count is odd, the last signal is the frequency.
+	Sine WaveformEnum
+	// Ramp defines values that ramp linearly from 0 to 100 every ten seconds, offset by signal.
+	Ramp WaveformEnum
+	// Noise defines normally distributed random values with a mean of 100 and a standard deviation of 1.
+	Noise WaveformEnum
+}{
+	Sine:  0,
+	Ramp:  1,
+	Noise: 2,
+}
+
+// String gets the Waveform enumeration value as a string.
+func (we WaveformEnum) String() string {
+	switch we {
+	case Waveform.Sine:
+		return "Sine"
+	case Waveform.Ramp:
+		return "Ramp"
+	case Waveform.Noise:
+		return "Noise"
+	default:
+		return "0x" + strconv.FormatInt(int64(we), 16)
+	}
+}
+
+// loadGeneratorSource defines the device acronym and measurement key source of generated signals.
+const loadGeneratorSource = "LOADGEN"
+
+// LoadGenerator is a DataPublisher that generates synthetic measurements at a fixed frame rate, e.g., to
+// benchmark DataSubscriber decoding at realistic PMU rates over loopback. Each subscription receives all
+// generated signals, filter expressions are ignored. Data packets use TSSC encoding when the subscriber
+// requests payload compression and compact encoding otherwise. When a subscriber enables time
+// reasonability checks, measurements with timestamps outside of the lag / lead time tolerances are
+// discarded, so ClockSkew can be used to exercise the checks.
+type LoadGenerator struct {
+	// SignalCount defines the number of signals published in each frame.
+	SignalCount int
+
+	// FramesPerSecond defines the number of frames published per second.
+	FramesPerSecond int
+
+	// Waveform defines the shape of the generated signal values.
+	Waveform WaveformEnum
+
+	// QualityFlagRate defines the fraction, from 0 to 1, of measurements published with QualityFlags.
+	QualityFlagRate float64
+
+	// QualityFlags defines the state flags of measurements selected by QualityFlagRate.
+	QualityFlags StateFlagsEnum
+
+	// ClockSkew defines the offset applied to published timestamps relative to the frame time.
+	ClockSkew time.Duration
+
+	// Seed defines the seed for random noise values and quality flag selection.
+	Seed int64
+
+	publisher       *DataPublisher
+	signals         []*MeasurementMetadata
+	signalIDs       []guid.Guid
+	stop            chan struct{}
+	waitGroup       sync.WaitGroup
+	framesPublished uint64
+}
+
+// NewLoadGenerator creates a new LoadGenerator that publishes 100 sine phasor signals at 30 frames per second.
+func NewLoadGenerator() *LoadGenerator {
+	return &LoadGenerator{
+		SignalCount:     100,
+		FramesPerSecond: 30,
+		Waveform:        Waveform.Sine,
+		QualityFlags:    StateFlags.BadData,
+		Seed:            1,
+		publisher:       NewDataPublisher(),
+	}
+}
+
+// Start begins listening for DataSubscriber connections on the specified TCP address, e.g.,
+// "127.0.0.1:0" to listen on an available loopback port, see Address, and starts publishing.
+func (lg *LoadGenerator) Start(address string) error {
+	if lg.SignalCount <= 0 || lg.FramesPerSecond <= 0 {
+		return errors.New("load generator signal count and frames per second must be greater than zero")
+	}
+
+	if lg.stop != nil {
+		return errors.New("load generator is already started")
+	}
+
+	lg.signals = make([]*MeasurementMetadata, lg.SignalCount)
+	lg.signalIDs = make([]guid.Guid, lg.SignalCount)
+
+	for i := range lg.signals {
+		lg.signalIDs[i] = guid.New()
+		lg.signals[i] = &MeasurementMetadata{SignalID: lg.signalIDs[i], Source: loadGeneratorSource, ID: uint64(i + 1), Multiplier: 1.0}
+	}
+
+	lg.publisher.SubscriptionRequestedCallback = func(*SubscriberConnection, string) ([]*MeasurementMetadata, error) {
+		return lg.signals, nil
+	}
+
+	lg.publisher.SetMetadata(lg.generateMetadata())
+
+	if err := lg.publisher.Start(address); err != nil {
+		return err
+	}
+
+	lg.stop = make(chan struct{})
+	lg.waitGroup.Add(1)
+	go lg.publish(lg.stop)
+
+	return nil
+}
+
+// Stop stops publishing and closes the listener and all subscriber connections.
+func (lg *LoadGenerator) Stop() {
+	if lg.stop == nil {
+		return
+	}
+
+	close(lg.stop)
+	lg.waitGroup.Wait()
+	lg.publisher.Stop()
+	lg.stop = nil
+}
+
+// Address gets the TCP address the LoadGenerator is listening on. Returns an empty string when not started.
+func (lg *LoadGenerator) Address() string {
+	return lg.publisher.Address()
+}
+
+// Publisher gets the DataPublisher used by the LoadGenerator, e.g., to define callbacks before
+// Start or to send notifications.
+func (lg *LoadGenerator) Publisher() *DataPublisher {
+	return lg.publisher
+}
+
+// SignalIDs gets the IDs of the generated signals, in signal index order.
+func (lg *LoadGenerator) SignalIDs() []guid.Guid {
+	return lg.signalIDs
+}
+
+// FramesPublished gets the total number of frames generated since Start.
+func (lg *LoadGenerator) FramesPublished() uint64 {
+	return atomic.LoadUint64(&lg.framesPublished)
+}
+
+// MeasurementsPublished gets the total number of measurements published to all subscribers.
+func (lg *LoadGenerator) MeasurementsPublished() uint64 {
+	return lg.publisher.TotalMeasurementsSent()
+}
+
+// MeasurementsDiscarded gets the total number of measurements discarded by time reasonability checks.
+func (lg *LoadGenerator) MeasurementsDiscarded() uint64 {
+	return lg.publisher.TotalMeasurementsDiscarded()
+}
+
+// BytesSent gets the total number of bytes sent to all subscribers.
+func (lg *LoadGenerator) BytesSent() uint64 {
+	return lg.publisher.TotalBytesSent()
+}
+
+// publish generates frames at the generator frame rate, aligned to the top of the second, until stopped.
+func (lg *LoadGenerator) publish(stop <-chan struct{}) {
+	defer lg.waitGroup.Done()
+
+	random := rand.New(rand.NewSource(lg.Seed))
+	measurements := make([]Measurement, lg.SignalCount)
+	framesPerSecond := int64(lg.FramesPerSecond)
+	start := time.Now().Truncate(time.Second)
+	frameIndex := int64(time.Since(start))*framesPerSecond/int64(time.Second) + 1
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for ; ; frameIndex++ {
+		frameTime := start.Add(time.Duration(frameIndex * int64(time.Second) / framesPerSecond))
+		timer.Reset(time.Until(frameTime))
+
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		}
+
+		timestamp := ticks.FromTime(frameTime) + ticks.Ticks(lg.ClockSkew.Nanoseconds()/100)
+		seconds := float64(frameTime.UnixNano()) / float64(time.Second)
+
+		for i, signalID := range lg.signalIDs {
+			measurements[i] = Measurement{
+				SignalID:  signalID,
+				Value:     lg.value(i, seconds, random),
+				Timestamp: timestamp,
+			}
+
+			if lg.QualityFlagRate > 0 && random.Float64() < lg.QualityFlagRate {
+				measurements[i].Flags = lg.QualityFlags
+			}
+		}
+
+		lg.publisher.PublishMeasurements(measurements)
+		atomic.AddUint64(&lg.framesPublished, 1)
+	}
+}
+
+// signalKind gets the signal reference acronym and signal type acronym for the signal at the specified index.
+func (lg *LoadGenerator) signalKind(index int) (string, string) {
+	if lg.Waveform != Waveform.Sine {
+		return "AV", "ALOG"
+	}
+
+	if index == lg.SignalCount-1 && lg.SignalCount%2 == 1 {
+		return "FQ", "FREQ"
+	}
+
+	if index%2 == 0 {
+		return "PM", "VPHM"
+	}
+
+	return "PA", "VPHA"
+}
+
+// generateMetadata generates the XML metadata for the generated signals, including a
+// device record and, for the Sine waveform, phasor records.
+func (lg *LoadGenerator) generateMetadata() []byte {
+	var builder strings.Builder
+	updatedOn := time.Now().UTC().Format("2006-01-02T15:04:05Z")
+
+	column := func(name, dataType string) string {
+		return `<xs:element name="` + name + `" ` + dataType + ` minOccurs="0" />`
+	}
+
+	table := func(name string, columns ...string) string {
+		return `<xs:element name="` + name + `"><xs:complexType><xs:sequence>` + strings.Join(columns, "") + `</xs:sequence></xs:complexType></xs:element>`
+	}
+
+	row := func(name string, values ...string) {
+		builder.WriteString("<" + name + ">")
+
+		for i := 0; i < len(values); i += 2 {
+			builder.WriteString("<" + values[i] + ">" + values[i+1] + "</" + values[i] + ">")
+		}
+
+		builder.WriteString("</" + name + ">\n")
+	}
+
+	guidType := `ext:DataType="System.Guid" type="xs:string"`
+
+	builder.WriteString(`<?xml version="1.0" standalone="yes"?>` + "\n<DataSet>\n")
+	builder.WriteString(`<xs:schema id="DataSet" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:ext="urn:schemas-microsoft-com:xml-msdata">`)
+	builder.WriteString(`<xs:element name="DataSet"><xs:complexType><xs:choice minOccurs="0" maxOccurs="unbounded">`)
+	builder.WriteString(table("DeviceDetail", column("UniqueID", guidType), column("Acronym", `type="xs:string"`), column("Name", `type="xs:string"`),
+		column("ProtocolName", `type="xs:string"`), column("FramesPerSecond", `type="xs:int"`), column("Enabled", `type="xs:boolean"`), column("UpdatedOn", `type="xs:dateTime"`)))
+	builder.WriteString(table("MeasurementDetail", column("DeviceAcronym", `type="xs:string"`), column("ID", `type="xs:string"`), column("SignalID", guidType),
+		column("PointTag", `type="xs:string"`), column("SignalReference", `type="xs:string"`), column("SignalAcronym", `type="xs:string"`),
+		column("PhasorSourceIndex", `type="xs:int"`), column("Description", `type="xs:string"`), column("Internal", `type="xs:boolean"`),
+		column("Enabled", `type="xs:boolean"`), column("UpdatedOn", `type="xs:dateTime"`)))
+	builder.WriteString(table("PhasorDetail", column("ID", `type="xs:int"`), column("DeviceAcronym", `type="xs:string"`), column("Label", `type="xs:string"`),
+		column("Type", `type="xs:string"`), column("Phase", `type="xs:string"`), column("SourceIndex", `type="xs:int"`), column("UpdatedOn", `type="xs:dateTime"`)))
+	builder.WriteString(table("SchemaVersion", column("VersionNumber", `type="xs:int"`)))
+	builder.WriteString("</xs:choice></xs:complexType></xs:element></xs:schema>\n")
+
+	row("DeviceDetail", "UniqueID", guid.New().String()[1:37], "Acronym", loadGeneratorSource, "Name", "STTP Load Generator",
+		"ProtocolName", "STTP", "FramesPerSecond", strconv.Itoa(lg.FramesPerSecond), "Enabled", "true", "UpdatedOn", updatedOn)
+
+	for i, signalID := range lg.signalIDs {
+		kind, acronym := lg.signalKind(i)
+		phasorIndex := ""
+		position := i + 1
+
+		if kind == "PM" || kind == "PA" {
+			position = i/2 + 1
+			phasorIndex = strconv.Itoa(position)
+		} else if kind == "FQ" {
+			position = 0
+		}
+
+		signalReference := loadGeneratorSource + "-" + kind
+
+		if position > 0 {
+			signalReference += strconv.Itoa(position)
+		}
+
+		row("MeasurementDetail", "DeviceAcronym", loadGeneratorSource, "ID", loadGeneratorSource+":"+strconv.Itoa(i+1), "SignalID", signalID.String()[1:37],
+			"PointTag", loadGeneratorSource+":"+acronym+strconv.Itoa(i+1), "SignalReference", signalReference, "SignalAcronym", acronym,
+			"PhasorSourceIndex", phasorIndex, "Description", lg.Waveform.String()+" waveform signal "+strconv.Itoa(i+1),
+			"Internal", "true", "Enabled", "true", "UpdatedOn", updatedOn)
+	}
+
+	if lg.Waveform == Waveform.Sine {
+		for i := 1; i <= lg.SignalCount/2; i++ {
+			row("PhasorDetail", "ID", strconv.Itoa(i), "DeviceAcronym", loadGeneratorSource, "Label", "Phasor "+strconv.Itoa(i),
+				"Type", "V", "Phase", "+", "SourceIndex", strconv.Itoa(i), "UpdatedOn", updatedOn)
+		}
+	}
+
+	row("SchemaVersion", "VersionNumber", "1")
+	builder.WriteString("</DataSet>\n")
+
+	return []byte(builder.String())
+}
+
+// value gets the generated value of the signal at the specified index for a time in seconds.
+func (lg *LoadGenerator) value(index int, seconds float64, random *rand.Rand) float64 {
+	switch lg.Waveform {
+	case Waveform.Ramp:
+		return math.Mod(seconds*10+float64(index), 100)
+	case Waveform.Noise:
+		return 100 + random.NormFloat64()
+	}
+
+	// Frequency deviates from nominal by up to 20 mHz with a 10 second period
+	const nominal, deviation, period = 60.0, 0.02, 10.0
+	cycle := 2 * math.Pi * seconds / period
+
+	switch kind, _ := lg.signalKind(index); kind {
+	case "FQ":
+		return nominal + deviation*math.Sin(cycle)
+	case "PM":
+		return 288675 * (1 + 0.01*math.Sin(cycle+float64(index)))
+	}
+
+	// Angle advances by the integral of the frequency deviation, phasors are 120 degrees apart
+	angle := -120*float64(index/2%3) + 360*deviation*period/(2*math.Pi)*(1-math.Cos(cycle))
+	return math.Remainder(angle, 360)
+}
@@ -0,0 +1,202 @@
+//******************************************************************************************************
+//  LoadGenerator_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"encoding/binary"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+func connectLoadSubscriber(t *testing.T, testName string, generator *LoadGenerator, configure func(*DataSubscriber)) *DataSubscriber {
+	host, portText, _ := net.SplitHostPort(generator.Address())
+	port, _ := strconv.Atoi(portText)
+
+	subscriber := NewDataSubscriber()
+	configure(subscriber)
+
+	if err := subscriber.Connect(host, uint16(port)); err != nil {
+		t.Fatal(testName + ": failed to connect to load generator: " + err.Error())
+	}
+
+	// Wait for operational modes to be accepted before subscribing
+	for deadline := time.Now().Add(5 * time.Second); !subscriber.IsValidated(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal(testName + ": timeout waiting for subscriber validation")
+		}
+	}
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatal(testName + ": failed to subscribe: " + err.Error())
+	}
+
+	return subscriber
+}
+
+func TestLoadGeneratorSubscription(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		generator := NewLoadGenerator()
+		generator.SignalCount = 21
+		generator.FramesPerSecond = 100
+		generator.QualityFlagRate = 0.5
+
+		if err := generator.Start("127.0.0.1:0"); err != nil {
+			t.Fatal("TestLoadGeneratorSubscription: failed to start load generator: " + err.Error())
+		}
+
+		var mutex sync.Mutex
+		received := make(map[guid.Guid]int)
+		flagged := 0
+		var frequency float64
+		var latest ticks.Ticks
+
+		subscriber := connectLoadSubscriber(t, "TestLoadGeneratorSubscription", generator, func(ds *DataSubscriber) {
+			ds.CompressPayloadData = compressed
+			ds.Subscription().UseMillisecondResolution = !compressed
+
+			ds.NewMeasurementsCallback = func(measurements *[]Measurement) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				for _, measurement := range *measurements {
+					received[measurement.SignalID]++
+
+					if measurement.Flags != 0 {
+						flagged++
+					}
+
+					if measurement.SignalID == generator.SignalIDs()[20] {
+						frequency = measurement.Value
+					}
+
+					latest = measurement.Timestamp
+				}
+			}
+		})
+
+		time.Sleep(300 * time.Millisecond)
+		subscriber.Dispose()
+		generator.Stop()
+
+		mutex.Lock()
+
+		if len(received) != generator.SignalCount {
+			t.Fatalf("TestLoadGeneratorSubscription: compressed=%v, expected %d distinct signals, received: %d", compressed, generator.SignalCount, len(received))
+		}
+
+		if flagged == 0 {
+			t.Fatalf("TestLoadGeneratorSubscription: compressed=%v, expected injected quality flags", compressed)
+		}
+
+		if math.Abs(frequency-60) > 0.05 {
+			t.Fatalf("TestLoadGeneratorSubscription: compressed=%v, unexpected frequency value: %f", compressed, frequency)
+		}
+
+		if offset := latest.ToTime().Sub(time.Now()); offset > time.Second || offset < -time.Second {
+			t.Fatalf("TestLoadGeneratorSubscription: compressed=%v, unexpected timestamp offset: %s", compressed, offset)
+		}
+
+		mutex.Unlock()
+
+		if published := generator.MeasurementsPublished(); generator.FramesPublished() == 0 || published == 0 || published%uint64(generator.SignalCount) != 0 {
+			t.Fatalf("TestLoadGeneratorSubscription: compressed=%v, unexpected published statistics", compressed)
+		}
+	}
+}
+
+func TestLoadGeneratorTimeReasonability(t *testing.T) {
+	generator := NewLoadGenerator()
+	generator.SignalCount = 4
+	generator.FramesPerSecond = 100
+	generator.ClockSkew = -time.Minute
+
+	if err := generator.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("TestLoadGeneratorTimeReasonability: failed to start load generator: " + err.Error())
+	}
+
+	defer generator.Stop()
+
+	subscriber := connectLoadSubscriber(t, "TestLoadGeneratorTimeReasonability", generator, func(ds *DataSubscriber) {
+		subscription := ds.Subscription()
+		subscription.EnableTimeReasonabilityCheck = true
+		subscription.UseLocalClockAsRealTime = true
+		subscription.LagTime = 5.0
+	})
+
+	time.Sleep(200 * time.Millisecond)
+	subscriber.Dispose()
+
+	if generator.MeasurementsDiscarded() == 0 || generator.MeasurementsPublished() != 0 {
+		t.Fatal("TestLoadGeneratorTimeReasonability: expected skewed measurements to be discarded")
+	}
+
+	if subscriber.TotalMeasurementsReceived() != 0 {
+		t.Fatal("TestLoadGeneratorTimeReasonability: expected no measurements to be received")
+	}
+}
+
+func TestSignalIndexCacheEncoding(t *testing.T) {
+	source := NewSignalIndexCache()
+	subscriberID := guid.New()
+
+	for i := 0; i < 3; i++ {
+		source.addRecord(nil, int32(i*2), guid.New(), "PPA", uint64(i+10), 1)
+	}
+
+	buffer := source.encode(&SubscriberConnection{encoding: OperationalEncoding.UTF8}, subscriberID, false)
+
+	// Header and trailer are 28 bytes, each record is 32 bytes plus its source
+	if len(buffer) != 28+3*(32+3) || binary.BigEndian.Uint32(buffer) != uint32(len(buffer)) {
+		t.Fatalf("TestSignalIndexCacheEncoding: unexpected encoded length: %d", len(buffer))
+	}
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	target := NewSignalIndexCache()
+	var decodedID guid.Guid
+
+	if err := target.decode(subscriber, buffer, &decodedID); err != nil {
+		t.Fatal("TestSignalIndexCacheEncoding: failed to decode signal index cache: " + err.Error())
+	}
+
+	if decodedID != subscriberID || target.Count() != source.Count() {
+		t.Fatal("TestSignalIndexCacheEncoding: unexpected decoded subscriber ID or record count")
+	}
+
+	for i := int32(0); i < 6; i += 2 {
+		signalID, sourceName, id, found := target.Record(i)
+
+		if !found || signalID != source.SignalID(i) || sourceName != "PPA" || id != uint64(i/2+10) {
+			t.Fatalf("TestSignalIndexCacheEncoding: unexpected record for signal index %d", i)
+		}
+	}
+}
@@ -74,6 +74,12 @@ func (mm *MeasurementMetadata) ParseSignalReference() (source string, signalKind
 	if len(parts) > 1 {
 		lastIndex := len(parts) - 1
 		typeInfo := parts[lastIndex]
+
+		// Type info must start with a two character signal kind acronym, e.g., "PA" in "SHELBY-PA1"
+		if len(typeInfo) < 2 {
+			return "", SignalKind.Unknown, 0
+		}
+
 		signalKind = ParseSignalKindAcronym(typeInfo[:2])
 		position, _ = strconv.Atoi(typeInfo[2:])
 		source = strings.Join(parts[:lastIndex], "-")
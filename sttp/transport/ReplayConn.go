@@ -0,0 +1,232 @@
+//******************************************************************************************************
+//  ReplayConn.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// ReplayConn is a net.Conn that plays back the frames received in an STTP session capture as a
+// DataPublisher command channel, e.g., to reproduce decoding issues with a DataSubscriber using
+// the ConnectConn function. Frames received over a UDP data channel are played back over the
+// command channel. Frames written to the connection, i.e., subscriber commands, are discarded.
+// Read returns io.EOF after the last captured frame has been played back.
+type ReplayConn struct {
+	reader  *CaptureReader
+	closer  io.Closer
+	name    string
+	speed   float64
+	pending []byte
+
+	startTime      time.Time
+	firstTimestamp ticks.Ticks
+	framesReplayed uint64
+
+	closed    chan struct{}
+	closeOnce sync.Once
+	readMutex sync.Mutex
+}
+
+// NewReplayConn creates a new ReplayConn that plays back the session capture read from the specified
+// reader. The speed parameter controls playback timing relative to the original frame arrival times,
+// e.g., 1.0 for original speed or 10.0 for ten times faster; set to zero to play back frames without
+// delay. If the reader is an io.Closer, it will be closed when the connection is closed.
+func NewReplayConn(reader io.Reader, speed float64) (*ReplayConn, error) {
+	captureReader, err := NewCaptureReader(reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &ReplayConn{
+		reader: captureReader,
+		name:   "replay",
+		speed:  speed,
+		closed: make(chan struct{}),
+	}
+
+	if closer, ok := reader.(io.Closer); ok {
+		rc.closer = closer
+	}
+
+	return rc, nil
+}
+
+// OpenReplayConn creates a new ReplayConn that plays back the specified session capture file.
+// See NewReplayConn for a description of the speed parameter.
+func OpenReplayConn(fileName string, speed float64) (*ReplayConn, error) {
+	file, err := os.Open(fileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := NewReplayConn(file, speed)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	rc.name = fileName
+
+	return rc, nil
+}
+
+// FramesReplayed gets the number of received frames that have been played back.
+func (rc *ReplayConn) FramesReplayed() uint64 {
+	return atomic.LoadUint64(&rc.framesReplayed)
+}
+
+// Read reads played back command channel data, waiting for the next captured frame as needed.
+func (rc *ReplayConn) Read(buffer []byte) (int, error) {
+	rc.readMutex.Lock()
+	defer rc.readMutex.Unlock()
+
+	for len(rc.pending) == 0 {
+		if err := rc.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+
+	count := copy(buffer, rc.pending)
+	rc.pending = rc.pending[count:]
+
+	return count, nil
+}
+
+func (rc *ReplayConn) nextFrame() error {
+	select {
+	case <-rc.closed:
+		return net.ErrClosed
+	default:
+	}
+
+	frame, err := rc.reader.ReadFrame()
+
+	if err != nil {
+		return err
+	}
+
+	if frame.Direction != CaptureDirection.Received {
+		return nil
+	}
+
+	if rc.framesReplayed == 0 {
+		rc.startTime = time.Now()
+		rc.firstTimestamp = frame.Timestamp
+	} else if rc.speed > 0 {
+		// Wait until frame is due relative to the first frame
+		elapsed := time.Duration(float64(frame.Timestamp-rc.firstTimestamp) * 100 / rc.speed)
+
+		if delay := time.Until(rc.startTime.Add(elapsed)); delay > 0 {
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-timer.C:
+			case <-rc.closed:
+				timer.Stop()
+				return net.ErrClosed
+			}
+		}
+	}
+
+	atomic.AddUint64(&rc.framesReplayed, 1)
+
+	// Command channel frames are preceded by a payload header containing the frame size
+	rc.pending = make([]byte, payloadHeaderSize+len(frame.Data))
+	binary.BigEndian.PutUint32(rc.pending, uint32(len(frame.Data)))
+	copy(rc.pending[payloadHeaderSize:], frame.Data)
+
+	return nil
+}
+
+// Write discards the data written to the connection.
+func (rc *ReplayConn) Write(buffer []byte) (int, error) {
+	select {
+	case <-rc.closed:
+		return 0, net.ErrClosed
+	default:
+		return len(buffer), nil
+	}
+}
+
+// Close closes the connection, any blocked Read operation will be unblocked and return an error.
+func (rc *ReplayConn) Close() error {
+	var err error
+
+	rc.closeOnce.Do(func() {
+		close(rc.closed)
+
+		if rc.closer != nil {
+			err = rc.closer.Close()
+		}
+	})
+
+	return err
+}
+
+// LocalAddr returns the local network address of the replay connection.
+func (rc *ReplayConn) LocalAddr() net.Addr {
+	return replayAddr("replay")
+}
+
+// RemoteAddr returns the remote network address of the replay connection, i.e., the capture file name.
+func (rc *ReplayConn) RemoteAddr() net.Addr {
+	return replayAddr(rc.name)
+}
+
+// SetDeadline is not supported by ReplayConn; the deadline is ignored.
+func (rc *ReplayConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+// SetReadDeadline is not supported by ReplayConn; the deadline is ignored.
+func (rc *ReplayConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+// SetWriteDeadline is not supported by ReplayConn; the deadline is ignored.
+func (rc *ReplayConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// replayAddr is the net.Addr of a ReplayConn.
+type replayAddr string
+
+func (ra replayAddr) Network() string {
+	return "replay"
+}
+
+func (ra replayAddr) String() string {
+	return string(ra)
+}
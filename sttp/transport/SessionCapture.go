@@ -0,0 +1,244 @@
+//******************************************************************************************************
+//  SessionCapture.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// Session capture files start with an 8-byte header, i.e., the "STTPCAP" signature followed by a
+// format version byte, and then contain a sequence of frames. Each frame is a 14-byte big-endian
+// frame header, i.e., direction (1 byte), channel (1 byte), arrival timestamp ticks (8 bytes) and
+// frame length (4 bytes), followed by the frame bytes. Received frames are the server responses as
+// passed to processServerResponse, sent frames are the server commands without the payload header.
+// Data channel frames are limited to maxPacketSize, command channel frames, e.g., metadata responses,
+// may be larger but are limited to maxCaptureFrameSize.
+const (
+	captureSignature       = "STTPCAP"
+	captureVersion         = 1
+	captureFileHeaderSize  = 8
+	captureFrameHeaderSize = 14
+	maxCaptureFrameSize    = 64 * 1024 * 1024
+)
+
+// CaptureDirectionEnum defines the type of the CaptureDirection enumeration.
+type CaptureDirectionEnum byte
+
+// CaptureDirection is an enumeration of the possible directions of a captured frame.
+var CaptureDirection = struct {
+	// Received defines a frame received from the DataPublisher.
+	Received CaptureDirectionEnum
+	// Sent defines a frame sent to the DataPublisher.
+	Sent CaptureDirectionEnum
+}{
+	Received: 0,
+	Sent:     1,
+}
+
+// String gets the CaptureDirection enumeration value as a string.
+func (cde CaptureDirectionEnum) String() string {
+	switch cde {
+	case CaptureDirection.Received:
+		return "Received"
+	case CaptureDirection.Sent:
+		return "Sent"
+	default:
+		return "0x" + strconv.FormatInt(int64(cde), 16)
+	}
+}
+
+// CaptureChannelEnum defines the type of the CaptureChannel enumeration.
+type CaptureChannelEnum byte
+
+// CaptureChannel is an enumeration of the possible channels of a captured frame.
+var CaptureChannel = struct {
+	// CommandChannel defines a frame transferred over the TCP command channel.
+	CommandChannel CaptureChannelEnum
+	// DataChannel defines a frame received over the UDP data channel.
+	DataChannel CaptureChannelEnum
+}{
+	CommandChannel: 0,
+	DataChannel:    1,
+}
+
+// String gets the CaptureChannel enumeration value as a string.
+func (cce CaptureChannelEnum) String() string {
+	switch cce {
+	case CaptureChannel.CommandChannel:
+		return "CommandChannel"
+	case CaptureChannel.DataChannel:
+		return "DataChannel"
+	default:
+		return "0x" + strconv.FormatInt(int64(cce), 16)
+	}
+}
+
+// CapturedFrame represents a frame of STTP session traffic.
+type CapturedFrame struct {
+	// Direction defines if the frame was received from, or sent to, the DataPublisher.
+	Direction CaptureDirectionEnum
+
+	// Channel defines the channel over which the frame was transferred.
+	Channel CaptureChannelEnum
+
+	// Timestamp defines the UTC arrival, or send, time of the frame.
+	Timestamp ticks.Ticks
+
+	// Data defines the frame bytes. For received frames, this starts with the response code,
+	// command code and payload size, i.e., the server response header.
+	Data []byte
+}
+
+// CaptureWriter writes captured STTP session frames to a stream. Writes are safe for concurrent use.
+type CaptureWriter struct {
+	writer *bufio.Writer
+	header [captureFrameHeaderSize]byte
+	mutex  sync.Mutex
+}
+
+// NewCaptureWriter creates a new CaptureWriter that writes captured frames to the specified writer.
+// The capture file header is written immediately.
+func NewCaptureWriter(writer io.Writer) (*CaptureWriter, error) {
+	cw := &CaptureWriter{writer: bufio.NewWriter(writer)}
+
+	if _, err := cw.writer.WriteString(captureSignature); err != nil {
+		return nil, err
+	}
+
+	if err := cw.writer.WriteByte(captureVersion); err != nil {
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+// WriteFrame writes the specified frame to the capture stream.
+func (cw *CaptureWriter) WriteFrame(frame *CapturedFrame) error {
+	if err := validateCaptureFrameSize(frame.Channel, uint64(len(frame.Data))); err != nil {
+		return err
+	}
+
+	cw.mutex.Lock()
+	defer cw.mutex.Unlock()
+
+	cw.header[0] = byte(frame.Direction)
+	cw.header[1] = byte(frame.Channel)
+	binary.BigEndian.PutUint64(cw.header[2:], uint64(frame.Timestamp))
+	binary.BigEndian.PutUint32(cw.header[10:], uint32(len(frame.Data)))
+
+	if _, err := cw.writer.Write(cw.header[:]); err != nil {
+		return err
+	}
+
+	_, err := cw.writer.Write(frame.Data)
+	return err
+}
+
+// Flush writes any buffered frames to the underlying writer.
+func (cw *CaptureWriter) Flush() error {
+	cw.mutex.Lock()
+	defer cw.mutex.Unlock()
+
+	return cw.writer.Flush()
+}
+
+// CaptureReader reads captured STTP session frames from a stream.
+type CaptureReader struct {
+	reader *bufio.Reader
+	header [captureFrameHeaderSize]byte
+}
+
+// NewCaptureReader creates a new CaptureReader that reads captured frames from the specified reader.
+// An error is returned if the stream does not start with a valid capture file header.
+func NewCaptureReader(reader io.Reader) (*CaptureReader, error) {
+	cr := &CaptureReader{reader: bufio.NewReader(reader)}
+	header := make([]byte, captureFileHeaderSize)
+
+	if _, err := io.ReadFull(cr.reader, header); err != nil {
+		return nil, errors.New("failed to read session capture header: " + err.Error())
+	}
+
+	if string(header[:len(captureSignature)]) != captureSignature {
+		return nil, errors.New("stream is not an STTP session capture")
+	}
+
+	if header[len(captureSignature)] != captureVersion {
+		return nil, errors.New("unsupported STTP session capture version " + strconv.Itoa(int(header[len(captureSignature)])))
+	}
+
+	return cr, nil
+}
+
+// ReadFrame reads the next frame from the capture stream. Returns io.EOF when no more frames are available.
+func (cr *CaptureReader) ReadFrame() (*CapturedFrame, error) {
+	if _, err := io.ReadFull(cr.reader, cr.header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.New("session capture frame header is truncated")
+		}
+
+		return nil, err
+	}
+
+	channel := CaptureChannelEnum(cr.header[1])
+	length := binary.BigEndian.Uint32(cr.header[10:])
+
+	// Validate length before allocating, a corrupt or hostile capture could claim up to 4GB
+	if err := validateCaptureFrameSize(channel, uint64(length)); err != nil {
+		return nil, err
+	}
+
+	frame := &CapturedFrame{
+		Direction: CaptureDirectionEnum(cr.header[0]),
+		Channel:   channel,
+		Timestamp: ticks.Ticks(binary.BigEndian.Uint64(cr.header[2:])),
+		Data:      make([]byte, length),
+	}
+
+	if _, err := io.ReadFull(cr.reader, frame.Data); err != nil {
+		return nil, errors.New("session capture frame data is truncated: " + err.Error())
+	}
+
+	return frame, nil
+}
+
+func validateCaptureFrameSize(channel CaptureChannelEnum, length uint64) error {
+	maxFrameSize := uint64(maxCaptureFrameSize)
+
+	if channel == CaptureChannel.DataChannel {
+		maxFrameSize = maxPacketSize
+	}
+
+	if length > maxFrameSize {
+		return errors.New("session capture " + channel.String() + " frame size of " + strconv.FormatUint(length, 10) + " bytes exceeds maximum of " + strconv.FormatUint(maxFrameSize, 10) + " bytes")
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+//******************************************************************************************************
+//  SessionCapture_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+func createResponseFrame(responseCode ServerResponseEnum, commandCode ServerCommandEnum, data []byte) []byte {
+	frame := make([]byte, responseHeaderSize+len(data))
+	frame[0] = byte(responseCode)
+	frame[1] = byte(commandCode)
+	binary.BigEndian.PutUint32(frame[2:], uint32(len(data)))
+	copy(frame[responseHeaderSize:], data)
+	return frame
+}
+
+func createSessionCapture(t *testing.T, interval ticks.Ticks) ([]*CapturedFrame, []byte) {
+	timestamp := ticks.UtcNow()
+
+	frames := []*CapturedFrame{
+		{CaptureDirection.Sent, CaptureChannel.CommandChannel, timestamp, []byte{byte(ServerCommand.DefineOperationalModes), 0, 0, 0, 2}},
+		{CaptureDirection.Received, CaptureChannel.CommandChannel, timestamp, createResponseFrame(ServerResponse.Succeeded, ServerCommand.DefineOperationalModes, []byte("modes defined"))},
+		{CaptureDirection.Received, CaptureChannel.DataChannel, timestamp + interval, createResponseFrame(ServerResponse.Notify, ServerCommand.Subscribe, append([]byte{0, 0, 0, 1}, "replayed notification"...))},
+		{CaptureDirection.Received, CaptureChannel.CommandChannel, timestamp + 2*interval, createResponseFrame(ServerResponse.ProcessingComplete, ServerCommand.Subscribe, []byte("replay complete"))},
+	}
+
+	var buffer bytes.Buffer
+	writer, err := NewCaptureWriter(&buffer)
+
+	if err != nil {
+		t.Fatal("TestSessionCapture: failed to create capture writer: " + err.Error())
+	}
+
+	for _, frame := range frames {
+		if err := writer.WriteFrame(frame); err != nil {
+			t.Fatal("TestSessionCapture: failed to write frame: " + err.Error())
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		t.Fatal("TestSessionCapture: failed to flush capture writer: " + err.Error())
+	}
+
+	return frames, buffer.Bytes()
+}
+
+func readCapturedFrames(t *testing.T, capture []byte) []*CapturedFrame {
+	reader, err := NewCaptureReader(bytes.NewReader(capture))
+
+	if err != nil {
+		t.Fatal("TestSessionCapture: failed to create capture reader: " + err.Error())
+	}
+
+	var frames []*CapturedFrame
+
+	for {
+		frame, err := reader.ReadFrame()
+
+		if err == io.EOF {
+			return frames
+		}
+
+		if err != nil {
+			t.Fatal("TestSessionCapture: failed to read frame: " + err.Error())
+		}
+
+		frames = append(frames, frame)
+	}
+}
+
+func TestSessionCapture(t *testing.T) {
+	expected, capture := createSessionCapture(t, ticks.PerMillisecond)
+	frames := readCapturedFrames(t, capture)
+
+	if len(frames) != len(expected) {
+		t.Fatalf("TestSessionCapture: expected %d frames, received: %d", len(expected), len(frames))
+	}
+
+	for i, frame := range frames {
+		if frame.Direction != expected[i].Direction || frame.Channel != expected[i].Channel || frame.Timestamp != expected[i].Timestamp || !bytes.Equal(frame.Data, expected[i].Data) {
+			t.Fatalf("TestSessionCapture: unexpected frame %d", i)
+		}
+	}
+
+	if _, err := NewCaptureReader(bytes.NewReader([]byte("STTPCAP"))); err == nil {
+		t.Fatal("TestSessionCapture: expected error for truncated capture header")
+	}
+
+	if _, err := NewCaptureReader(bytes.NewReader(append([]byte("NOTACAP"), captureVersion))); err == nil {
+		t.Fatal("TestSessionCapture: expected error for invalid capture signature")
+	}
+
+	reader, _ := NewCaptureReader(bytes.NewReader(capture[:len(capture)-1]))
+	err := error(nil)
+
+	for err == nil {
+		_, err = reader.ReadFrame()
+	}
+
+	if err == io.EOF {
+		t.Fatal("TestSessionCapture: expected error for truncated frame")
+	}
+
+	// Frame lengths beyond the maximum frame size should fail before allocating
+	for _, testCase := range []struct {
+		channel CaptureChannelEnum
+		length  uint32
+	}{
+		{CaptureChannel.DataChannel, maxPacketSize + 1},
+		{CaptureChannel.CommandChannel, maxCaptureFrameSize + 1},
+		{CaptureChannel.CommandChannel, math.MaxUint32},
+	} {
+		header := make([]byte, captureFrameHeaderSize)
+		header[1] = byte(testCase.channel)
+		binary.BigEndian.PutUint32(header[10:], testCase.length)
+
+		reader, _ = NewCaptureReader(bytes.NewReader(append(append([]byte(captureSignature), captureVersion), header...)))
+
+		if _, err := reader.ReadFrame(); err == nil || err == io.EOF || strings.Contains(err.Error(), "truncated") {
+			t.Fatalf("TestSessionCapture: expected frame size error for %s frame length %d", testCase.channel, testCase.length)
+		}
+	}
+
+	writer, _ := NewCaptureWriter(io.Discard)
+
+	if err := writer.WriteFrame(&CapturedFrame{Channel: CaptureChannel.DataChannel, Data: make([]byte, maxPacketSize+1)}); err == nil {
+		t.Fatal("TestSessionCapture: expected error writing oversized data channel frame")
+	}
+}
+
+func TestReplayConnTiming(t *testing.T) {
+	frames, capture := createSessionCapture(t, 40*ticks.PerMillisecond)
+	conn, err := NewReplayConn(bytes.NewReader(capture), 2.0)
+
+	if err != nil {
+		t.Fatal("TestReplayConnTiming: failed to create replay connection: " + err.Error())
+	}
+
+	defer conn.Close()
+
+	// Received frames are played back with a payload header, sent frames are skipped
+	var expected []byte
+
+	for _, frame := range frames[1:] {
+		expected = binary.BigEndian.AppendUint32(expected, uint32(len(frame.Data)))
+		expected = append(expected, frame.Data...)
+	}
+
+	started := time.Now()
+
+	if data, err := io.ReadAll(conn); err != nil || !bytes.Equal(data, expected) {
+		t.Fatal("TestReplayConnTiming: unexpected replayed data")
+	}
+
+	// Frames span 80ms, replayed at twice the original speed
+	if elapsed := time.Since(started); elapsed < 40*time.Millisecond {
+		t.Fatal("TestReplayConnTiming: expected accelerated playback delay, elapsed: " + elapsed.String())
+	}
+
+	if conn.FramesReplayed() != 3 {
+		t.Fatal("TestReplayConnTiming: expected 3 replayed frames")
+	}
+
+	if _, err := conn.Write([]byte{1}); err != nil {
+		t.Fatal("TestReplayConnTiming: expected writes to be discarded")
+	}
+
+	conn.Close()
+
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Fatal("TestReplayConnTiming: expected read error after close")
+	}
+}
+
+func TestReplayDataSubscriber(t *testing.T) {
+	expected, capture := createSessionCapture(t, 20*ticks.PerMillisecond)
+	conn, err := NewReplayConn(bytes.NewReader(capture), 1.0)
+
+	if err != nil {
+		t.Fatal("TestReplayDataSubscriber: failed to create replay connection: " + err.Error())
+	}
+
+	notifications := make(chan string, 1)
+	processingComplete := make(chan string, 1)
+	terminated := make(chan bool, 1)
+
+	ds := NewDataSubscriber()
+	defer ds.Dispose()
+
+	ds.NotificationReceivedCallback = func(message string) { notifications <- message }
+	ds.ProcessingCompleteCallback = func(message string) { processingComplete <- message }
+	ds.ConnectionTerminatedCallback = func() { terminated <- true }
+
+	// Capture replayed session to verify received and sent frames are captured
+	var recapture bytes.Buffer
+
+	if err := ds.StartCapture(&recapture); err != nil {
+		t.Fatal("TestReplayDataSubscriber: failed to start capture: " + err.Error())
+	}
+
+	if err := ds.StartCapture(&recapture); err == nil {
+		t.Fatal("TestReplayDataSubscriber: expected error starting second capture")
+	}
+
+	if err := ds.ConnectConn(conn); err != nil {
+		t.Fatal("TestReplayDataSubscriber: failed to connect: " + err.Error())
+	}
+
+	if ds.ConnectionID() != "replay" {
+		t.Fatal("TestReplayDataSubscriber: unexpected connection ID: " + ds.ConnectionID())
+	}
+
+	for _, result := range []struct {
+		received chan string
+		expected string
+	}{
+		{notifications, "replayed notification"},
+		{processingComplete, "replay complete"},
+	} {
+		select {
+		case message := <-result.received:
+			if message != result.expected {
+				t.Fatal("TestReplayDataSubscriber: unexpected message: " + message)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("TestReplayDataSubscriber: timeout waiting for \"" + result.expected + "\"")
+		}
+	}
+
+	select {
+	case <-terminated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("TestReplayDataSubscriber: timeout waiting for connection termination at end of replay")
+	}
+
+	if err := ds.StopCapture(); err != nil || ds.IsCapturing() {
+		t.Fatal("TestReplayDataSubscriber: failed to stop capture")
+	}
+
+	var received, sent []*CapturedFrame
+
+	for _, frame := range readCapturedFrames(t, recapture.Bytes()) {
+		if frame.Direction == CaptureDirection.Received {
+			received = append(received, frame)
+		} else {
+			sent = append(sent, frame)
+		}
+	}
+
+	if len(received) != 3 {
+		t.Fatalf("TestReplayDataSubscriber: expected 3 received frames, captured: %d", len(received))
+	}
+
+	for i, frame := range received {
+		if !bytes.Equal(frame.Data, expected[i+1].Data) || frame.Channel != CaptureChannel.CommandChannel {
+			t.Fatalf("TestReplayDataSubscriber: unexpected received frame %d", i)
+		}
+	}
+
+	// Define operational modes and notification confirmation commands
+	if len(sent) != 2 || sent[0].Data[0] != byte(ServerCommand.DefineOperationalModes) || sent[1].Data[0] != byte(ServerCommand.ConfirmNotification) {
+		t.Fatal("TestReplayDataSubscriber: unexpected sent frames")
+	}
+}
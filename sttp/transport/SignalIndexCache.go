@@ -62,12 +62,15 @@ func (sic *SignalIndexCache) addRecord(ds *DataSubscriber, signalIndex int32, si
 	sic.idList = append(sic.idList, id)
 	sic.signalIDCache[signalID] = signalIndex
 
-	metadata := ds.LookupMetadata(signalID)
-
-	// Register measurement metadata if not defined already
-	if len(metadata.Source) == 0 {
-		metadata.Source = source
-		metadata.ID = id
+	// Register measurement metadata if not defined already, caches encoded
+	// for publication have no associated subscriber metadata registry
+	if ds != nil {
+		metadata := ds.LookupMetadata(signalID)
+
+		if len(metadata.Source) == 0 {
+			metadata.Source = source
+			metadata.ID = id
+		}
 	}
 
 	// Char size here helps provide a rough-estimate on binary length used to reserve
@@ -233,7 +236,31 @@ func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscribe
 	return nil
 }
 
-//// Encode serializes a SignalIndexCache to a byte buffer for publication to a DataSubscriber.
-//func (sic *SignalIndexCache) Encode(connection *SubscriberConnection, buffer []byte) {
-//	// TODO: This will be needed by DataPublisher implementation
-//}
+// encode serializes a SignalIndexCache to a byte buffer for publication to a DataSubscriber.
+func (sic *SignalIndexCache) encode(connection *SubscriberConnection, subscriberID guid.Guid, swapGuidEndianness bool) []byte {
+	buffer := make([]byte, 4, 28+len(sic.signalIDList)*48)
+
+	// Subscriber ID
+	buffer = append(buffer, subscriberID.ToBytes(swapGuidEndianness)...)
+
+	// Number of references
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(sic.signalIDList)))
+
+	for i, signalID := range sic.signalIDList {
+		source := connection.EncodeString(sic.sourceList[i])
+
+		buffer = binary.BigEndian.AppendUint32(buffer, uint32(sic.signalIDCache[signalID]))
+		buffer = append(buffer, signalID.ToBytes(swapGuidEndianness)...)
+		buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(source)))
+		buffer = append(buffer, source...)
+		buffer = binary.BigEndian.AppendUint64(buffer, sic.idList[i])
+	}
+
+	// Number of unauthorized signal IDs
+	buffer = binary.BigEndian.AppendUint32(buffer, 0)
+
+	// Byte size of cache
+	binary.BigEndian.PutUint32(buffer, uint32(len(buffer)))
+
+	return buffer
+}
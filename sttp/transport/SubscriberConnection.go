@@ -23,9 +23,132 @@
 
 package transport
 
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+const (
+	// sendQueueSize defines the maximum number of responses queued for a subscriber connection
+	// before the connection is considered unresponsive and is disconnected.
+	sendQueueSize = 4096
+
+	// baseTimeRotationInterval defines how often compact measurement base time offsets are updated.
+	// This keeps offsets within range of 2-byte millisecond resolution timestamps.
+	baseTimeRotationInterval = 30 * ticks.PerSecond
+
+	// maxDataPacketSize defines the maximum size of a published data packet payload.
+	maxDataPacketSize = maxPacketSize - responseHeaderSize
+)
+
 // SubscriberConnection represents a connection from a DataPublisher to DataSubscriber.
 type SubscriberConnection struct {
-	encoding OperationalEncodingEnum
+	publisher        *DataPublisher
+	conn             net.Conn
+	connectionID     string
+	subscriberID     guid.Guid
+	encoding         OperationalEncodingEnum
+	version          byte
+	operationalModes OperationalModesEnum
+
+	sendQueue chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	// Subscription state is guarded by the subscription mutex so measurements
+	// can be published while the subscriber changes its subscription
+	subscriptionMutex            sync.Mutex
+	signalIndexCache             *SignalIndexCache
+	filterExpression             string
+	includeTime                  bool
+	useMillisecondResolution     bool
+	enableTimeReasonabilityCheck bool
+	useLocalClockAsRealTime      bool
+	lagTime                      ticks.Ticks
+	leadTime                     ticks.Ticks
+	latestTimestamp              ticks.Ticks
+	compressed                   bool
+	dataStartTimeSent            bool
+	baseTimeOffsets              [2]int64
+	encoder                      *tssc.Encoder
+	sequenceNumber               uint16
+
+	totalMeasurementsSent      uint64
+	totalMeasurementsDiscarded uint64
+	totalBytesSent             uint64
+}
+
+func newSubscriberConnection(publisher *DataPublisher, conn net.Conn) *SubscriberConnection {
+	return &SubscriberConnection{
+		publisher:    publisher,
+		conn:         conn,
+		connectionID: conn.RemoteAddr().String(),
+		subscriberID: guid.New(),
+		encoding:     OperationalEncoding.UTF8,
+		version:      2,
+		sendQueue:    make(chan []byte, sendQueueSize),
+		closed:       make(chan struct{}),
+	}
+}
+
+// ConnectionID gets the remote address of the DataSubscriber connection.
+func (sc *SubscriberConnection) ConnectionID() string {
+	return sc.connectionID
+}
+
+// SubscriberID gets the unique ID assigned to the DataSubscriber connection.
+func (sc *SubscriberConnection) SubscriberID() guid.Guid {
+	return sc.subscriberID
+}
+
+// Version gets the STTP protocol version requested by the DataSubscriber.
+func (sc *SubscriberConnection) Version() byte {
+	return sc.version
+}
+
+// IsSubscribed determines if the DataSubscriber has an active subscription.
+func (sc *SubscriberConnection) IsSubscribed() bool {
+	sc.subscriptionMutex.Lock()
+	defer sc.subscriptionMutex.Unlock()
+	return sc.signalIndexCache != nil
+}
+
+// FilterExpression gets the filter expression of the active subscription.
+func (sc *SubscriberConnection) FilterExpression() string {
+	sc.subscriptionMutex.Lock()
+	defer sc.subscriptionMutex.Unlock()
+	return sc.filterExpression
+}
+
+// SignalIndexCache gets the signal index cache of the active subscription, or nil when not subscribed.
+func (sc *SubscriberConnection) SignalIndexCache() *SignalIndexCache {
+	sc.subscriptionMutex.Lock()
+	defer sc.subscriptionMutex.Unlock()
+	return sc.signalIndexCache
+}
+
+// TotalMeasurementsSent gets the total number of measurements sent to the DataSubscriber.
+func (sc *SubscriberConnection) TotalMeasurementsSent() uint64 {
+	return atomic.LoadUint64(&sc.totalMeasurementsSent)
+}
+
+// TotalMeasurementsDiscarded gets the total number of measurements discarded by time reasonability checks.
+func (sc *SubscriberConnection) TotalMeasurementsDiscarded() uint64 {
+	return atomic.LoadUint64(&sc.totalMeasurementsDiscarded)
+}
+
+// TotalBytesSent gets the total number of bytes sent to the DataSubscriber.
+func (sc *SubscriberConnection) TotalBytesSent() uint64 {
+	return atomic.LoadUint64(&sc.totalBytesSent)
 }
 
 // EncodeString encodes an STTP string according to the defined operational modes.
@@ -38,4 +161,396 @@ func (sc *SubscriberConnection) EncodeString(value string) []byte {
 	return []byte(value)
 }
 
-// TODO: Flesh this for out the DataPublisher implementation
+// Disconnect closes the DataSubscriber connection.
+func (sc *SubscriberConnection) Disconnect() {
+	sc.closeOnce.Do(func() {
+		close(sc.closed)
+		sc.conn.Close()
+	})
+}
+
+// run processes subscriber commands until the connection is closed.
+func (sc *SubscriberConnection) run() {
+	defer sc.Disconnect()
+	go sc.runSendQueue()
+
+	header := make([]byte, payloadHeaderSize)
+
+	for {
+		if _, err := io.ReadFull(sc.conn, header); err != nil {
+			return
+		}
+
+		size := binary.BigEndian.Uint32(header)
+
+		if size < 1 || size > maxPacketSize {
+			sc.publisher.dispatchErrorMessage("Invalid command packet size " + strconv.Itoa(int(size)) + " received from \"" + sc.connectionID + "\" - disconnecting")
+			return
+		}
+
+		packet := make([]byte, size)
+
+		if _, err := io.ReadFull(sc.conn, packet); err != nil {
+			return
+		}
+
+		sc.handleCommand(ServerCommandEnum(packet[0]), packet[1:])
+	}
+}
+
+// runSendQueue writes queued responses in order until the connection is closed.
+func (sc *SubscriberConnection) runSendQueue() {
+	for {
+		select {
+		case <-sc.closed:
+			return
+		case buffer := <-sc.sendQueue:
+			if _, err := sc.conn.Write(buffer); err != nil {
+				sc.Disconnect()
+				return
+			}
+
+			atomic.AddUint64(&sc.totalBytesSent, uint64(len(buffer)))
+			atomic.AddUint64(&sc.publisher.totalBytesSent, uint64(len(buffer)))
+		}
+	}
+}
+
+//gocyclo:ignore
+func (sc *SubscriberConnection) handleCommand(command ServerCommandEnum, data []byte) {
+	switch command {
+	case ServerCommand.DefineOperationalModes:
+		sc.handleDefineOperationalModes(data)
+	case ServerCommand.MetadataRefresh:
+		sc.handleMetadataRefresh()
+	case ServerCommand.Subscribe:
+		sc.handleSubscribe(data)
+	case ServerCommand.Unsubscribe:
+		sc.subscriptionMutex.Lock()
+		sc.signalIndexCache = nil
+		sc.subscriptionMutex.Unlock()
+
+		sc.sendResponse(ServerResponse.Succeeded, command, sc.EncodeString("Client unsubscribed"))
+		sc.publisher.dispatchStatusMessage("Client \"" + sc.connectionID + "\" unsubscribed")
+	case ServerCommand.ConfirmNotification, ServerCommand.ConfirmBufferBlock, ServerCommand.ConfirmUpdateSignalIndexCache,
+		ServerCommand.ConfirmUpdateBaseTimes, ServerCommand.ConfirmUpdateCipherKeys:
+		// Confirmations are not tracked since responses are always delivered in order over TCP
+	default:
+		sc.sendResponse(ServerResponse.Failed, command, sc.EncodeString("Command "+command.String()+" is not supported by the Go DataPublisher"))
+	}
+}
+
+func (sc *SubscriberConnection) handleDefineOperationalModes(data []byte) {
+	if len(data) < 4 {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.DefineOperationalModes, []byte("Invalid operational modes"))
+		return
+	}
+
+	operationalModes := OperationalModesEnum(binary.BigEndian.Uint32(data))
+
+	if OperationalEncodingEnum(operationalModes&OperationalModes.EncodingMask) != OperationalEncoding.UTF8 {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.DefineOperationalModes, []byte("Go implementation of STTP only supports UTF8 string encoding"))
+		return
+	}
+
+	sc.operationalModes = operationalModes
+	sc.version = byte(operationalModes & OperationalModes.VersionMask)
+	sc.sendResponse(ServerResponse.Succeeded, ServerCommand.DefineOperationalModes, sc.EncodeString("Operational modes defined"))
+}
+
+func (sc *SubscriberConnection) handleMetadataRefresh() {
+	metadata := sc.publisher.Metadata()
+
+	if metadata == nil {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.MetadataRefresh, sc.EncodeString("No metadata is available"))
+		return
+	}
+
+	if sc.operationalModes&OperationalModes.CompressMetadata != 0 {
+		var err error
+
+		if metadata, err = compressGZip(metadata); err != nil {
+			sc.sendResponse(ServerResponse.Failed, ServerCommand.MetadataRefresh, sc.EncodeString("Failed to compress metadata: "+err.Error()))
+			return
+		}
+	}
+
+	sc.sendResponse(ServerResponse.Succeeded, ServerCommand.MetadataRefresh, metadata)
+}
+
+func (sc *SubscriberConnection) handleSubscribe(data []byte) {
+	if len(data) < 5 || int(binary.BigEndian.Uint32(data[1:])) > len(data)-5 {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.Subscribe, sc.EncodeString("Invalid subscription request"))
+		return
+	}
+
+	settings := parseConnectionString(string(data[5 : 5+binary.BigEndian.Uint32(data[1:])]))
+
+	if _, ok := settings["datachannel"]; ok {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.Subscribe, sc.EncodeString("UDP data channel is not supported by the Go DataPublisher"))
+		return
+	}
+
+	filterExpression := settings["filterexpression"]
+	signalIndexCache, err := sc.publisher.resolveSubscription(sc, filterExpression)
+
+	if err != nil {
+		sc.sendResponse(ServerResponse.Failed, ServerCommand.Subscribe, sc.EncodeString("Failed to subscribe: "+err.Error()))
+		return
+	}
+
+	parseSeconds := func(key string, defaultValue float64) ticks.Ticks {
+		value, err := strconv.ParseFloat(settings[key], 64)
+
+		if err != nil {
+			value = defaultValue
+		}
+
+		return ticks.Ticks(value * float64(ticks.PerSecond))
+	}
+
+	cache := signalIndexCache.encode(sc, sc.subscriberID, false)
+
+	if sc.operationalModes&OperationalModes.CompressSignalIndexCache != 0 {
+		if cache, err = compressGZip(cache); err != nil {
+			sc.sendResponse(ServerResponse.Failed, ServerCommand.Subscribe, sc.EncodeString("Failed to compress signal index cache: "+err.Error()))
+			return
+		}
+	}
+
+	if sc.version > 1 {
+		// Subscription always uses cache index 0, updates are delivered in order
+		cache = append([]byte{0}, cache...)
+	}
+
+	// Hold subscription lock while queuing responses so no measurements for
+	// a prior subscription can be queued after the new signal index cache
+	sc.subscriptionMutex.Lock()
+	defer sc.subscriptionMutex.Unlock()
+
+	sc.signalIndexCache = signalIndexCache
+	sc.filterExpression = filterExpression
+	sc.includeTime = parseBoolSetting(settings, "includetime", true)
+	sc.useMillisecondResolution = parseBoolSetting(settings, "usemillisecondresolution", false)
+	sc.enableTimeReasonabilityCheck = parseBoolSetting(settings, "enabletimereasonabilitycheck", false)
+	sc.useLocalClockAsRealTime = parseBoolSetting(settings, "uselocalclockasrealtime", false)
+	sc.lagTime = parseSeconds("lagtime", defaultLagTime)
+	sc.leadTime = parseSeconds("leadtime", defaultLeadTime)
+	sc.latestTimestamp = 0
+	sc.compressed = sc.operationalModes&OperationalModes.CompressPayloadData != 0
+	sc.dataStartTimeSent = false
+	sc.baseTimeOffsets = [2]int64{}
+	sc.encoder = tssc.NewEncoder()
+	sc.sequenceNumber = 0
+
+	message := "Client subscribed to " + strconv.Itoa(int(signalIndexCache.Count())) + " signals"
+	sc.sendResponse(ServerResponse.Succeeded, ServerCommand.Subscribe, sc.EncodeString(message))
+	sc.sendResponse(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe, cache)
+	sc.publisher.dispatchStatusMessage(message + " from \"" + sc.connectionID + "\"")
+}
+
+// sendResponse queues a response for the DataSubscriber. The connection is closed
+// if the send queue is full, i.e., the DataSubscriber is not keeping up.
+func (sc *SubscriberConnection) sendResponse(response ServerResponseEnum, command ServerCommandEnum, data []byte) bool {
+	buffer := make([]byte, payloadHeaderSize+responseHeaderSize, payloadHeaderSize+responseHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buffer, uint32(responseHeaderSize+len(data)))
+	buffer[payloadHeaderSize] = byte(response)
+	buffer[payloadHeaderSize+1] = byte(command)
+	binary.BigEndian.PutUint32(buffer[payloadHeaderSize+2:], uint32(len(data)))
+	buffer = append(buffer, data...)
+
+	select {
+	case <-sc.closed:
+		return false
+	case sc.sendQueue <- buffer:
+		return true
+	default:
+		sc.publisher.dispatchErrorMessage("Send queue for client \"" + sc.connectionID + "\" is full - disconnecting")
+		sc.Disconnect()
+		return false
+	}
+}
+
+// publishMeasurements queues data packets for the measurements included in the active subscription.
+func (sc *SubscriberConnection) publishMeasurements(measurements []Measurement) {
+	sc.subscriptionMutex.Lock()
+	defer sc.subscriptionMutex.Unlock()
+
+	if sc.signalIndexCache == nil {
+		return
+	}
+
+	selected := make([]Measurement, 0, len(measurements))
+	indexes := make([]int32, 0, len(measurements))
+	var discarded uint64
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		signalIndex, ok := sc.signalIndexCache.signalIDCache[measurement.SignalID]
+
+		if !ok {
+			continue
+		}
+
+		if sc.enableTimeReasonabilityCheck && !sc.isReasonable(measurement.Timestamp) {
+			discarded++
+			continue
+		}
+
+		selected = append(selected, *measurement)
+		indexes = append(indexes, signalIndex)
+	}
+
+	if discarded > 0 {
+		atomic.AddUint64(&sc.totalMeasurementsDiscarded, discarded)
+		atomic.AddUint64(&sc.publisher.totalMeasurementsDiscarded, discarded)
+	}
+
+	if len(selected) == 0 {
+		return
+	}
+
+	if !sc.dataStartTimeSent {
+		sc.dataStartTimeSent = true
+		sc.sendResponse(ServerResponse.DataStartTime, ServerCommand.Subscribe, binary.BigEndian.AppendUint64(nil, uint64(selected[0].Timestamp)))
+	}
+
+	var err error
+
+	if sc.compressed {
+		err = sc.publishTSSCMeasurements(selected, indexes)
+	} else {
+		err = sc.publishCompactMeasurements(selected)
+	}
+
+	if err != nil {
+		sc.publisher.dispatchErrorMessage("Failed to publish measurements to client \"" + sc.connectionID + "\": " + err.Error())
+		return
+	}
+
+	atomic.AddUint64(&sc.totalMeasurementsSent, uint64(len(selected)))
+	atomic.AddUint64(&sc.publisher.totalMeasurementsSent, uint64(len(selected)))
+}
+
+// isReasonable determines if a timestamp is within the lag / lead time tolerances of real time, where real
+// time is the local clock or, when not using the local clock, the latest received measurement timestamp.
+func (sc *SubscriberConnection) isReasonable(timestamp ticks.Ticks) bool {
+	var realTime ticks.Ticks
+
+	if sc.useLocalClockAsRealTime {
+		realTime = ticks.UtcNow()
+	} else {
+		if timestamp > sc.latestTimestamp {
+			sc.latestTimestamp = timestamp
+		}
+
+		realTime = sc.latestTimestamp
+	}
+
+	return timestamp >= realTime-sc.lagTime && timestamp <= realTime+sc.leadTime
+}
+
+func (sc *SubscriberConnection) publishCompactMeasurements(measurements []Measurement) error {
+	if sc.includeTime {
+		// Measurements are always encoded with time index 0, so only its base time offset is updated
+		if timestamp := int64(measurements[0].Timestamp); sc.baseTimeOffsets[0] == 0 || timestamp-sc.baseTimeOffsets[0] > int64(baseTimeRotationInterval) || timestamp < sc.baseTimeOffsets[0] {
+			sc.baseTimeOffsets[0] = timestamp
+
+			update := make([]byte, 20)
+			binary.BigEndian.PutUint64(update[4:], uint64(sc.baseTimeOffsets[0]))
+			binary.BigEndian.PutUint64(update[12:], uint64(sc.baseTimeOffsets[1]))
+
+			if !sc.sendResponse(ServerResponse.UpdateBaseTimes, ServerCommand.Subscribe, update) {
+				return errors.New("connection closed")
+			}
+		}
+	}
+
+	const headerSize = 5
+	packet := make([]byte, headerSize, maxDataPacketSize)
+	packet[0] = byte(DataPacketFlags.Compact)
+	count := 0
+
+	for i := range measurements {
+		compactMeasurement := NewCompactMeasurement(sc.signalIndexCache, sc.includeTime, sc.useMillisecondResolution, &sc.baseTimeOffsets)
+		compactMeasurement.Measurement = measurements[i]
+
+		if len(packet)+int(compactMeasurement.GetBinaryLength()) > maxDataPacketSize {
+			if err := sc.sendDataPacket(packet, count); err != nil {
+				return err
+			}
+
+			packet = make([]byte, headerSize, maxDataPacketSize)
+			packet[0] = byte(DataPacketFlags.Compact)
+			count = 0
+		}
+
+		packet = compactMeasurement.encode(packet)
+		count++
+	}
+
+	return sc.sendDataPacket(packet, count)
+}
+
+func (sc *SubscriberConnection) publishTSSCMeasurements(measurements []Measurement, indexes []int32) error {
+	const headerSize = 8
+	var packet []byte
+	count := 0
+
+	reset := func() {
+		packet = make([]byte, maxDataPacketSize)
+		packet[0] = byte(DataPacketFlags.Compressed)
+		packet[5] = 85
+		sc.encoder.SetBuffer(packet[headerSize:])
+		count = 0
+	}
+
+	flush := func() error {
+		binary.BigEndian.PutUint16(packet[6:], sc.sequenceNumber)
+
+		if err := sc.sendDataPacket(packet[:headerSize+sc.encoder.FinishBlock()], count); err != nil {
+			return err
+		}
+
+		// Do not increment to 0 on roll-over, zero resets subscriber decoder
+		if sc.sequenceNumber++; sc.sequenceNumber == 0 {
+			sc.sequenceNumber = 1
+		}
+
+		return nil
+	}
+
+	reset()
+
+	for i := range measurements {
+		measurement := &measurements[i]
+
+		if !sc.encoder.TryAddMeasurement(indexes[i], int64(measurement.Timestamp), uint32(measurement.Flags), float32(measurement.Value)) {
+			if err := flush(); err != nil {
+				return err
+			}
+
+			reset()
+			sc.encoder.TryAddMeasurement(indexes[i], int64(measurement.Timestamp), uint32(measurement.Flags), float32(measurement.Value))
+		}
+
+		count++
+	}
+
+	return flush()
+}
+
+// sendDataPacket queues a data packet after updating its measurement count.
+func (sc *SubscriberConnection) sendDataPacket(packet []byte, count int) error {
+	if count == 0 {
+		return nil
+	}
+
+	binary.BigEndian.PutUint32(packet[1:], uint32(count))
+
+	if !sc.sendResponse(ServerResponse.DataPacket, ServerCommand.Subscribe, packet) {
+		return errors.New("connection closed")
+	}
+
+	return nil
+}
@@ -0,0 +1,408 @@
+//******************************************************************************************************
+//  SubscriberMetrics.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// metricsContentType defines the HTTP content type of the Prometheus text exposition format.
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// latencyBuckets defines the upper bounds, in seconds, of the measurement latency histogram buckets.
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// SubscriberMetrics accumulates DataSubscriber health metrics. Unlike the DataSubscriber statistics
+// counters, which reset on each connection or subscription, metric counters accumulate for the lifetime
+// of the DataSubscriber. Metrics are written in the Prometheus text exposition format and SubscriberMetrics
+// implements http.Handler so that it can be directly served as a Prometheus scrape endpoint.
+type SubscriberMetrics struct {
+	subscriber *DataSubscriber
+
+	connectAttempts   uint64
+	reconnectAttempts uint64
+	connections       uint64
+
+	commandChannelBytes uint64
+	dataChannelBytes    uint64
+	dataPackets         uint64
+	measurements        uint64
+
+	metadataRefreshes       uint64
+	metadataRefreshDuration int64 // time.Duration of last metadata refresh
+
+	tsscResets         uint64
+	tsscOutOfSequence  uint64
+	decryptFailures    uint64
+	bufferBlocks       uint64
+	bufferBlockBacklog int64
+
+	rateMutex              sync.Mutex
+	rateSampleTime         time.Time
+	rateSampleBytes        uint64
+	rateSampleMeasurements uint64
+	bytesPerSecond         float64
+	measurementsPerSecond  float64
+
+	latencyMutex  sync.Mutex
+	latencyCounts []uint64
+	latencyCount  uint64
+	latencySum    float64
+	signalLatency map[guid.Guid]float64
+}
+
+func newSubscriberMetrics(ds *DataSubscriber) *SubscriberMetrics {
+	return &SubscriberMetrics{
+		subscriber:     ds,
+		rateSampleTime: time.Now(),
+		latencyCounts:  make([]uint64, len(latencyBuckets)),
+		signalLatency:  make(map[guid.Guid]float64),
+	}
+}
+
+// The following functions are safe to call on a nil SubscriberMetrics, i.e., when metrics are not enabled.
+
+func (sm *SubscriberMetrics) addConnectAttempt(autoReconnecting bool) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.connectAttempts, 1)
+
+	if autoReconnecting {
+		atomic.AddUint64(&sm.reconnectAttempts, 1)
+	}
+}
+
+func (sm *SubscriberMetrics) addConnection() {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.connections, 1)
+}
+
+func (sm *SubscriberMetrics) addCommandChannelBytes(count int) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.commandChannelBytes, uint64(count))
+}
+
+func (sm *SubscriberMetrics) addDataChannelBytes(count int) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.dataChannelBytes, uint64(count))
+}
+
+func (sm *SubscriberMetrics) addMetadataRefresh(duration time.Duration) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.metadataRefreshes, 1)
+	atomic.StoreInt64(&sm.metadataRefreshDuration, int64(duration))
+}
+
+func (sm *SubscriberMetrics) addTSSCReset() {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.tsscResets, 1)
+}
+
+func (sm *SubscriberMetrics) addTSSCOutOfSequence() {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.tsscOutOfSequence, 1)
+}
+
+func (sm *SubscriberMetrics) addDecryptFailure() {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.decryptFailures, 1)
+}
+
+func (sm *SubscriberMetrics) addBufferBlock(backlog int) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.bufferBlocks, 1)
+	atomic.StoreInt64(&sm.bufferBlockBacklog, int64(backlog))
+}
+
+// addDataPacket records a received data packet along with the latency, relative to ticks.UtcNow, of each of
+// its parsed measurements. Latency is not recorded when parsed is false, e.g., for an out-of-sequence packet.
+func (sm *SubscriberMetrics) addDataPacket(measurements []Measurement, parsed bool) {
+	if sm == nil {
+		return
+	}
+
+	atomic.AddUint64(&sm.dataPackets, 1)
+	atomic.AddUint64(&sm.measurements, uint64(len(measurements)))
+
+	if !parsed {
+		return
+	}
+
+	now := ticks.UtcNow()
+
+	sm.latencyMutex.Lock()
+	defer sm.latencyMutex.Unlock()
+
+	for i := range measurements {
+		timestamp := measurements[i].Timestamp & ticks.ValueMask
+
+		if timestamp == 0 {
+			continue
+		}
+
+		latency := float64(now-timestamp) / float64(ticks.PerSecond)
+
+		for j, bound := range latencyBuckets {
+			if latency <= bound {
+				sm.latencyCounts[j]++
+				break
+			}
+		}
+
+		sm.latencyCount++
+		sm.latencySum += latency
+		sm.signalLatency[measurements[i].SignalID] = latency
+	}
+}
+
+// updateRates updates the measurement and byte rates when at least one second has elapsed since the last update.
+func (sm *SubscriberMetrics) updateRates(bytes, measurements uint64) (float64, float64) {
+	sm.rateMutex.Lock()
+	defer sm.rateMutex.Unlock()
+
+	if elapsed := time.Since(sm.rateSampleTime).Seconds(); elapsed >= 1.0 {
+		sm.bytesPerSecond = float64(bytes-sm.rateSampleBytes) / elapsed
+		sm.measurementsPerSecond = float64(measurements-sm.rateSampleMeasurements) / elapsed
+		sm.rateSampleTime = time.Now()
+		sm.rateSampleBytes = bytes
+		sm.rateSampleMeasurements = measurements
+	}
+
+	return sm.bytesPerSecond, sm.measurementsPerSecond
+}
+
+// TotalMeasurementsReceived gets the total number of measurements received since metrics were enabled.
+func (sm *SubscriberMetrics) TotalMeasurementsReceived() uint64 {
+	return atomic.LoadUint64(&sm.measurements)
+}
+
+// TotalBytesReceived gets the total number of command and data channel bytes received since metrics were enabled.
+func (sm *SubscriberMetrics) TotalBytesReceived() uint64 {
+	return atomic.LoadUint64(&sm.commandChannelBytes) + atomic.LoadUint64(&sm.dataChannelBytes)
+}
+
+// ReconnectAttempts gets the total number of automatic reconnect attempts since metrics were enabled.
+func (sm *SubscriberMetrics) ReconnectAttempts() uint64 {
+	return atomic.LoadUint64(&sm.reconnectAttempts)
+}
+
+// SignalLatency gets the latency, relative to ticks.UtcNow, of the last measurement received for the specified
+// signal ID. Returns false if no measurement has been received for the signal.
+func (sm *SubscriberMetrics) SignalLatency(signalID guid.Guid) (time.Duration, bool) {
+	sm.latencyMutex.Lock()
+	latency, ok := sm.signalLatency[signalID]
+	sm.latencyMutex.Unlock()
+
+	return time.Duration(latency * float64(time.Second)), ok
+}
+
+// WriteTo writes the current metrics to the specified writer in the Prometheus text exposition format.
+func (sm *SubscriberMetrics) WriteTo(writer io.Writer) (int64, error) {
+	ds := sm.subscriber
+	mw := &metricsWriter{writer: bufio.NewWriter(writer)}
+
+	commandChannelBytes := atomic.LoadUint64(&sm.commandChannelBytes)
+	dataChannelBytes := atomic.LoadUint64(&sm.dataChannelBytes)
+	measurements := atomic.LoadUint64(&sm.measurements)
+	bytesPerSecond, measurementsPerSecond := sm.updateRates(commandChannelBytes+dataChannelBytes, measurements)
+
+	mw.gauge("connected", "Whether the subscriber is connected to a publisher.", boolValue(ds.IsConnected()))
+	mw.gauge("subscribed", "Whether the subscriber is subscribed to a publisher.", boolValue(ds.IsSubscribed()))
+	mw.counter("connect_attempts_total", "Total connection attempts, including automatic reconnect attempts.", atomic.LoadUint64(&sm.connectAttempts))
+	mw.counter("reconnect_attempts_total", "Total automatic reconnect attempts.", atomic.LoadUint64(&sm.reconnectAttempts))
+	mw.counter("connections_total", "Total established publisher connections.", atomic.LoadUint64(&sm.connections))
+	mw.counter("command_channel_bytes_total", "Total bytes received on the command channel.", commandChannelBytes)
+	mw.counter("data_channel_bytes_total", "Total bytes received on the data channel.", dataChannelBytes)
+	mw.gauge("bytes_per_second", "Rate of bytes received on all channels.", bytesPerSecond)
+	mw.counter("data_packets_total", "Total data packets received.", atomic.LoadUint64(&sm.dataPackets))
+	mw.counter("measurements_total", "Total measurements received.", measurements)
+	mw.gauge("measurements_per_second", "Rate of measurements received.", measurementsPerSecond)
+	mw.counter("metadata_refreshes_total", "Total metadata refresh responses received.", atomic.LoadUint64(&sm.metadataRefreshes))
+	mw.gauge("metadata_refresh_duration_seconds", "Time from the last metadata request to its response.", time.Duration(atomic.LoadInt64(&sm.metadataRefreshDuration)).Seconds())
+	mw.counter("tssc_resets_total", "Total TSSC decoder resets requested by the publisher.", atomic.LoadUint64(&sm.tsscResets))
+	mw.counter("tssc_out_of_sequence_total", "Total TSSC data packets ignored for being out of sequence.", atomic.LoadUint64(&sm.tsscOutOfSequence))
+	mw.counter("decrypt_failures_total", "Total data packets that failed to decrypt.", atomic.LoadUint64(&sm.decryptFailures))
+	mw.counter("buffer_blocks_total", "Total buffer blocks received.", atomic.LoadUint64(&sm.bufferBlocks))
+	mw.gauge("buffer_block_backlog", "Buffer blocks received out of sequence and awaiting delivery.", float64(atomic.LoadInt64(&sm.bufferBlockBacklog)))
+
+	sm.latencyMutex.Lock()
+	latencyCounts := append([]uint64(nil), sm.latencyCounts...)
+	latencyCount := sm.latencyCount
+	latencySum := sm.latencySum
+	signalIDs := make([]guid.Guid, 0, len(sm.signalLatency))
+	signalLatency := make(map[guid.Guid]float64, len(sm.signalLatency))
+
+	for signalID, latency := range sm.signalLatency {
+		signalIDs = append(signalIDs, signalID)
+		signalLatency[signalID] = latency
+	}
+
+	sm.latencyMutex.Unlock()
+
+	mw.header("measurement_latency_seconds", "Measurement latency relative to the local clock.", "histogram")
+	var cumulative uint64
+
+	for i, bound := range latencyBuckets {
+		cumulative += latencyCounts[i]
+		mw.sample("measurement_latency_seconds_bucket", "le=\""+formatMetricValue(bound)+"\"", float64(cumulative))
+	}
+
+	mw.sample("measurement_latency_seconds_bucket", "le=\"+Inf\"", float64(latencyCount))
+	mw.sample("measurement_latency_seconds_sum", "", latencySum)
+	mw.sample("measurement_latency_seconds_count", "", float64(latencyCount))
+
+	sort.Slice(signalIDs, func(i, j int) bool {
+		return signalIDs[i].String() < signalIDs[j].String()
+	})
+
+	mw.header("signal_latency_seconds", "Latency of the last measurement received for each signal relative to the local clock.", "gauge")
+
+	for _, signalID := range signalIDs {
+		labels := "signal_id=\"" + signalID.String() + "\""
+
+		if metadata, ok := ds.measurementRegistry.Load(signalID); ok {
+			if tag := metadata.(*MeasurementMetadata).Tag; len(tag) > 0 {
+				labels += ",point_tag=\"" + escapeLabelValue(tag) + "\""
+			}
+		}
+
+		mw.sample("signal_latency_seconds", labels, signalLatency[signalID])
+	}
+
+	if mw.err == nil {
+		mw.err = mw.writer.Flush()
+	}
+
+	return mw.count, mw.err
+}
+
+// ServeHTTP writes the current metrics as an HTTP response in the Prometheus text exposition format.
+func (sm *SubscriberMetrics) ServeHTTP(response http.ResponseWriter, _ *http.Request) {
+	response.Header().Set("Content-Type", metricsContentType)
+
+	// Write errors are client disconnects, nothing more can be reported
+	_, _ = sm.WriteTo(response)
+}
+
+// metricsWriter writes metric families in the Prometheus text exposition format, retaining the first error.
+type metricsWriter struct {
+	writer *bufio.Writer
+	count  int64
+	err    error
+}
+
+func (mw *metricsWriter) write(text string) {
+	if mw.err != nil {
+		return
+	}
+
+	var count int
+	count, mw.err = mw.writer.WriteString(text)
+	mw.count += int64(count)
+}
+
+func (mw *metricsWriter) header(name string, help string, metricType string) {
+	mw.write("# HELP sttp_subscriber_" + name + " " + help + "\n# TYPE sttp_subscriber_" + name + " " + metricType + "\n")
+}
+
+func (mw *metricsWriter) sample(name string, labels string, value float64) {
+	if len(labels) > 0 {
+		labels = "{" + labels + "}"
+	}
+
+	mw.write("sttp_subscriber_" + name + labels + " " + formatMetricValue(value) + "\n")
+}
+
+func (mw *metricsWriter) counter(name string, help string, value uint64) {
+	mw.header(name, help, "counter")
+	mw.write("sttp_subscriber_" + name + " " + strconv.FormatUint(value, 10) + "\n")
+}
+
+func (mw *metricsWriter) gauge(name string, help string, value float64) {
+	mw.header(name, help, "gauge")
+	mw.sample(name, "", value)
+}
+
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n").Replace(value)
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
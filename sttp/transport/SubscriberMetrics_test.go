@@ -0,0 +1,152 @@
+//******************************************************************************************************
+//  SubscriberMetrics_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bufio"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// parseMetrics parses Prometheus text exposition format samples into a map of values keyed by sample name with labels.
+func parseMetrics(t *testing.T, text string) map[string]float64 {
+	samples := make(map[string]float64)
+	scanner := bufio.NewScanner(strings.NewReader(text))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		separator := strings.LastIndexByte(line, ' ')
+		value, err := strconv.ParseFloat(line[separator+1:], 64)
+
+		if separator < 0 || err != nil {
+			t.Fatal("TestSubscriberMetrics: invalid metrics sample: " + line)
+		}
+
+		samples[line[:separator]] = value
+	}
+
+	return samples
+}
+
+func TestSubscriberMetrics(t *testing.T) {
+	generator := NewLoadGenerator()
+	generator.SignalCount = 6
+	generator.FramesPerSecond = 100
+
+	if err := generator.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("TestSubscriberMetrics: failed to start load generator: " + err.Error())
+	}
+
+	defer generator.Stop()
+
+	var metrics *SubscriberMetrics
+
+	subscriber := connectLoadSubscriber(t, "TestSubscriberMetrics", generator, func(ds *DataSubscriber) {
+		metrics = ds.EnableMetrics()
+		ds.MetadataReceivedCallback = func([]byte) {}
+	})
+
+	defer subscriber.Dispose()
+
+	if subscriber.Metrics() != metrics || subscriber.EnableMetrics() != metrics {
+		t.Fatal("TestSubscriberMetrics: expected metrics to be enabled once")
+	}
+
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+	time.Sleep(300 * time.Millisecond)
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != metricsContentType {
+		t.Fatal("TestSubscriberMetrics: unexpected content type: " + contentType)
+	}
+
+	samples := parseMetrics(t, recorder.Body.String())
+
+	if samples["sttp_subscriber_connected"] != 1 || samples["sttp_subscriber_subscribed"] != 1 || samples["sttp_subscriber_connections_total"] != 1 {
+		t.Fatal("TestSubscriberMetrics: unexpected connection state metrics")
+	}
+
+	if samples["sttp_subscriber_metadata_refreshes_total"] != 1 || samples["sttp_subscriber_metadata_refresh_duration_seconds"] <= 0 {
+		t.Fatal("TestSubscriberMetrics: unexpected metadata refresh metrics")
+	}
+
+	measurements := samples["sttp_subscriber_measurements_total"]
+
+	if measurements == 0 || measurements != samples["sttp_subscriber_measurement_latency_seconds_count"] || measurements != samples["sttp_subscriber_measurement_latency_seconds_bucket{le=\"+Inf\"}"] {
+		t.Fatalf("TestSubscriberMetrics: unexpected measurement metrics: %f", measurements)
+	}
+
+	if samples["sttp_subscriber_command_channel_bytes_total"] == 0 || samples["sttp_subscriber_data_packets_total"] == 0 {
+		t.Fatal("TestSubscriberMetrics: expected received bytes and data packets")
+	}
+
+	for _, signalID := range generator.SignalIDs() {
+		latency, ok := metrics.SignalLatency(signalID)
+
+		if !ok || latency > time.Second || latency < -time.Second {
+			t.Fatalf("TestSubscriberMetrics: unexpected latency for signal %s: %s", signalID.String(), latency)
+		}
+
+		if _, ok := samples["sttp_subscriber_signal_latency_seconds{signal_id=\""+signalID.String()+"\"}"]; !ok {
+			t.Fatal("TestSubscriberMetrics: missing latency sample for signal " + signalID.String())
+		}
+	}
+
+	// Metric counters accumulate across subscriptions while statistics counters reset
+	received := metrics.TotalMeasurementsReceived()
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatal("TestSubscriberMetrics: failed to resubscribe: " + err.Error())
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	var builder strings.Builder
+
+	if _, err := metrics.WriteTo(&builder); err != nil {
+		t.Fatal("TestSubscriberMetrics: failed to write metrics: " + err.Error())
+	}
+
+	samples = parseMetrics(t, builder.String())
+
+	if samples["sttp_subscriber_measurements_total"] <= float64(received) || subscriber.TotalMeasurementsReceived() >= metrics.TotalMeasurementsReceived() {
+		t.Fatal("TestSubscriberMetrics: expected metrics to accumulate across subscriptions")
+	}
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	if escaped := escapeLabelValue("A\\B\"C\nD"); escaped != "A\\\\B\\\"C\\nD" {
+		t.Fatal("TestEscapeLabelValue: unexpected escaped value: " + escaped)
+	}
+}
@@ -0,0 +1,393 @@
+//******************************************************************************************************
+//  Encoder.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package tssc
+
+import (
+	"math"
+)
+
+const (
+	bits4  uint32 = 0xF
+	bits8  uint32 = 0xFF
+	bits12 uint32 = 0xFFF
+	bits16 uint32 = 0xFFFF
+	bits20 uint32 = 0xFFFFF
+	bits24 uint32 = 0xFFFFFF
+	bits28 uint32 = 0xFFFFFFF
+)
+
+// Encoder is the encoder for the Time-Series Special Compression (TSSC) algorithm of STTP.
+type Encoder struct {
+	data         []byte
+	position     int
+	lastPosition int
+
+	prevTimestamp1 int64
+	prevTimestamp2 int64
+
+	prevTimeDelta1 int64
+	prevTimeDelta2 int64
+	prevTimeDelta3 int64
+	prevTimeDelta4 int64
+
+	lastPoint *pointMetadata
+	points    map[int32]*pointMetadata
+
+	// The position in m_buffer where the bit stream cache will be written. -1 means the bitstream is empty
+	bitStreamBufferIndex int
+
+	// The number of bits in m_bitStreamCache that are valid. 0 Means the bitstream is empty
+	bitStreamCacheBitCount int32
+
+	// A cache of bits that need to be flushed to m_buffer when full. Bits filled starting from the right moving left
+	bitStreamCache int32
+
+	// SequenceNumber is the sequence used to synchronize encoding and decoding.
+	SequenceNumber uint16
+}
+
+// NewEncoder creates a new TSSC encoder.
+func NewEncoder() *Encoder {
+	te := &Encoder{}
+	te.Reset()
+	return te
+}
+
+func (te *Encoder) newPointMetadata() *pointMetadata {
+	return newPointMetadata(te.writeBits, nil, nil)
+}
+
+// Reset resets the encoder state such that the next encoded block can be decoded by a new Decoder.
+func (te *Encoder) Reset() {
+	te.points = make(map[int32]*pointMetadata)
+	te.lastPoint = te.newPointMetadata()
+	te.data = nil
+	te.position = 0
+	te.lastPosition = 0
+	te.clearBitStream()
+	te.prevTimeDelta1 = math.MaxInt64
+	te.prevTimeDelta2 = math.MaxInt64
+	te.prevTimeDelta3 = math.MaxInt64
+	te.prevTimeDelta4 = math.MaxInt64
+	te.prevTimestamp1 = 0
+	te.prevTimestamp2 = 0
+}
+
+func (te *Encoder) clearBitStream() {
+	te.bitStreamBufferIndex = -1
+	te.bitStreamCacheBitCount = 0
+	te.bitStreamCache = 0
+}
+
+// SetBuffer assigns the working buffer to use for encoding measurements.
+func (te *Encoder) SetBuffer(data []byte) {
+	te.clearBitStream()
+	te.data = data
+	te.position = 0
+	te.lastPosition = len(data)
+}
+
+// FinishBlock completes the current block and returns the number of bytes used in the working buffer.
+func (te *Encoder) FinishBlock() int {
+	te.bitStreamFlush()
+	return te.position
+}
+
+// TryAddMeasurement attempts to add a measurement to the working buffer. Returns false when the
+// working buffer is full, in which case the block should be finished and a new buffer assigned.
+//gocyclo:ignore
+func (te *Encoder) TryAddMeasurement(id int32, timestamp int64, stateFlags uint32, value float32) bool {
+	// If there are fewer than 100 bytes available on the buffer
+	// assume that we cannot add any more.
+	if te.lastPosition-te.position < 100 {
+		return false
+	}
+
+	point, ok := te.points[id]
+
+	if !ok || point == nil {
+		point = te.newPointMetadata()
+		point.PrevNextPointID1 = id + 1
+		te.points[id] = point
+	}
+
+	// Note: since the incoming pointID is not known in advance, the most recent
+	// measurement received will be the one that contains the coding algorithm
+	// for this measurement. Since for the most part measurements generally have
+	// some sort of sequence to them, this still ends up being a good assumption.
+
+	if te.lastPoint.PrevNextPointID1 != id {
+		te.writePointIDChange(id)
+	}
+
+	if te.prevTimestamp1 != timestamp {
+		te.writeTimestampChange(timestamp)
+	}
+
+	if point.PrevStateFlags1 != stateFlags {
+		te.writeStateFlagsChange(stateFlags, point)
+	}
+
+	valueRaw := math.Float32bits(value)
+
+	// Since measurement value will almost always change, this is not put inside a function call
+	if point.PrevValue1 == valueRaw {
+		te.lastPoint.WriteCode(int32(codeWords.Value1))
+	} else if point.PrevValue2 == valueRaw {
+		te.lastPoint.WriteCode(int32(codeWords.Value2))
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	} else if point.PrevValue3 == valueRaw {
+		te.lastPoint.WriteCode(int32(codeWords.Value3))
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	} else if valueRaw == 0 {
+		te.lastPoint.WriteCode(int32(codeWords.ValueZero))
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = 0
+	} else {
+		bitsChanged := valueRaw ^ point.PrevValue1
+
+		switch {
+		case bitsChanged <= bits4:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor4))
+			te.writeBits(int32(bitsChanged&15), 4)
+		case bitsChanged <= bits8:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor8))
+			te.writeBytes(bitsChanged, 1)
+		case bitsChanged <= bits12:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor12))
+			te.writeBits(int32(bitsChanged&15), 4)
+			te.writeBytes(bitsChanged>>4, 1)
+		case bitsChanged <= bits16:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor16))
+			te.writeBytes(bitsChanged, 2)
+		case bitsChanged <= bits20:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor20))
+			te.writeBits(int32(bitsChanged&15), 4)
+			te.writeBytes(bitsChanged>>4, 2)
+		case bitsChanged <= bits24:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor24))
+			te.writeBytes(bitsChanged, 3)
+		case bitsChanged <= bits28:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor28))
+			te.writeBits(int32(bitsChanged&15), 4)
+			te.writeBytes(bitsChanged>>4, 3)
+		default:
+			te.lastPoint.WriteCode(int32(codeWords.ValueXor32))
+			te.writeBytes(bitsChanged, 4)
+		}
+
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	}
+
+	te.lastPoint = point
+
+	return true
+}
+
+func (te *Encoder) writePointIDChange(id int32) {
+	bitsChanged := uint32(id ^ te.lastPoint.PrevNextPointID1)
+
+	switch {
+	case bitsChanged <= bits4:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor4))
+		te.writeBits(int32(bitsChanged&15), 4)
+	case bitsChanged <= bits8:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor8))
+		te.writeBytes(bitsChanged, 1)
+	case bitsChanged <= bits12:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor12))
+		te.writeBits(int32(bitsChanged&15), 4)
+		te.writeBytes(bitsChanged>>4, 1)
+	case bitsChanged <= bits16:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor16))
+		te.writeBytes(bitsChanged, 2)
+	case bitsChanged <= bits20:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor20))
+		te.writeBits(int32(bitsChanged&15), 4)
+		te.writeBytes(bitsChanged>>4, 2)
+	case bitsChanged <= bits24:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor24))
+		te.writeBytes(bitsChanged, 3)
+	default:
+		te.lastPoint.WriteCode(int32(codeWords.PointIDXor32))
+		te.writeBytes(bitsChanged, 4)
+	}
+
+	te.lastPoint.PrevNextPointID1 = id
+}
+
+//gocyclo:ignore
+func (te *Encoder) writeTimestampChange(timestamp int64) {
+	switch timestamp {
+	case te.prevTimestamp1 + te.prevTimeDelta1:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta1Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta2:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta2Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta3:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta3Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta4:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta4Forward))
+	case te.prevTimestamp1 - te.prevTimeDelta1:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta1Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta2:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta2Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta3:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta3Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta4:
+		te.lastPoint.WriteCode(int32(codeWords.TimeDelta4Reverse))
+	case te.prevTimestamp2:
+		te.lastPoint.WriteCode(int32(codeWords.Timestamp2))
+	default:
+		te.lastPoint.WriteCode(int32(codeWords.TimeXor7Bit))
+		encode7BitUInt64(te.data, &te.position, uint64(timestamp^te.prevTimestamp1))
+	}
+
+	// Save the smallest delta time
+	minDelta := abs(te.prevTimestamp1 - timestamp)
+
+	if minDelta < te.prevTimeDelta4 && minDelta != te.prevTimeDelta1 && minDelta != te.prevTimeDelta2 && minDelta != te.prevTimeDelta3 {
+		if minDelta < te.prevTimeDelta1 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = te.prevTimeDelta2
+			te.prevTimeDelta2 = te.prevTimeDelta1
+			te.prevTimeDelta1 = minDelta
+		} else if minDelta < te.prevTimeDelta2 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = te.prevTimeDelta2
+			te.prevTimeDelta2 = minDelta
+		} else if minDelta < te.prevTimeDelta3 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = minDelta
+		} else {
+			te.prevTimeDelta4 = minDelta
+		}
+	}
+
+	te.prevTimestamp2 = te.prevTimestamp1
+	te.prevTimestamp1 = timestamp
+}
+
+func (te *Encoder) writeStateFlagsChange(stateFlags uint32, point *pointMetadata) {
+	if point.PrevStateFlags2 == stateFlags {
+		te.lastPoint.WriteCode(int32(codeWords.StateFlags2))
+	} else {
+		te.lastPoint.WriteCode(int32(codeWords.StateFlags7Bit32))
+		encode7BitUInt32(te.data, &te.position, stateFlags)
+	}
+
+	point.PrevStateFlags2 = point.PrevStateFlags1
+	point.PrevStateFlags1 = stateFlags
+}
+
+// writeBytes writes the specified number of low-order bytes of value, least significant byte first.
+func (te *Encoder) writeBytes(value uint32, count int) {
+	for i := 0; i < count; i++ {
+		te.data[te.position] = byte(value >> (8 * i))
+		te.position++
+	}
+}
+
+func (te *Encoder) writeBits(code int32, length int32) {
+	if te.bitStreamBufferIndex < 0 {
+		te.bitStreamBufferIndex = te.position
+		te.position++
+	}
+
+	te.bitStreamCache = te.bitStreamCache<<length | code
+	te.bitStreamCacheBitCount += length
+
+	if te.bitStreamCacheBitCount > 7 {
+		te.bitStreamEnd()
+	}
+}
+
+func (te *Encoder) bitStreamFlush() {
+	if te.bitStreamCacheBitCount == 0 {
+		return
+	}
+
+	// Mark end of stream so that decoder does not interpret padding bits as codes
+	te.lastPoint.WriteCode(int32(codeWords.EndOfStream))
+
+	if te.bitStreamCacheBitCount > 0 {
+		// Pad remaining bits to complete the byte
+		te.data[te.bitStreamBufferIndex] = byte(te.bitStreamCache << (8 - te.bitStreamCacheBitCount))
+	}
+
+	te.clearBitStream()
+}
+
+func (te *Encoder) bitStreamEnd() {
+	for te.bitStreamCacheBitCount > 7 {
+		te.data[te.bitStreamBufferIndex] = byte(te.bitStreamCache >> (te.bitStreamCacheBitCount - 8))
+		te.bitStreamCacheBitCount -= 8
+
+		if te.bitStreamCacheBitCount > 0 {
+			te.bitStreamBufferIndex = te.position
+			te.position++
+		} else {
+			te.bitStreamBufferIndex = -1
+		}
+	}
+}
+
+func encode7BitUInt32(stream []byte, position *int, value uint32) {
+	for i := 0; i < 4; i++ {
+		if value < 128 {
+			stream[*position] = byte(value)
+			*position++
+			return
+		}
+
+		stream[*position] = byte(value) | 128
+		*position++
+		value >>= 7
+	}
+
+	stream[*position] = byte(value)
+	*position++
+}
+
+func encode7BitUInt64(stream []byte, position *int, value uint64) {
+	for i := 0; i < 8; i++ {
+		if value < 128 {
+			stream[*position] = byte(value)
+			*position++
+			return
+		}
+
+		stream[*position] = byte(value) | 128
+		*position++
+		value >>= 7
+	}
+
+	stream[*position] = byte(value)
+	*position++
+}
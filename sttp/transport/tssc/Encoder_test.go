@@ -0,0 +1,159 @@
+//******************************************************************************************************
+//  Encoder_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/18/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package tssc
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+type testMeasurement struct {
+	id         int32
+	timestamp  int64
+	stateFlags uint32
+	value      float32
+}
+
+func createTestMeasurements(count int) []testMeasurement {
+	random := rand.New(rand.NewSource(42))
+	measurements := make([]testMeasurement, 0, count)
+	timestamp := int64(638000000000000000)
+
+	for len(measurements) < count {
+		// Frames of 50 signals at 30 frames per second with occasional gaps and out-of-order values
+		for id := int32(0); id < 50 && len(measurements) < count; id++ {
+			value := float32(60.0 + math.Sin(float64(len(measurements))/100.0))
+
+			switch random.Intn(20) {
+			case 0:
+				value = 0
+			case 1:
+				value = float32(random.NormFloat64() * 1e6)
+			}
+
+			stateFlags := uint32(0)
+
+			if random.Intn(50) == 0 {
+				stateFlags = uint32(random.Int31())
+			}
+
+			measurementTimestamp := timestamp
+
+			if random.Intn(100) == 0 {
+				measurementTimestamp -= int64(random.Intn(1000000))
+			}
+
+			measurements = append(measurements, testMeasurement{id * 37, measurementTimestamp, stateFlags, value})
+		}
+
+		timestamp += 333333
+
+		if random.Intn(30) == 0 {
+			timestamp += int64(random.Intn(100000000))
+		}
+	}
+
+	return measurements
+}
+
+func TestEncoderRoundTrip(t *testing.T) {
+	measurements := createTestMeasurements(20000)
+	encoder := NewEncoder()
+	decoder := NewDecoder()
+	buffer := make([]byte, 16384)
+	decoded := make([]testMeasurement, 0, len(measurements))
+	blocks := 0
+
+	// Encoder and decoder state is maintained across blocks
+	for index := 0; index < len(measurements); {
+		encoder.SetBuffer(buffer)
+
+		for index < len(measurements) {
+			measurement := measurements[index]
+
+			if !encoder.TryAddMeasurement(measurement.id, measurement.timestamp, measurement.stateFlags, measurement.value) {
+				break
+			}
+
+			index++
+		}
+
+		length := encoder.FinishBlock()
+		decoder.SetBuffer(buffer[:length])
+		blocks++
+
+		var measurement testMeasurement
+
+		for {
+			ok, err := decoder.TryGetMeasurement(&measurement.id, &measurement.timestamp, &measurement.stateFlags, &measurement.value)
+
+			if err != nil {
+				t.Fatal("TestEncoderRoundTrip: failed to decode measurement: " + err.Error())
+			}
+
+			if !ok {
+				break
+			}
+
+			decoded = append(decoded, measurement)
+		}
+	}
+
+	if blocks < 2 {
+		t.Fatal("TestEncoderRoundTrip: expected multiple blocks, encoded: " + strconv.Itoa(blocks))
+	}
+
+	if len(decoded) != len(measurements) {
+		t.Fatal("TestEncoderRoundTrip: expected " + strconv.Itoa(len(measurements)) + " measurements, decoded: " + strconv.Itoa(len(decoded)))
+	}
+
+	for i, measurement := range measurements {
+		if decoded[i] != measurement {
+			t.Fatal("TestEncoderRoundTrip: unexpected decoded measurement " + strconv.Itoa(i))
+		}
+	}
+
+	// After reset, an encoded block can be decoded by a new decoder
+	encoder.Reset()
+	encoder.SetBuffer(buffer)
+
+	for _, measurement := range measurements[:1000] {
+		if !encoder.TryAddMeasurement(measurement.id, measurement.timestamp, measurement.stateFlags, measurement.value) {
+			t.Fatal("TestEncoderRoundTrip: unexpected full buffer")
+		}
+	}
+
+	decoder = NewDecoder()
+	decoder.SetBuffer(buffer[:encoder.FinishBlock()])
+
+	for i := 0; i < 1000; i++ {
+		var measurement testMeasurement
+
+		if ok, err := decoder.TryGetMeasurement(&measurement.id, &measurement.timestamp, &measurement.stateFlags, &measurement.value); !ok || err != nil || measurement != measurements[i] {
+			t.Fatal("TestEncoderRoundTrip: unexpected decoded measurement after reset " + strconv.Itoa(i))
+		}
+	}
+}